@@ -17,6 +17,7 @@
 package common
 
 import (
+	"context"
 	"fmt"
 	"runtime"
 
@@ -67,14 +68,42 @@ const (
 //
 //	a Map which contains the set of headers to be included in the REST API request
 func GetSdkHeaders(serviceName string, serviceVersion string, operationId string) map[string]string {
+	return GetSdkHeadersWithContext(context.Background(), serviceName, serviceVersion, operationId)
+}
+
+// GetSdkHeadersWithContext - returns the set of SDK-specific headers to be included in an outgoing request
+// that is sent with the specified Context.
+//
+// The x-request-id header is the request ID of the Context, set with WithRequestID, or a new UUID if the
+// Context has none.
+func GetSdkHeadersWithContext(ctx context.Context, serviceName string, serviceVersion string, operationId string) map[string]string {
 	sdkHeaders := make(map[string]string)
 
 	sdkHeaders[headerNameUserAgent] = GetUserAgentInfo()
-	sdkHeaders[xRequestId] = GetNewXRequestID()
+	sdkHeaders[xRequestId] = GetRequestID(ctx)
+	if sdkHeaders[xRequestId] == "" {
+		sdkHeaders[xRequestId] = GetNewXRequestID()
+	}
 
 	return sdkHeaders
 }
 
+// requestIDContextKey is the key of the request ID within a Context.
+type requestIDContextKey struct{}
+
+// WithRequestID - returns a copy of the Context with which the SDK requests are sent with "requestID" as
+// their x-request-id header, instead of a new UUID, to correlate the requests with the caller's own
+// request. An empty "requestID" restores the default.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// GetRequestID - returns the request ID of the Context, set with WithRequestID, or an empty string.
+func GetRequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+	return requestID
+}
+
 var userAgent string = fmt.Sprintf("%s/%s %s", sdkName, Version, GetSystemInfo())
 
 func GetUserAgentInfo() string {
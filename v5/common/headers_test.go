@@ -17,6 +17,7 @@
 package common
 
 import (
+	"context"
 	"github.com/stretchr/testify/assert"
 	"strings"
 	"testing"
@@ -41,3 +42,19 @@ func TestGetSdkHeaders(t *testing.T) {
 	assert.True(t, foundIt)
 	t.Logf("user agent: %s\n", headers[headerNameUserAgent])
 }
+
+func TestGetSdkHeadersWithContext(t *testing.T) {
+	var headers = GetSdkHeadersWithContext(context.Background(), "myService", "v123", "myOperation")
+	assert.NotEmpty(t, headers[xRequestId])
+	assert.NotEqual(t, headers[xRequestId], GetSdkHeaders("myService", "v123", "myOperation")[xRequestId])
+
+	ctx := WithRequestID(context.Background(), "inbound-request-1")
+	assert.Equal(t, "inbound-request-1", GetRequestID(ctx))
+	headers = GetSdkHeadersWithContext(ctx, "myService", "v123", "myOperation")
+	assert.Equal(t, "inbound-request-1", headers[xRequestId])
+	assert.Equal(t, GetUserAgentInfo(), headers[headerNameUserAgent])
+
+	headers = GetSdkHeadersWithContext(WithRequestID(ctx, ""), "myService", "v123", "myOperation")
+	assert.NotEqual(t, "inbound-request-1", headers[xRequestId])
+	assert.NotEmpty(t, headers[xRequestId])
+}
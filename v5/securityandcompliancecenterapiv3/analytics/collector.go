@@ -0,0 +1,205 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+)
+
+// Collector collects the reports of an instance and computes their trends.
+type Collector struct {
+	// The service used to list the reports and read their summaries. Required.
+	Service scc.ReportsAPI
+
+	// The ID of the instance. Required.
+	InstanceID string
+
+	// The options of the analysis of the trends. Optional.
+	Options *TrendOptions
+}
+
+// NewCollector returns a Collector of the reports of an instance.
+func NewCollector(service scc.ReportsAPI, instanceID string) *Collector {
+	return &Collector{
+		Service:    service,
+		InstanceID: instanceID,
+	}
+}
+
+// Query selects the reports of a trend.
+type Query struct {
+	// The attachment, profile or report group of the reports. At least one is required, and
+	// the reports match all of those that are specified. The reports of a profile include the
+	// reports of all of its attachments.
+	AttachmentID string
+	ProfileID    string
+	GroupID      string
+
+	// The type of the scans of the reports, such as "scheduled". Optional.
+	Type string
+
+	// The time window of the scans of the reports: the reports scanned at or after Since, and
+	// before Until. A zero time doesn't bound the window.
+	Since time.Time
+	Until time.Time
+}
+
+// Collect collects the reports selected by the query and returns their trend.
+func (c *Collector) Collect(ctx context.Context, query *Query) (trend *Trend, err error) {
+	snapshots, err := c.Snapshots(ctx, query)
+	if err != nil {
+		return
+	}
+	trend = NewTrend(snapshots, c.Options)
+	return
+}
+
+// Snapshots lists the reports selected by the query and returns their snapshots, in the order of
+// their scan times, reading the summary of every report.
+func (c *Collector) Snapshots(ctx context.Context, query *Query) (snapshots []Snapshot, err error) {
+	err = c.validate(query)
+	if err != nil {
+		return
+	}
+
+	listReportsOptions := &scc.ListReportsOptions{
+		InstanceID: core.StringPtr(c.InstanceID),
+	}
+	if query.AttachmentID != "" {
+		listReportsOptions.ReportAttachmentID = core.StringPtr(query.AttachmentID)
+	}
+	if query.ProfileID != "" {
+		listReportsOptions.ReportProfileID = core.StringPtr(query.ProfileID)
+	}
+	if query.GroupID != "" {
+		listReportsOptions.GroupID = core.StringPtr(query.GroupID)
+	}
+	if query.Type != "" {
+		listReportsOptions.Type = core.StringPtr(query.Type)
+	}
+
+	var reports []scc.Report
+	var scanTimes []time.Time
+	for report, listErr := range c.Service.AllReports(ctx, listReportsOptions) {
+		if listErr != nil {
+			err = core.SDKErrorf(listErr, fmt.Sprintf("unable to list the reports: %s", listErr.Error()), "list-reports-error", common.GetComponentInfo())
+			return
+		}
+		if report.ID == nil {
+			continue
+		}
+		scanTime, ok := reportTime(&report)
+		if !ok {
+			err = core.SDKErrorf(nil, fmt.Sprintf("report %s has no valid scan time", *report.ID), "invalid-report-time", common.GetComponentInfo())
+			return
+		}
+		if (!query.Since.IsZero() && scanTime.Before(query.Since)) || (!query.Until.IsZero() && !scanTime.Before(query.Until)) {
+			continue
+		}
+		reports = append(reports, report)
+		scanTimes = append(scanTimes, scanTime)
+	}
+
+	snapshots = make([]Snapshot, 0, len(reports))
+	for i, report := range reports {
+		var summary *scc.ReportSummary
+		summary, _, err = c.Service.GetReportSummaryWithContext(ctx, &scc.GetReportSummaryOptions{
+			InstanceID: core.StringPtr(c.InstanceID),
+			ReportID:   report.ID,
+		})
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("unable to read the summary of report %s: %s", *report.ID, err.Error()), "report-summary-error", common.GetComponentInfo())
+			return nil, err
+		}
+		snapshots = append(snapshots, newSnapshot(&report, scanTimes[i], summary))
+	}
+	return sortSnapshots(snapshots), nil
+}
+
+// validate returns an error if the collector or the query is incomplete.
+func (c *Collector) validate(query *Query) error {
+	if c.Service == nil || c.InstanceID == "" {
+		return core.SDKErrorf(nil, "the service and the instance ID of the collector are required", "invalid-collector", common.GetComponentInfo())
+	}
+	if query == nil || (query.AttachmentID == "" && query.ProfileID == "" && query.GroupID == "") {
+		return core.SDKErrorf(nil, "the query requires an attachment ID, a profile ID or a group ID", "invalid-query", common.GetComponentInfo())
+	}
+	if !query.Since.IsZero() && !query.Until.IsZero() && !query.Since.Before(query.Until) {
+		return core.SDKErrorf(nil, "the start of the time window of the query must be before its end", "invalid-query", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// reportTime returns the scan time of a report, or its creation time if it has no scan time.
+func reportTime(report *scc.Report) (t time.Time, ok bool) {
+	for _, value := range []*string{report.ScanTime, report.CreatedOn} {
+		if value == nil {
+			continue
+		}
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.000Z0700"} {
+			parsed, err := time.Parse(layout, *value)
+			if err == nil {
+				return parsed, true
+			}
+		}
+	}
+	return
+}
+
+// newSnapshot returns the snapshot of a report, with the metrics of its summary.
+func newSnapshot(report *scc.Report, scanTime time.Time, summary *scc.ReportSummary) Snapshot {
+	snapshot := Snapshot{
+		ReportID: *report.ID,
+		GroupID:  core.StringNilMapper(report.GroupID),
+		ScanTime: scanTime,
+		Values:   map[Metric]float64{},
+	}
+	if report.Profile != nil {
+		snapshot.ProfileID = core.StringNilMapper(report.Profile.ID)
+	}
+	if report.Attachment != nil {
+		snapshot.AttachmentID = core.StringNilMapper(report.Attachment.ID)
+	}
+
+	if summary == nil {
+		return snapshot
+	}
+	set := func(metric Metric, value *int64) {
+		if value != nil {
+			snapshot.Values[metric] = float64(*value)
+		}
+	}
+	if summary.Score != nil {
+		set(MetricCompliancePercent, summary.Score.Percent)
+	}
+	if summary.Controls != nil {
+		set(MetricControlsPassed, summary.Controls.CompliantCount)
+		set(MetricControlsFailed, summary.Controls.NotCompliantCount)
+	}
+	if summary.Resources != nil {
+		set(MetricResourcesTotal, summary.Resources.TotalCount)
+		set(MetricResourcesPassed, summary.Resources.CompliantCount)
+		set(MetricResourcesFailed, summary.Resources.NotCompliantCount)
+	}
+	return snapshot
+}
@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analytics_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/analytics"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Collector`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	var testServer *httptest.Server
+	var collector *analytics.Collector
+	var listQueries []string
+	var summaries []string
+	var summaryStatus int
+
+	report := func(id string, scanTime string) string {
+		return fmt.Sprintf(`{"id": %q, "type": "scheduled", "group_id": "group-1", "created_on": %q, "scan_time": %q, "profile": {"id": "profile-1"}, "attachment": {"id": "attachment-1"}}`, id, scanTime, scanTime)
+	}
+
+	BeforeEach(func() {
+		listQueries = nil
+		summaries = nil
+		summaryStatus = 200
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			path := strings.TrimPrefix(req.URL.Path, "/instances/"+instanceID+"/v3/reports")
+			if path == "" {
+				listQueries = append(listQueries, req.URL.RawQuery)
+				// The reports are listed newest first, on two pages.
+				if req.URL.Query().Get("start") == "" {
+					fmt.Fprintf(res, `{"limit": 2, "total_count": 4, "first": {"href": "first"}, "next": {"href": "next", "start": "page-2"}, "reports": [%s, %s]}`,
+						report("report-4", "2025-03-04T00:00:00.000Z"), report("report-3", "2025-03-03T00:00:00.000Z"))
+				} else {
+					fmt.Fprintf(res, `{"limit": 2, "total_count": 4, "first": {"href": "first"}, "reports": [%s, %s]}`,
+						report("report-2", "2025-03-02T00:00:00.000Z"), report("report-1", "2025-03-01T00:00:00.000Z"))
+				}
+				return
+			}
+
+			reportID := strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/summary")
+			summaries = append(summaries, reportID)
+			if summaryStatus != 200 {
+				res.WriteHeader(summaryStatus)
+				fmt.Fprintf(res, `{"status_code": %d, "errors": [{"code": "not_found", "message": "report not found"}]}`, summaryStatus)
+				return
+			}
+			n := int(reportID[len(reportID)-1] - '0')
+			fmt.Fprintf(res, `{"report_id": %q, "score": {"passed": %d, "total_count": 100, "percent": %d}, "controls": {"status": "not_compliant", "total_count": 10, "compliant_count": %d, "not_compliant_count": %d}, "resources": {"status": "not_compliant", "total_count": 50, "compliant_count": %d, "not_compliant_count": %d}}`,
+				reportID, 100-10*n, 100-10*n, 10-n, n, 50-5*n, 5*n)
+		}))
+
+		service, err := securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		collector = analytics.NewCollector(service, instanceID)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Collect the trend of the reports within the time window`, func() {
+		collector.Options = &analytics.TrendOptions{RegressionThreshold: 15}
+		trend, err := collector.Collect(context.Background(), &analytics.Query{
+			AttachmentID: "attachment-1",
+			Type:         securityandcompliancecenterapiv3.ListReportsOptionsTypeScheduledConst,
+			Since:        time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC),
+			Until:        time.Date(2025, time.March, 4, 0, 0, 0, 0, time.UTC),
+		})
+		Expect(err).To(BeNil())
+
+		Expect(listQueries).To(HaveLen(2))
+		Expect(listQueries[0]).To(ContainSubstring("report_attachment_id=attachment-1"))
+		Expect(listQueries[0]).To(ContainSubstring("type=scheduled"))
+		Expect(listQueries[0]).ToNot(ContainSubstring("report_profile_id"))
+		Expect(summaries).To(ConsistOf("report-3", "report-2"))
+
+		Expect(trend.From).To(Equal(time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC)))
+		Expect(trend.To).To(Equal(time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC)))
+		Expect(trend.Snapshots).To(Equal([]analytics.Snapshot{
+			{
+				ReportID:     "report-2",
+				GroupID:      "group-1",
+				ProfileID:    "profile-1",
+				AttachmentID: "attachment-1",
+				ScanTime:     time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC),
+				Values: map[analytics.Metric]float64{
+					analytics.MetricCompliancePercent: 80,
+					analytics.MetricControlsPassed:    8,
+					analytics.MetricControlsFailed:    2,
+					analytics.MetricResourcesTotal:    50,
+					analytics.MetricResourcesPassed:   40,
+					analytics.MetricResourcesFailed:   10,
+				},
+			},
+			{
+				ReportID:     "report-3",
+				GroupID:      "group-1",
+				ProfileID:    "profile-1",
+				AttachmentID: "attachment-1",
+				ScanTime:     time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC),
+				Values: map[analytics.Metric]float64{
+					analytics.MetricCompliancePercent: 70,
+					analytics.MetricControlsPassed:    7,
+					analytics.MetricControlsFailed:    3,
+					analytics.MetricResourcesTotal:    50,
+					analytics.MetricResourcesPassed:   35,
+					analytics.MetricResourcesFailed:   15,
+				},
+			},
+		}))
+
+		// No metric gets worse by more than 15 between the two reports.
+		Expect(trend.Regressions).To(BeEmpty())
+
+		collector.Options = nil
+		trend, err = collector.Collect(context.Background(), &analytics.Query{ProfileID: "profile-1"})
+		Expect(err).To(BeNil())
+		Expect(listQueries[2]).To(ContainSubstring("report_profile_id=profile-1"))
+		Expect(trend.Snapshots).To(HaveLen(4))
+		// Every metric but the total number of resources gets worse with every report.
+		Expect(trend.Regressions).To(HaveLen(5 * 3))
+	})
+	It(`Validate the query`, func() {
+		_, err := collector.Collect(context.Background(), &analytics.Query{})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("attachment ID, a profile ID or a group ID"))
+
+		_, err = collector.Collect(context.Background(), &analytics.Query{
+			GroupID: "group-1",
+			Since:   time.Date(2025, time.March, 4, 0, 0, 0, 0, time.UTC),
+			Until:   time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC),
+		})
+		Expect(err).ToNot(BeNil())
+
+		_, err = analytics.NewCollector(nil, instanceID).Collect(context.Background(), &analytics.Query{GroupID: "group-1"})
+		Expect(err).ToNot(BeNil())
+		Expect(listQueries).To(BeEmpty())
+	})
+	It(`Return the errors of the summaries`, func() {
+		summaryStatus = 404
+		_, err := collector.Snapshots(context.Background(), &analytics.Query{GroupID: "group-1"})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unable to read the summary of report report-4"))
+	})
+})
@@ -0,0 +1,280 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package analytics computes compliance trends from the historical reports of a Security and
+// Compliance Center instance.
+//
+// A Collector lists the reports of an attachment, a profile or a report group within a time
+// window, reads their summaries and returns a Trend:
+//
+//	collector := analytics.NewCollector(service, instanceID)
+//	trend, err := collector.Collect(ctx, &analytics.Query{
+//		AttachmentID: attachmentID,
+//		Since:        time.Now().AddDate(0, -3, 0),
+//	})
+//
+// The Trend contains a time series for every Metric, with the change of every point and its
+// moving average, and the regressions: the points at which a metric got worse by more than a
+// threshold. Trends are JSON-encodable, for plotting by other tools. NewTrend computes the same
+// trend from snapshots that were collected beforehand.
+package analytics
+
+import (
+	"sort"
+	"time"
+)
+
+// Metric is a value measured by every report, which the time series of a trend track.
+type Metric string
+
+// The metrics of the reports.
+const (
+	// The percentage of successful evaluations (the compliance score of the report).
+	MetricCompliancePercent Metric = "compliance_percent"
+
+	// The number of compliant controls.
+	MetricControlsPassed Metric = "controls_passed"
+
+	// The number of controls that are not compliant.
+	MetricControlsFailed Metric = "controls_failed"
+
+	// The number of evaluated resources.
+	MetricResourcesTotal Metric = "resources_total"
+
+	// The number of compliant resources.
+	MetricResourcesPassed Metric = "resources_passed"
+
+	// The number of resources that are not compliant.
+	MetricResourcesFailed Metric = "resources_failed"
+)
+
+// Metrics lists all of the metrics, in the order of the series of a trend.
+var Metrics = []Metric{
+	MetricCompliancePercent,
+	MetricControlsPassed,
+	MetricControlsFailed,
+	MetricResourcesTotal,
+	MetricResourcesPassed,
+	MetricResourcesFailed,
+}
+
+// direction returns 1 if a higher value of the metric is better, -1 if a lower value is better,
+// or 0 if the metric has no better direction, so it has no regressions.
+func (m Metric) direction() float64 {
+	switch m {
+	case MetricCompliancePercent, MetricControlsPassed, MetricResourcesPassed:
+		return 1
+	case MetricControlsFailed, MetricResourcesFailed:
+		return -1
+	}
+	return 0
+}
+
+// Snapshot is the compliance measured by a report.
+type Snapshot struct {
+	// The ID of the report.
+	ReportID string `json:"report_id"`
+
+	// The report group, profile and attachment of the report.
+	GroupID      string `json:"group_id,omitempty"`
+	ProfileID    string `json:"profile_id,omitempty"`
+	AttachmentID string `json:"attachment_id,omitempty"`
+
+	// The time of the scan of the report.
+	ScanTime time.Time `json:"scan_time"`
+
+	// The values of the metrics. The metrics that the summary of the report doesn't include
+	// are missing.
+	Values map[Metric]float64 `json:"values"`
+}
+
+// Point is the value of a metric at the time of a report.
+type Point struct {
+	// The ID of the report and the time of its scan.
+	ReportID string    `json:"report_id"`
+	Time     time.Time `json:"time"`
+
+	// The value of the metric.
+	Value float64 `json:"value"`
+
+	// The change of the value since the previous point of the series, or nil for the first point.
+	Delta *float64 `json:"delta,omitempty"`
+
+	// The average of the values of this point and of the points before it, within the window of
+	// the moving average.
+	MovingAverage float64 `json:"moving_average"`
+}
+
+// Series is the time series of a metric, in the order of the scan times of the reports.
+type Series struct {
+	Metric Metric  `json:"metric"`
+	Points []Point `json:"points"`
+}
+
+// Regression is a change of a metric, between two consecutive points of its series, that makes it
+// worse by more than the regression threshold of the metric.
+type Regression struct {
+	Metric Metric `json:"metric"`
+
+	// The report before the regression, and its value.
+	PreviousReportID string    `json:"previous_report_id"`
+	PreviousTime     time.Time `json:"previous_time"`
+	PreviousValue    float64   `json:"previous_value"`
+
+	// The report of the regression, and its value.
+	ReportID string    `json:"report_id"`
+	Time     time.Time `json:"time"`
+	Value    float64   `json:"value"`
+
+	// The change of the value, which is negative for the metrics for which a higher value is better.
+	Delta float64 `json:"delta"`
+}
+
+// Trend is the evolution of the compliance over a sequence of reports.
+type Trend struct {
+	// The time of the first and the last report, or zero if there are no reports.
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	// The snapshots of the reports, in the order of their scan times.
+	Snapshots []Snapshot `json:"snapshots"`
+
+	// The series of the metrics, in the order of Metrics.
+	Series []Series `json:"series"`
+
+	// The regressions, in the order of their times, and then of Metrics.
+	Regressions []Regression `json:"regressions"`
+}
+
+// GetSeries returns the series of a metric, or nil.
+func (t *Trend) GetSeries(metric Metric) *Series {
+	for i := range t.Series {
+		if t.Series[i].Metric == metric {
+			return &t.Series[i]
+		}
+	}
+	return nil
+}
+
+// DefaultMovingAverageWindow is the number of points of the moving averages, unless specified.
+const DefaultMovingAverageWindow = 3
+
+// TrendOptions are the options of the analysis of a trend.
+type TrendOptions struct {
+	// The number of points of the moving averages. Defaults to DefaultMovingAverageWindow.
+	MovingAverageWindow int
+
+	// The change, in the unit of the metric, beyond which a change that makes a metric worse is a
+	// regression: percentage points for MetricCompliancePercent, and numbers of controls or
+	// resources for the other metrics. Defaults to 0, so that any worsening is a regression.
+	RegressionThreshold float64
+
+	// The regression thresholds of specific metrics, which override RegressionThreshold.
+	RegressionThresholds map[Metric]float64
+}
+
+// threshold returns the regression threshold of a metric.
+func (o *TrendOptions) threshold(metric Metric) float64 {
+	if threshold, ok := o.RegressionThresholds[metric]; ok {
+		return threshold
+	}
+	return o.RegressionThreshold
+}
+
+// NewTrend computes the trend of a sequence of snapshots, which it sorts by scan time (and then
+// by report ID). The options may be nil.
+func NewTrend(snapshots []Snapshot, options *TrendOptions) *Trend {
+	if options == nil {
+		options = &TrendOptions{}
+	}
+	window := options.MovingAverageWindow
+	if window <= 0 {
+		window = DefaultMovingAverageWindow
+	}
+
+	sorted := sortSnapshots(snapshots)
+
+	trend := &Trend{
+		Snapshots:   sorted,
+		Series:      make([]Series, 0, len(Metrics)),
+		Regressions: []Regression{},
+	}
+	if len(sorted) > 0 {
+		trend.From = sorted[0].ScanTime
+		trend.To = sorted[len(sorted)-1].ScanTime
+	}
+
+	for _, metric := range Metrics {
+		series := Series{Metric: metric, Points: []Point{}}
+		sum := 0.0
+		for _, snapshot := range sorted {
+			value, ok := snapshot.Values[metric]
+			if !ok {
+				continue
+			}
+
+			point := Point{
+				ReportID: snapshot.ReportID,
+				Time:     snapshot.ScanTime,
+				Value:    value,
+			}
+			n := len(series.Points)
+			if n > 0 {
+				previous := series.Points[n-1]
+				delta := value - previous.Value
+				point.Delta = &delta
+				worsening := -metric.direction() * delta
+				if worsening > 0 && worsening > options.threshold(metric) {
+					trend.Regressions = append(trend.Regressions, Regression{
+						Metric:           metric,
+						PreviousReportID: previous.ReportID,
+						PreviousTime:     previous.Time,
+						PreviousValue:    previous.Value,
+						ReportID:         point.ReportID,
+						Time:             point.Time,
+						Value:            value,
+						Delta:            delta,
+					})
+				}
+			}
+
+			sum += value
+			if n >= window {
+				sum -= series.Points[n-window].Value
+			}
+			point.MovingAverage = sum / float64(min(n+1, window))
+			series.Points = append(series.Points, point)
+		}
+		trend.Series = append(trend.Series, series)
+	}
+
+	sort.SliceStable(trend.Regressions, func(i, j int) bool {
+		return trend.Regressions[i].Time.Before(trend.Regressions[j].Time)
+	})
+	return trend
+}
+
+// sortSnapshots returns a copy of the snapshots, sorted by scan time and then by report ID.
+func sortSnapshots(snapshots []Snapshot) []Snapshot {
+	sorted := append([]Snapshot{}, snapshots...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].ScanTime.Equal(sorted[j].ScanTime) {
+			return sorted[i].ScanTime.Before(sorted[j].ScanTime)
+		}
+		return sorted[i].ReportID < sorted[j].ReportID
+	})
+	return sorted
+}
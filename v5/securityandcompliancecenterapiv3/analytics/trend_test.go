@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package analytics_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/analytics"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`NewTrend`, func() {
+	day := func(d int) time.Time {
+		return time.Date(2025, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	snapshot := func(reportID string, d int, percent, controlsFailed float64) analytics.Snapshot {
+		return analytics.Snapshot{
+			ReportID: reportID,
+			ScanTime: day(d),
+			Values: map[analytics.Metric]float64{
+				analytics.MetricCompliancePercent: percent,
+				analytics.MetricControlsFailed:    controlsFailed,
+				analytics.MetricResourcesTotal:    10 * float64(d),
+			},
+		}
+	}
+	values := func(series *analytics.Series) (result []float64) {
+		for _, point := range series.Points {
+			result = append(result, point.Value)
+		}
+		return
+	}
+	movingAverages := func(series *analytics.Series) (result []float64) {
+		for _, point := range series.Points {
+			result = append(result, point.MovingAverage)
+		}
+		return
+	}
+
+	It(`Build the series of the metrics in the order of the scan times`, func() {
+		trend := analytics.NewTrend([]analytics.Snapshot{
+			snapshot("report-3", 3, 70, 4),
+			snapshot("report-1", 1, 80, 2),
+			snapshot("report-2", 2, 90, 1),
+			snapshot("report-4", 4, 60, 5),
+		}, nil)
+
+		Expect(trend.From).To(Equal(day(1)))
+		Expect(trend.To).To(Equal(day(4)))
+		Expect(trend.Snapshots[0].ReportID).To(Equal("report-1"))
+		Expect(trend.Series).To(HaveLen(len(analytics.Metrics)))
+
+		percent := trend.GetSeries(analytics.MetricCompliancePercent)
+		Expect(values(percent)).To(Equal([]float64{80, 90, 70, 60}))
+		Expect(percent.Points[0].Delta).To(BeNil())
+		Expect(*percent.Points[1].Delta).To(Equal(10.0))
+		Expect(*percent.Points[2].Delta).To(Equal(-20.0))
+		Expect(movingAverages(percent)).To(Equal([]float64{80, 85, 80, 220.0 / 3}))
+
+		Expect(trend.GetSeries(analytics.MetricControlsPassed).Points).To(BeEmpty())
+	})
+	It(`Report the worsenings beyond the threshold as regressions`, func() {
+		trend := analytics.NewTrend([]analytics.Snapshot{
+			snapshot("report-1", 1, 80, 2),
+			snapshot("report-2", 2, 90, 1),
+			snapshot("report-3", 3, 70, 4),
+			snapshot("report-4", 4, 65, 5),
+		}, &analytics.TrendOptions{
+			MovingAverageWindow: 2,
+			RegressionThreshold: 5,
+			RegressionThresholds: map[analytics.Metric]float64{
+				analytics.MetricControlsFailed: 0,
+			},
+		})
+
+		Expect(movingAverages(trend.GetSeries(analytics.MetricCompliancePercent))).To(Equal([]float64{80, 85, 80, 67.5}))
+
+		// The compliance drops by 20 and then by 5, which isn't beyond the threshold, the failed
+		// controls increase twice, and the total number of resources has no regressions.
+		Expect(trend.Regressions).To(Equal([]analytics.Regression{
+			{
+				Metric:           analytics.MetricCompliancePercent,
+				PreviousReportID: "report-2",
+				PreviousTime:     day(2),
+				PreviousValue:    90,
+				ReportID:         "report-3",
+				Time:             day(3),
+				Value:            70,
+				Delta:            -20,
+			},
+			{
+				Metric:           analytics.MetricControlsFailed,
+				PreviousReportID: "report-2",
+				PreviousTime:     day(2),
+				PreviousValue:    1,
+				ReportID:         "report-3",
+				Time:             day(3),
+				Value:            4,
+				Delta:            3,
+			},
+			{
+				Metric:           analytics.MetricControlsFailed,
+				PreviousReportID: "report-3",
+				PreviousTime:     day(3),
+				PreviousValue:    4,
+				ReportID:         "report-4",
+				Time:             day(4),
+				Value:            5,
+				Delta:            1,
+			},
+		}))
+	})
+	It(`Encode the trend as JSON`, func() {
+		trend := analytics.NewTrend([]analytics.Snapshot{
+			snapshot("report-1", 1, 80, 2),
+			snapshot("report-2", 2, 90, 1),
+		}, nil)
+		data, err := json.Marshal(trend)
+		Expect(err).To(BeNil())
+
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded["from"]).To(Equal("2025-03-01T00:00:00Z"))
+		series := decoded["series"].([]interface{})[0].(map[string]interface{})
+		Expect(series["metric"]).To(Equal("compliance_percent"))
+		Expect(series["points"]).To(Equal([]interface{}{
+			map[string]interface{}{"report_id": "report-1", "time": "2025-03-01T00:00:00Z", "value": 80.0, "moving_average": 80.0},
+			map[string]interface{}{"report_id": "report-2", "time": "2025-03-02T00:00:00Z", "value": 90.0, "delta": 10.0, "moving_average": 85.0},
+		}))
+		Expect(decoded["regressions"]).To(BeEmpty())
+	})
+	It(`Return an empty trend without snapshots`, func() {
+		trend := analytics.NewTrend(nil, nil)
+		Expect(trend.From.IsZero()).To(BeTrue())
+		Expect(trend.Snapshots).To(BeEmpty())
+		Expect(trend.Regressions).To(BeEmpty())
+		Expect(trend.GetSeries(analytics.MetricResourcesFailed).Points).To(BeEmpty())
+		Expect(trend.GetSeries("unknown")).To(BeNil())
+	})
+})
@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Sentinel errors matched, with errors.Is, by the errors that the operations return for
+// the corresponding HTTP status codes.
+var (
+	// ErrUnauthorized matches the responses with status 401 (Unauthorized).
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrNotFound matches the responses with status 404 (Not Found).
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict matches the responses with status 409 (Conflict).
+	ErrConflict = errors.New("conflict")
+
+	// ErrPreconditionFailed matches the responses with status 412 (Precondition Failed),
+	// returned when the If-Match header of a request doesn't match the ETag of the resource.
+	ErrPreconditionFailed = errors.New("precondition failed")
+
+	// ErrRateLimited matches the responses with status 429 (Too Many Requests).
+	ErrRateLimited = errors.New("rate limited")
+)
+
+// APIError is an error response of the Security and Compliance Center API.
+//
+// Every operation that receives an error response returns an SDK problem that wraps an APIError,
+// which can be retrieved with errors.As and compared with the sentinel errors, such as ErrNotFound,
+// with errors.Is. The APIError embeds the HTTPProblem of the response, which also remains
+// available through errors.As.
+type APIError struct {
+	*core.HTTPProblem
+
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The trace of the error, to be provided to IBM support.
+	Trace string
+
+	// The code and the message of the first error of the response, such as "not_found".
+	Code    string
+	Message string
+
+	// The request ID that was sent in the x-request-id header of the request, to be quoted in
+	// support tickets. See WithSentRequestID.
+	RequestID string
+
+	// The request ID that the service returned in the x-request-id header of the response, if any.
+	ServiceRequestID string
+}
+
+// Is reports whether the error matches the sentinel error of its status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrPreconditionFailed:
+		return e.StatusCode == http.StatusPreconditionFailed
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return e.HTTPProblem.Is(target)
+}
+
+// Unwrap returns the HTTPProblem of the response and the problems that caused it.
+func (e *APIError) Unwrap() []error {
+	return append([]error{e.HTTPProblem}, e.HTTPProblem.Unwrap()...)
+}
+
+// newAPIError returns an APIError for the error of "request" that received an error response,
+// or err itself for the other errors, such as network errors.
+func newAPIError(err error, response *core.DetailedResponse, request *http.Request) error {
+	if response == nil || response.StatusCode < 300 {
+		return err
+	}
+
+	// The problems created by the core keep the HTTPProblem out of their chain, until they are
+	// wrapped in a problem of the SDK.
+	var httpProblem *core.HTTPProblem
+	if !errors.As(core.SDKErrorf(err, "", "", getServiceComponentInfo()), &httpProblem) {
+		return err
+	}
+
+	apiErr := &APIError{
+		HTTPProblem:      httpProblem,
+		StatusCode:       response.StatusCode,
+		RequestID:        getRequestIDHeader(request.Header),
+		ServiceRequestID: response.GetHeaders().Get(requestIDHeader),
+	}
+	if body, ok := response.GetResult().(map[string]interface{}); ok {
+		apiErr.Trace, _ = body["trace"].(string)
+		if errs, ok := body["errors"].([]interface{}); ok && len(errs) > 0 {
+			if first, ok := errs[0].(map[string]interface{}); ok {
+				apiErr.Code, _ = first["code"].(string)
+				apiErr.Message, _ = first["message"].(string)
+			}
+		}
+	}
+	return apiErr
+}
@@ -0,0 +1,126 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`APIError`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	var testServer *httptest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var status int
+
+	sentinels := []error{
+		securityandcompliancecenterapiv3.ErrUnauthorized,
+		securityandcompliancecenterapiv3.ErrNotFound,
+		securityandcompliancecenterapiv3.ErrConflict,
+		securityandcompliancecenterapiv3.ErrPreconditionFailed,
+		securityandcompliancecenterapiv3.ErrRateLimited,
+	}
+	matching := func(err error) (result []error) {
+		for _, sentinel := range sentinels {
+			if errors.Is(err, sentinel) {
+				result = append(result, sentinel)
+			}
+		}
+		return
+	}
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("X-Request-Id", req.Header.Get("X-Request-Id"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(status)
+			fmt.Fprintf(res, `{"status_code":%d,"trace":"trace-1","errors":[{"code":"code-%d","message":"message %d"}]}`, status, status, status)
+		}))
+
+		var serviceErr error
+		securityAndComplianceCenterAPIService, serviceErr = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Match the error responses with the sentinel errors`, func() {
+		for i, code := range []int{401, 404, 409, 412, 429} {
+			status = code
+			_, response, err := securityAndComplianceCenterAPIService.GetRule(securityAndComplianceCenterAPIService.NewGetRuleOptions(instanceID, "rule-1"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(code))
+			Expect(matching(err)).To(Equal([]error{sentinels[i]}), fmt.Sprint(code))
+		}
+
+		status = 500
+		_, err := securityAndComplianceCenterAPIService.DeleteRule(securityAndComplianceCenterAPIService.NewDeleteRuleOptions(instanceID, "rule-1"))
+		Expect(err).ToNot(BeNil())
+		Expect(matching(err)).To(BeEmpty())
+	})
+	It(`Expose the details of the error response`, func() {
+		status = 404
+		_, _, err := securityAndComplianceCenterAPIService.GetProfileWithContext(context.Background(), securityAndComplianceCenterAPIService.NewGetProfileOptions(instanceID, "profile-1"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("message 404"))
+
+		var apiErr *securityandcompliancecenterapiv3.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(404))
+		Expect(apiErr.Trace).To(Equal("trace-1"))
+		Expect(apiErr.Code).To(Equal("code-404"))
+		Expect(apiErr.Message).To(Equal("message 404"))
+		Expect(apiErr.RequestID).ToNot(BeEmpty())
+		Expect(apiErr.OperationID).To(Equal("get_profile"))
+
+		var httpProblem *core.HTTPProblem
+		Expect(errors.As(err, &httpProblem)).To(BeTrue())
+		Expect(httpProblem.Response.StatusCode).To(Equal(404))
+		var sdkProblem *core.SDKProblem
+		Expect(errors.As(err, &sdkProblem)).To(BeTrue())
+	})
+	It(`Keep the sentinel errors through the helpers`, func() {
+		status = 412
+		_, _, err := securityAndComplianceCenterAPIService.UpdateRule(securityAndComplianceCenterAPIService.NewUpdateRuleOptions(instanceID, "rule-1", func(*securityandcompliancecenterapiv3.Rule) error {
+			return nil
+		}))
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, securityandcompliancecenterapiv3.ErrPreconditionFailed)).To(BeTrue())
+	})
+	It(`Don't return an APIError without an error response`, func() {
+		testServer.Close()
+		_, _, err := securityAndComplianceCenterAPIService.GetRule(securityAndComplianceCenterAPIService.NewGetRuleOptions(instanceID, "rule-1"))
+		Expect(err).ToNot(BeNil())
+		var apiErr *securityandcompliancecenterapiv3.APIError
+		Expect(errors.As(err, &apiErr)).To(BeFalse())
+		Expect(matching(err)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,609 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"io"
+	"iter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SecurityAndComplianceCenterAPIV3Interface contains all of the operations of the
+// SecurityAndComplianceCenterAPIV3 service, including their WithContext variants, pager
+// constructors and iterators, so that code using the service can be tested with a fake
+// implementation such as the one in the sccmock package.
+//
+// It is composed of one interface per kind of resource, which lets consumers depend only on
+// the operations that they use. Options and model constructors such as NewGetRuleOptions are not
+// part of the interface because they do not use the service; they can be invoked on a nil
+// *SecurityAndComplianceCenterAPIV3.
+type SecurityAndComplianceCenterAPIV3Interface interface {
+	SettingsAPI
+	RulesAPI
+	ControlLibrariesAPI
+	ProfilesAPI
+	AttachmentsAPI
+	ScansAPI
+	ScopesAPI
+	TargetsAPI
+	ProviderTypesAPI
+	ReportsAPI
+	ScanReportsAPI
+	ServicesAPI
+}
+
+var _ SecurityAndComplianceCenterAPIV3Interface = (*SecurityAndComplianceCenterAPIV3)(nil)
+
+// SettingsAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the settings and test event operations.
+type SettingsAPI interface {
+	// GetSettings : List settings
+	GetSettings(getSettingsOptions *GetSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+
+	// GetSettingsWithContext is an alternate form of the GetSettings method which supports a Context parameter
+	GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+
+	// UpdateSettings : Update settings
+	UpdateSettings(updateSettingsOptions *UpdateSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+
+	// UpdateSettingsWithContext is an alternate form of the UpdateSettings method which supports a Context parameter
+	UpdateSettingsWithContext(ctx context.Context, updateSettingsOptions *UpdateSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+
+	// PostTestEvent : Create a test event
+	PostTestEvent(postTestEventOptions *PostTestEventOptions) (result *TestEvent, response *core.DetailedResponse, err error)
+
+	// PostTestEventWithContext is an alternate form of the PostTestEvent method which supports a Context parameter
+	PostTestEventWithContext(ctx context.Context, postTestEventOptions *PostTestEventOptions) (result *TestEvent, response *core.DetailedResponse, err error)
+}
+
+// RulesAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the rule operations.
+type RulesAPI interface {
+	// ListRules : Get all rules
+	ListRules(listRulesOptions *ListRulesOptions) (result *RuleCollection, response *core.DetailedResponse, err error)
+
+	// ListRulesWithContext is an alternate form of the ListRules method which supports a Context parameter
+	ListRulesWithContext(ctx context.Context, listRulesOptions *ListRulesOptions) (result *RuleCollection, response *core.DetailedResponse, err error)
+
+	// CreateRule : Create a custom rule
+	CreateRule(createRuleOptions *CreateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// CreateRuleWithContext is an alternate form of the CreateRule method which supports a Context parameter
+	CreateRuleWithContext(ctx context.Context, createRuleOptions *CreateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// GetRule : Get a custom rule
+	GetRule(getRuleOptions *GetRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// GetRuleWithContext is an alternate form of the GetRule method which supports a Context parameter
+	GetRuleWithContext(ctx context.Context, getRuleOptions *GetRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ReplaceRule : Update a custom rule
+	ReplaceRule(replaceRuleOptions *ReplaceRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ReplaceRuleWithContext is an alternate form of the ReplaceRule method which supports a Context parameter
+	ReplaceRuleWithContext(ctx context.Context, replaceRuleOptions *ReplaceRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// UpdateRule : Update a custom rule with optimistic concurrency
+	UpdateRule(updateRuleOptions *UpdateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// UpdateRuleWithContext is an alternate form of the UpdateRule method which supports a Context parameter
+	UpdateRuleWithContext(ctx context.Context, updateRuleOptions *UpdateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// DeleteRule : Delete a custom rule
+	DeleteRule(deleteRuleOptions *DeleteRuleOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteRuleWithContext is an alternate form of the DeleteRule method which supports a Context parameter
+	DeleteRuleWithContext(ctx context.Context, deleteRuleOptions *DeleteRuleOptions) (response *core.DetailedResponse, err error)
+
+	// NewRulesPager returns a new RulesPager instance.
+	NewRulesPager(options *ListRulesOptions) (pager *RulesPager, err error)
+
+	// AllRules returns an iterator over all of the results of the "ListRules" method.
+	AllRules(ctx context.Context, options *ListRulesOptions) iter.Seq2[Rule, error]
+}
+
+// ControlLibrariesAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the control library operations.
+type ControlLibrariesAPI interface {
+	// CreateControlLibrary : Create a custom control library
+	CreateControlLibrary(createControlLibraryOptions *CreateControlLibraryOptions) (result *ControlLibrary, response *core.DetailedResponse, err error)
+
+	// CreateControlLibraryWithContext is an alternate form of the CreateControlLibrary method which supports a Context parameter
+	CreateControlLibraryWithContext(ctx context.Context, createControlLibraryOptions *CreateControlLibraryOptions) (result *ControlLibrary, response *core.DetailedResponse, err error)
+
+	// ListControlLibraries : Get all control libraries
+	ListControlLibraries(listControlLibrariesOptions *ListControlLibrariesOptions) (result *ControlLibraryCollection, response *core.DetailedResponse, err error)
+
+	// ListControlLibrariesWithContext is an alternate form of the ListControlLibraries method which supports a Context parameter
+	ListControlLibrariesWithContext(ctx context.Context, listControlLibrariesOptions *ListControlLibrariesOptions) (result *ControlLibraryCollection, response *core.DetailedResponse, err error)
+
+	// ReplaceCustomControlLibrary : Update a custom control library
+	ReplaceCustomControlLibrary(replaceCustomControlLibraryOptions *ReplaceCustomControlLibraryOptions) (result *ControlLibrary, response *core.DetailedResponse, err error)
+
+	// ReplaceCustomControlLibraryWithContext is an alternate form of the ReplaceCustomControlLibrary method which supports a Context parameter
+	ReplaceCustomControlLibraryWithContext(ctx context.Context, replaceCustomControlLibraryOptions *ReplaceCustomControlLibraryOptions) (result *ControlLibrary, response *core.DetailedResponse, err error)
+
+	// GetControlLibrary : Get a control library
+	GetControlLibrary(getControlLibraryOptions *GetControlLibraryOptions) (result *ControlLibrary, response *core.DetailedResponse, err error)
+
+	// GetControlLibraryWithContext is an alternate form of the GetControlLibrary method which supports a Context parameter
+	GetControlLibraryWithContext(ctx context.Context, getControlLibraryOptions *GetControlLibraryOptions) (result *ControlLibrary, response *core.DetailedResponse, err error)
+
+	// DeleteCustomControlLibrary : Delete a custom control library
+	DeleteCustomControlLibrary(deleteCustomControlLibraryOptions *DeleteCustomControlLibraryOptions) (result *ControlLibrary, response *core.DetailedResponse, err error)
+
+	// DeleteCustomControlLibraryWithContext is an alternate form of the DeleteCustomControlLibrary method which supports a Context parameter
+	DeleteCustomControlLibraryWithContext(ctx context.Context, deleteCustomControlLibraryOptions *DeleteCustomControlLibraryOptions) (result *ControlLibrary, response *core.DetailedResponse, err error)
+
+	// NewControlLibrariesPager returns a new ControlLibrariesPager instance.
+	NewControlLibrariesPager(options *ListControlLibrariesOptions) (pager *ControlLibrariesPager, err error)
+
+	// AllControlLibraries returns an iterator over all of the results of the "ListControlLibraries" method.
+	AllControlLibraries(ctx context.Context, options *ListControlLibrariesOptions) iter.Seq2[ControlLibrary, error]
+}
+
+// ProfilesAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the profile operations.
+type ProfilesAPI interface {
+	// CreateProfile : Create a custom profile
+	CreateProfile(createProfileOptions *CreateProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+
+	// CreateProfileWithContext is an alternate form of the CreateProfile method which supports a Context parameter
+	CreateProfileWithContext(ctx context.Context, createProfileOptions *CreateProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+
+	// ListProfiles : Get all profiles
+	ListProfiles(listProfilesOptions *ListProfilesOptions) (result *ProfileCollection, response *core.DetailedResponse, err error)
+
+	// ListProfilesWithContext is an alternate form of the ListProfiles method which supports a Context parameter
+	ListProfilesWithContext(ctx context.Context, listProfilesOptions *ListProfilesOptions) (result *ProfileCollection, response *core.DetailedResponse, err error)
+
+	// ReplaceProfile : Update a custom profile
+	ReplaceProfile(replaceProfileOptions *ReplaceProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+
+	// ReplaceProfileWithContext is an alternate form of the ReplaceProfile method which supports a Context parameter
+	ReplaceProfileWithContext(ctx context.Context, replaceProfileOptions *ReplaceProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+
+	// GetProfile : Get a profile
+	GetProfile(getProfileOptions *GetProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+
+	// GetProfileWithContext is an alternate form of the GetProfile method which supports a Context parameter
+	GetProfileWithContext(ctx context.Context, getProfileOptions *GetProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+
+	// DeleteCustomProfile : Delete a custom profile
+	DeleteCustomProfile(deleteCustomProfileOptions *DeleteCustomProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+
+	// DeleteCustomProfileWithContext is an alternate form of the DeleteCustomProfile method which supports a Context parameter
+	DeleteCustomProfileWithContext(ctx context.Context, deleteCustomProfileOptions *DeleteCustomProfileOptions) (result *Profile, response *core.DetailedResponse, err error)
+
+	// ReplaceProfileParameters : Update custom profile parameters
+	ReplaceProfileParameters(replaceProfileParametersOptions *ReplaceProfileParametersOptions) (result *ProfileDefaultParametersResponse, response *core.DetailedResponse, err error)
+
+	// ReplaceProfileParametersWithContext is an alternate form of the ReplaceProfileParameters method which supports a Context parameter
+	ReplaceProfileParametersWithContext(ctx context.Context, replaceProfileParametersOptions *ReplaceProfileParametersOptions) (result *ProfileDefaultParametersResponse, response *core.DetailedResponse, err error)
+
+	// ListProfileParameters : List profile parameters for a given profile
+	ListProfileParameters(listProfileParametersOptions *ListProfileParametersOptions) (result *ProfileDefaultParametersResponse, response *core.DetailedResponse, err error)
+
+	// ListProfileParametersWithContext is an alternate form of the ListProfileParameters method which supports a Context parameter
+	ListProfileParametersWithContext(ctx context.Context, listProfileParametersOptions *ListProfileParametersOptions) (result *ProfileDefaultParametersResponse, response *core.DetailedResponse, err error)
+
+	// CompareProfiles : Compare profiles
+	CompareProfiles(compareProfilesOptions *CompareProfilesOptions) (result *ComparePredefinedProfilesResponse, response *core.DetailedResponse, err error)
+
+	// CompareProfilesWithContext is an alternate form of the CompareProfiles method which supports a Context parameter
+	CompareProfilesWithContext(ctx context.Context, compareProfilesOptions *CompareProfilesOptions) (result *ComparePredefinedProfilesResponse, response *core.DetailedResponse, err error)
+
+	// NewProfilesPager returns a new ProfilesPager instance.
+	NewProfilesPager(options *ListProfilesOptions) (pager *ProfilesPager, err error)
+
+	// AllProfiles returns an iterator over all of the results of the "ListProfiles" method.
+	AllProfiles(ctx context.Context, options *ListProfilesOptions) iter.Seq2[Profile, error]
+}
+
+// AttachmentsAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the profile attachment operations.
+type AttachmentsAPI interface {
+	// ListInstanceAttachments : Get all instance attachments
+	ListInstanceAttachments(listInstanceAttachmentsOptions *ListInstanceAttachmentsOptions) (result *ProfileAttachmentCollection, response *core.DetailedResponse, err error)
+
+	// ListInstanceAttachmentsWithContext is an alternate form of the ListInstanceAttachments method which supports a Context parameter
+	ListInstanceAttachmentsWithContext(ctx context.Context, listInstanceAttachmentsOptions *ListInstanceAttachmentsOptions) (result *ProfileAttachmentCollection, response *core.DetailedResponse, err error)
+
+	// CreateProfileAttachment : Create an attachment
+	CreateProfileAttachment(createProfileAttachmentOptions *CreateProfileAttachmentOptions) (result *ProfileAttachmentResponse, response *core.DetailedResponse, err error)
+
+	// CreateProfileAttachmentWithContext is an alternate form of the CreateProfileAttachment method which supports a Context parameter
+	CreateProfileAttachmentWithContext(ctx context.Context, createProfileAttachmentOptions *CreateProfileAttachmentOptions) (result *ProfileAttachmentResponse, response *core.DetailedResponse, err error)
+
+	// GetProfileAttachment : Get an attachment
+	GetProfileAttachment(getProfileAttachmentOptions *GetProfileAttachmentOptions) (result *ProfileAttachment, response *core.DetailedResponse, err error)
+
+	// GetProfileAttachmentWithContext is an alternate form of the GetProfileAttachment method which supports a Context parameter
+	GetProfileAttachmentWithContext(ctx context.Context, getProfileAttachmentOptions *GetProfileAttachmentOptions) (result *ProfileAttachment, response *core.DetailedResponse, err error)
+
+	// ReplaceProfileAttachment : Update an attachment
+	ReplaceProfileAttachment(replaceProfileAttachmentOptions *ReplaceProfileAttachmentOptions) (result *ProfileAttachment, response *core.DetailedResponse, err error)
+
+	// ReplaceProfileAttachmentWithContext is an alternate form of the ReplaceProfileAttachment method which supports a Context parameter
+	ReplaceProfileAttachmentWithContext(ctx context.Context, replaceProfileAttachmentOptions *ReplaceProfileAttachmentOptions) (result *ProfileAttachment, response *core.DetailedResponse, err error)
+
+	// DeleteProfileAttachment : Delete an attachment
+	DeleteProfileAttachment(deleteProfileAttachmentOptions *DeleteProfileAttachmentOptions) (result *ProfileAttachment, response *core.DetailedResponse, err error)
+
+	// DeleteProfileAttachmentWithContext is an alternate form of the DeleteProfileAttachment method which supports a Context parameter
+	DeleteProfileAttachmentWithContext(ctx context.Context, deleteProfileAttachmentOptions *DeleteProfileAttachmentOptions) (result *ProfileAttachment, response *core.DetailedResponse, err error)
+
+	// UpgradeAttachment : Upgrade an attachment
+	UpgradeAttachment(upgradeAttachmentOptions *UpgradeAttachmentOptions) (result *ProfileAttachment, response *core.DetailedResponse, err error)
+
+	// UpgradeAttachmentWithContext is an alternate form of the UpgradeAttachment method which supports a Context parameter
+	UpgradeAttachmentWithContext(ctx context.Context, upgradeAttachmentOptions *UpgradeAttachmentOptions) (result *ProfileAttachment, response *core.DetailedResponse, err error)
+
+	// ListProfileAttachments : Get all attachments linked to a specific profile
+	ListProfileAttachments(listProfileAttachmentsOptions *ListProfileAttachmentsOptions) (result *ProfileAttachmentCollection, response *core.DetailedResponse, err error)
+
+	// ListProfileAttachmentsWithContext is an alternate form of the ListProfileAttachments method which supports a Context parameter
+	ListProfileAttachmentsWithContext(ctx context.Context, listProfileAttachmentsOptions *ListProfileAttachmentsOptions) (result *ProfileAttachmentCollection, response *core.DetailedResponse, err error)
+
+	// NewProfileAttachmentsPager returns a new ProfileAttachmentsPager instance.
+	NewProfileAttachmentsPager(options *ListProfileAttachmentsOptions) (pager *ProfileAttachmentsPager, err error)
+
+	// AllProfileAttachments returns an iterator over all of the results of the "ListProfileAttachments" method.
+	AllProfileAttachments(ctx context.Context, options *ListProfileAttachmentsOptions) iter.Seq2[ProfileAttachment, error]
+
+	// NewInstanceAttachmentsPager returns a new InstanceAttachmentsPager instance.
+	NewInstanceAttachmentsPager(options *ListInstanceAttachmentsOptions) (pager *InstanceAttachmentsPager, err error)
+
+	// AllInstanceAttachments returns an iterator over all of the results of the "ListInstanceAttachments" method.
+	AllInstanceAttachments(ctx context.Context, options *ListInstanceAttachmentsOptions) iter.Seq2[ProfileAttachment, error]
+}
+
+// ScansAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the scan operations.
+type ScansAPI interface {
+	// CreateScan : Create a scan
+	CreateScan(createScanOptions *CreateScanOptions) (result *CreateScanResponse, response *core.DetailedResponse, err error)
+
+	// CreateScanWithContext is an alternate form of the CreateScan method which supports a Context parameter
+	CreateScanWithContext(ctx context.Context, createScanOptions *CreateScanOptions) (result *CreateScanResponse, response *core.DetailedResponse, err error)
+
+	// WaitForScan : Create a scan and wait for its report
+	WaitForScan(waitForScanOptions *WaitForScanOptions) (result *ScanResult, err error)
+
+	// WaitForScanWithContext is an alternate form of the WaitForScan method which supports a Context parameter
+	WaitForScanWithContext(ctx context.Context, waitForScanOptions *WaitForScanOptions) (result *ScanResult, err error)
+}
+
+// ScopesAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the scope and subscope operations.
+type ScopesAPI interface {
+	// CreateScope : Create a scope
+	CreateScope(createScopeOptions *CreateScopeOptions) (result *Scope, response *core.DetailedResponse, err error)
+
+	// CreateScopeWithContext is an alternate form of the CreateScope method which supports a Context parameter
+	CreateScopeWithContext(ctx context.Context, createScopeOptions *CreateScopeOptions) (result *Scope, response *core.DetailedResponse, err error)
+
+	// ListScopes : Get all scopes
+	ListScopes(listScopesOptions *ListScopesOptions) (result *ScopeCollection, response *core.DetailedResponse, err error)
+
+	// ListScopesWithContext is an alternate form of the ListScopes method which supports a Context parameter
+	ListScopesWithContext(ctx context.Context, listScopesOptions *ListScopesOptions) (result *ScopeCollection, response *core.DetailedResponse, err error)
+
+	// UpdateScope : Update a scope
+	UpdateScope(updateScopeOptions *UpdateScopeOptions) (result *Scope, response *core.DetailedResponse, err error)
+
+	// UpdateScopeWithContext is an alternate form of the UpdateScope method which supports a Context parameter
+	UpdateScopeWithContext(ctx context.Context, updateScopeOptions *UpdateScopeOptions) (result *Scope, response *core.DetailedResponse, err error)
+
+	// GetScope : Get a scope
+	GetScope(getScopeOptions *GetScopeOptions) (result *Scope, response *core.DetailedResponse, err error)
+
+	// GetScopeWithContext is an alternate form of the GetScope method which supports a Context parameter
+	GetScopeWithContext(ctx context.Context, getScopeOptions *GetScopeOptions) (result *Scope, response *core.DetailedResponse, err error)
+
+	// DeleteScope : Delete a scope
+	DeleteScope(deleteScopeOptions *DeleteScopeOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteScopeWithContext is an alternate form of the DeleteScope method which supports a Context parameter
+	DeleteScopeWithContext(ctx context.Context, deleteScopeOptions *DeleteScopeOptions) (response *core.DetailedResponse, err error)
+
+	// CreateSubscope : Create a subscope
+	CreateSubscope(createSubscopeOptions *CreateSubscopeOptions) (result *SubScopeResponse, response *core.DetailedResponse, err error)
+
+	// CreateSubscopeWithContext is an alternate form of the CreateSubscope method which supports a Context parameter
+	CreateSubscopeWithContext(ctx context.Context, createSubscopeOptions *CreateSubscopeOptions) (result *SubScopeResponse, response *core.DetailedResponse, err error)
+
+	// ListSubscopes : Get all subscopes
+	ListSubscopes(listSubscopesOptions *ListSubscopesOptions) (result *SubScopeCollection, response *core.DetailedResponse, err error)
+
+	// ListSubscopesWithContext is an alternate form of the ListSubscopes method which supports a Context parameter
+	ListSubscopesWithContext(ctx context.Context, listSubscopesOptions *ListSubscopesOptions) (result *SubScopeCollection, response *core.DetailedResponse, err error)
+
+	// GetSubscope : Get a subscope
+	GetSubscope(getSubscopeOptions *GetSubscopeOptions) (result *SubScope, response *core.DetailedResponse, err error)
+
+	// GetSubscopeWithContext is an alternate form of the GetSubscope method which supports a Context parameter
+	GetSubscopeWithContext(ctx context.Context, getSubscopeOptions *GetSubscopeOptions) (result *SubScope, response *core.DetailedResponse, err error)
+
+	// UpdateSubscope : Update a subscope
+	UpdateSubscope(updateSubscopeOptions *UpdateSubscopeOptions) (result *SubScope, response *core.DetailedResponse, err error)
+
+	// UpdateSubscopeWithContext is an alternate form of the UpdateSubscope method which supports a Context parameter
+	UpdateSubscopeWithContext(ctx context.Context, updateSubscopeOptions *UpdateSubscopeOptions) (result *SubScope, response *core.DetailedResponse, err error)
+
+	// DeleteSubscope : Delete a subscope
+	DeleteSubscope(deleteSubscopeOptions *DeleteSubscopeOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteSubscopeWithContext is an alternate form of the DeleteSubscope method which supports a Context parameter
+	DeleteSubscopeWithContext(ctx context.Context, deleteSubscopeOptions *DeleteSubscopeOptions) (response *core.DetailedResponse, err error)
+
+	// NewScopesPager returns a new ScopesPager instance.
+	NewScopesPager(options *ListScopesOptions) (pager *ScopesPager, err error)
+
+	// NewSubscopesPager returns a new SubscopesPager instance.
+	NewSubscopesPager(options *ListSubscopesOptions) (pager *SubscopesPager, err error)
+
+	// AllScopes returns an iterator over all of the results of the "ListScopes" method.
+	AllScopes(ctx context.Context, options *ListScopesOptions) iter.Seq2[Scope, error]
+
+	// AllSubscopes returns an iterator over all of the results of the "ListSubscopes" method.
+	AllSubscopes(ctx context.Context, options *ListSubscopesOptions) iter.Seq2[SubScope, error]
+}
+
+// TargetsAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the target operations.
+type TargetsAPI interface {
+	// CreateTarget : Create a target
+	CreateTarget(createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// CreateTargetWithContext is an alternate form of the CreateTarget method which supports a Context parameter
+	CreateTargetWithContext(ctx context.Context, createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ListTargets : Get a list of targets with pagination
+	ListTargets(listTargetsOptions *ListTargetsOptions) (result *TargetCollection, response *core.DetailedResponse, err error)
+
+	// ListTargetsWithContext is an alternate form of the ListTargets method which supports a Context parameter
+	ListTargetsWithContext(ctx context.Context, listTargetsOptions *ListTargetsOptions) (result *TargetCollection, response *core.DetailedResponse, err error)
+
+	// NewTargetsPager returns a new TargetsPager instance.
+	NewTargetsPager(options *ListTargetsOptions) (pager *TargetsPager, err error)
+
+	// AllTargets returns an iterator over all of the results of the "ListTargets" method.
+	AllTargets(ctx context.Context, options *ListTargetsOptions) iter.Seq2[Target, error]
+
+	// GetTarget : Get a target by ID
+	GetTarget(getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// GetTargetWithContext is an alternate form of the GetTarget method which supports a Context parameter
+	GetTargetWithContext(ctx context.Context, getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ReplaceTarget : replace a target by ID
+	ReplaceTarget(replaceTargetOptions *ReplaceTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ReplaceTargetWithContext is an alternate form of the ReplaceTarget method which supports a Context parameter
+	ReplaceTargetWithContext(ctx context.Context, replaceTargetOptions *ReplaceTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// DeleteTarget : Delete a target by ID
+	DeleteTarget(deleteTargetOptions *DeleteTargetOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteTargetWithContext is an alternate form of the DeleteTarget method which supports a Context parameter
+	DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *DeleteTargetOptions) (response *core.DetailedResponse, err error)
+}
+
+// ProviderTypesAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the provider type and provider type instance operations.
+type ProviderTypesAPI interface {
+	// CreateProviderTypeInstance : Create a provider type instance
+	CreateProviderTypeInstance(createProviderTypeInstanceOptions *CreateProviderTypeInstanceOptions) (result *ProviderTypeInstance, response *core.DetailedResponse, err error)
+
+	// CreateProviderTypeInstanceWithContext is an alternate form of the CreateProviderTypeInstance method which supports a Context parameter
+	CreateProviderTypeInstanceWithContext(ctx context.Context, createProviderTypeInstanceOptions *CreateProviderTypeInstanceOptions) (result *ProviderTypeInstance, response *core.DetailedResponse, err error)
+
+	// ListProviderTypeInstances : List instances of a specific provider type
+	ListProviderTypeInstances(listProviderTypeInstancesOptions *ListProviderTypeInstancesOptions) (result *ProviderTypeInstanceCollection, response *core.DetailedResponse, err error)
+
+	// ListProviderTypeInstancesWithContext is an alternate form of the ListProviderTypeInstances method which supports a Context parameter
+	ListProviderTypeInstancesWithContext(ctx context.Context, listProviderTypeInstancesOptions *ListProviderTypeInstancesOptions) (result *ProviderTypeInstanceCollection, response *core.DetailedResponse, err error)
+
+	// GetProviderTypeInstance : Get a provider type instance
+	GetProviderTypeInstance(getProviderTypeInstanceOptions *GetProviderTypeInstanceOptions) (result *ProviderTypeInstance, response *core.DetailedResponse, err error)
+
+	// GetProviderTypeInstanceWithContext is an alternate form of the GetProviderTypeInstance method which supports a Context parameter
+	GetProviderTypeInstanceWithContext(ctx context.Context, getProviderTypeInstanceOptions *GetProviderTypeInstanceOptions) (result *ProviderTypeInstance, response *core.DetailedResponse, err error)
+
+	// UpdateProviderTypeInstance : Update a provider type instance
+	UpdateProviderTypeInstance(updateProviderTypeInstanceOptions *UpdateProviderTypeInstanceOptions) (result *ProviderTypeInstance, response *core.DetailedResponse, err error)
+
+	// UpdateProviderTypeInstanceWithContext is an alternate form of the UpdateProviderTypeInstance method which supports a Context parameter
+	UpdateProviderTypeInstanceWithContext(ctx context.Context, updateProviderTypeInstanceOptions *UpdateProviderTypeInstanceOptions) (result *ProviderTypeInstance, response *core.DetailedResponse, err error)
+
+	// DeleteProviderTypeInstance : Delete a provider type instance
+	DeleteProviderTypeInstance(deleteProviderTypeInstanceOptions *DeleteProviderTypeInstanceOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteProviderTypeInstanceWithContext is an alternate form of the DeleteProviderTypeInstance method which supports a Context parameter
+	DeleteProviderTypeInstanceWithContext(ctx context.Context, deleteProviderTypeInstanceOptions *DeleteProviderTypeInstanceOptions) (response *core.DetailedResponse, err error)
+
+	// ListProviderTypes : List provider types
+	ListProviderTypes(listProviderTypesOptions *ListProviderTypesOptions) (result *ProviderTypeCollection, response *core.DetailedResponse, err error)
+
+	// ListProviderTypesWithContext is an alternate form of the ListProviderTypes method which supports a Context parameter
+	ListProviderTypesWithContext(ctx context.Context, listProviderTypesOptions *ListProviderTypesOptions) (result *ProviderTypeCollection, response *core.DetailedResponse, err error)
+
+	// GetProviderTypeByID : Get a provider type
+	GetProviderTypeByID(getProviderTypeByIDOptions *GetProviderTypeByIDOptions) (result *ProviderType, response *core.DetailedResponse, err error)
+
+	// GetProviderTypeByIDWithContext is an alternate form of the GetProviderTypeByID method which supports a Context parameter
+	GetProviderTypeByIDWithContext(ctx context.Context, getProviderTypeByIDOptions *GetProviderTypeByIDOptions) (result *ProviderType, response *core.DetailedResponse, err error)
+}
+
+// ReportsAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the report operations.
+type ReportsAPI interface {
+	// GetLatestReports : List latest reports
+	GetLatestReports(getLatestReportsOptions *GetLatestReportsOptions) (result *ReportLatest, response *core.DetailedResponse, err error)
+
+	// GetLatestReportsWithContext is an alternate form of the GetLatestReports method which supports a Context parameter
+	GetLatestReportsWithContext(ctx context.Context, getLatestReportsOptions *GetLatestReportsOptions) (result *ReportLatest, response *core.DetailedResponse, err error)
+
+	// ListReports : List reports
+	ListReports(listReportsOptions *ListReportsOptions) (result *ReportCollection, response *core.DetailedResponse, err error)
+
+	// ListReportsWithContext is an alternate form of the ListReports method which supports a Context parameter
+	ListReportsWithContext(ctx context.Context, listReportsOptions *ListReportsOptions) (result *ReportCollection, response *core.DetailedResponse, err error)
+
+	// GetReport : Get a report
+	GetReport(getReportOptions *GetReportOptions) (result *Report, response *core.DetailedResponse, err error)
+
+	// GetReportWithContext is an alternate form of the GetReport method which supports a Context parameter
+	GetReportWithContext(ctx context.Context, getReportOptions *GetReportOptions) (result *Report, response *core.DetailedResponse, err error)
+
+	// GetReportSummary : Get a report summary
+	GetReportSummary(getReportSummaryOptions *GetReportSummaryOptions) (result *ReportSummary, response *core.DetailedResponse, err error)
+
+	// GetReportSummaryWithContext is an alternate form of the GetReportSummary method which supports a Context parameter
+	GetReportSummaryWithContext(ctx context.Context, getReportSummaryOptions *GetReportSummaryOptions) (result *ReportSummary, response *core.DetailedResponse, err error)
+
+	// GetReportDownloadFile : Get report evaluation details
+	GetReportDownloadFile(getReportDownloadFileOptions *GetReportDownloadFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetReportDownloadFileWithContext is an alternate form of the GetReportDownloadFile method which supports a Context parameter
+	GetReportDownloadFileWithContext(ctx context.Context, getReportDownloadFileOptions *GetReportDownloadFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetReportDownloadFileCSV : Get report evaluation details as CSV rows
+	GetReportDownloadFileCSV(getReportDownloadFileOptions *GetReportDownloadFileOptions) (result *ReportCSVDecoder, response *core.DetailedResponse, err error)
+
+	// GetReportDownloadFileCSVWithContext is an alternate form of the GetReportDownloadFileCSV method which supports a Context parameter
+	GetReportDownloadFileCSVWithContext(ctx context.Context, getReportDownloadFileOptions *GetReportDownloadFileOptions) (result *ReportCSVDecoder, response *core.DetailedResponse, err error)
+
+	// GetReportControls : Get report controls
+	GetReportControls(getReportControlsOptions *GetReportControlsOptions) (result *ReportControls, response *core.DetailedResponse, err error)
+
+	// GetReportControlsWithContext is an alternate form of the GetReportControls method which supports a Context parameter
+	GetReportControlsWithContext(ctx context.Context, getReportControlsOptions *GetReportControlsOptions) (result *ReportControls, response *core.DetailedResponse, err error)
+
+	// GetReportRule : Get a report rule
+	GetReportRule(getReportRuleOptions *GetReportRuleOptions) (result *RuleInfo, response *core.DetailedResponse, err error)
+
+	// GetReportRuleWithContext is an alternate form of the GetReportRule method which supports a Context parameter
+	GetReportRuleWithContext(ctx context.Context, getReportRuleOptions *GetReportRuleOptions) (result *RuleInfo, response *core.DetailedResponse, err error)
+
+	// ListReportEvaluations : List report evaluations
+	ListReportEvaluations(listReportEvaluationsOptions *ListReportEvaluationsOptions) (result *EvaluationPage, response *core.DetailedResponse, err error)
+
+	// ListReportEvaluationsWithContext is an alternate form of the ListReportEvaluations method which supports a Context parameter
+	ListReportEvaluationsWithContext(ctx context.Context, listReportEvaluationsOptions *ListReportEvaluationsOptions) (result *EvaluationPage, response *core.DetailedResponse, err error)
+
+	// ListReportResources : List report resources
+	ListReportResources(listReportResourcesOptions *ListReportResourcesOptions) (result *ResourcePage, response *core.DetailedResponse, err error)
+
+	// ListReportResourcesWithContext is an alternate form of the ListReportResources method which supports a Context parameter
+	ListReportResourcesWithContext(ctx context.Context, listReportResourcesOptions *ListReportResourcesOptions) (result *ResourcePage, response *core.DetailedResponse, err error)
+
+	// GetReportTags : List report tags
+	GetReportTags(getReportTagsOptions *GetReportTagsOptions) (result *ReportTags, response *core.DetailedResponse, err error)
+
+	// GetReportTagsWithContext is an alternate form of the GetReportTags method which supports a Context parameter
+	GetReportTagsWithContext(ctx context.Context, getReportTagsOptions *GetReportTagsOptions) (result *ReportTags, response *core.DetailedResponse, err error)
+
+	// GetReportViolationsDrift : Get report violations drift
+	GetReportViolationsDrift(getReportViolationsDriftOptions *GetReportViolationsDriftOptions) (result *ReportViolationsDrift, response *core.DetailedResponse, err error)
+
+	// GetReportViolationsDriftWithContext is an alternate form of the GetReportViolationsDrift method which supports a Context parameter
+	GetReportViolationsDriftWithContext(ctx context.Context, getReportViolationsDriftOptions *GetReportViolationsDriftOptions) (result *ReportViolationsDrift, response *core.DetailedResponse, err error)
+
+	// GetReportJUnit : Get report controls as JUnit test suites
+	GetReportJUnit(getReportJUnitOptions *GetReportJUnitOptions) (result *JUnitTestSuites, err error)
+
+	// GetReportJUnitWithContext is an alternate form of the GetReportJUnit method which supports a Context parameter
+	GetReportJUnitWithContext(ctx context.Context, getReportJUnitOptions *GetReportJUnitOptions) (result *JUnitTestSuites, err error)
+
+	// CompareReports : Compare the evaluations of two reports
+	CompareReports(compareReportsOptions *CompareReportsOptions) (result *ReportComparison, err error)
+
+	// CompareReportsWithContext is an alternate form of the CompareReports method which supports a Context parameter
+	CompareReportsWithContext(ctx context.Context, compareReportsOptions *CompareReportsOptions) (result *ReportComparison, err error)
+
+	// NewReportsPager returns a new ReportsPager instance.
+	NewReportsPager(options *ListReportsOptions) (pager *ReportsPager, err error)
+
+	// NewReportEvaluationsPager returns a new ReportEvaluationsPager instance.
+	NewReportEvaluationsPager(options *ListReportEvaluationsOptions) (pager *ReportEvaluationsPager, err error)
+
+	// NewReportResourcesPager returns a new ReportResourcesPager instance.
+	NewReportResourcesPager(options *ListReportResourcesOptions) (pager *ReportResourcesPager, err error)
+
+	// AllReports returns an iterator over all of the results of the "ListReports" method.
+	AllReports(ctx context.Context, options *ListReportsOptions) iter.Seq2[Report, error]
+
+	// AllReportEvaluations returns an iterator over all of the results of the "ListReportEvaluations" method.
+	AllReportEvaluations(ctx context.Context, options *ListReportEvaluationsOptions) iter.Seq2[Evaluation, error]
+
+	// AllReportResources returns an iterator over all of the results of the "ListReportResources" method.
+	AllReportResources(ctx context.Context, options *ListReportResourcesOptions) iter.Seq2[Resource, error]
+}
+
+// ScanReportsAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the scan report operations.
+type ScanReportsAPI interface {
+	// ListScanReports : List scan reports
+	ListScanReports(listScanReportsOptions *ListScanReportsOptions) (result *ScanReportCollection, response *core.DetailedResponse, err error)
+
+	// ListScanReportsWithContext is an alternate form of the ListScanReports method which supports a Context parameter
+	ListScanReportsWithContext(ctx context.Context, listScanReportsOptions *ListScanReportsOptions) (result *ScanReportCollection, response *core.DetailedResponse, err error)
+
+	// NewScanReportsPager returns a new ScanReportsPager instance.
+	NewScanReportsPager(options *ListScanReportsOptions) (pager *ScanReportsPager, err error)
+
+	// AllScanReports returns an iterator over all of the results of the "ListScanReports" method.
+	AllScanReports(ctx context.Context, options *ListScanReportsOptions) iter.Seq2[ScanReport, error]
+
+	// CreateScanReport : Create a scan report
+	CreateScanReport(createScanReportOptions *CreateScanReportOptions) (result *CreateScanReport, response *core.DetailedResponse, err error)
+
+	// CreateScanReportWithContext is an alternate form of the CreateScanReport method which supports a Context parameter
+	CreateScanReportWithContext(ctx context.Context, createScanReportOptions *CreateScanReportOptions) (result *CreateScanReport, response *core.DetailedResponse, err error)
+
+	// GetScanReport : Get a scan report
+	GetScanReport(getScanReportOptions *GetScanReportOptions) (result *ScanReport, response *core.DetailedResponse, err error)
+
+	// GetScanReportWithContext is an alternate form of the GetScanReport method which supports a Context parameter
+	GetScanReportWithContext(ctx context.Context, getScanReportOptions *GetScanReportOptions) (result *ScanReport, response *core.DetailedResponse, err error)
+
+	// GetScanReportDownloadFile : Get a scan report details
+	GetScanReportDownloadFile(getScanReportDownloadFileOptions *GetScanReportDownloadFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetScanReportDownloadFileWithContext is an alternate form of the GetScanReportDownloadFile method which supports a Context parameter
+	GetScanReportDownloadFileWithContext(ctx context.Context, getScanReportDownloadFileOptions *GetScanReportDownloadFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// DownloadScanReport : Export a report and download it to a file
+	DownloadScanReport(downloadScanReportOptions *DownloadScanReportOptions) (result *ScanReportDownload, err error)
+
+	// DownloadScanReportWithContext is an alternate form of the DownloadScanReport method which supports a Context parameter
+	DownloadScanReportWithContext(ctx context.Context, downloadScanReportOptions *DownloadScanReportOptions) (result *ScanReportDownload, err error)
+}
+
+// ServicesAPI is implemented by SecurityAndComplianceCenterAPIV3 and contains the service catalog operations.
+type ServicesAPI interface {
+	// ListServices : List services
+	ListServices(listServicesOptions *ListServicesOptions) (result *ServiceCollection, response *core.DetailedResponse, err error)
+
+	// ListServicesWithContext is an alternate form of the ListServices method which supports a Context parameter
+	ListServicesWithContext(ctx context.Context, listServicesOptions *ListServicesOptions) (result *ServiceCollection, response *core.DetailedResponse, err error)
+
+	// GetService : Get a service
+	GetService(getServiceOptions *GetServiceOptions) (result *Service, response *core.DetailedResponse, err error)
+
+	// GetServiceWithContext is an alternate form of the GetService method which supports a Context parameter
+	GetServiceWithContext(ctx context.Context, getServiceOptions *GetServiceOptions) (result *Service, response *core.DetailedResponse, err error)
+
+	// LoadRuleValidator : Load a rule validator
+	LoadRuleValidator(listServicesOptions *ListServicesOptions) (result *RuleValidator, err error)
+
+	// LoadRuleValidatorWithContext is an alternate form of the LoadRuleValidator method which supports a Context parameter
+	LoadRuleValidatorWithContext(ctx context.Context, listServicesOptions *ListServicesOptions) (result *RuleValidator, err error)
+}
@@ -0,0 +1,61 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package securityandcompliancecenterapiv3_test
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`SecurityAndComplianceCenterAPIV3Interface`, func() {
+	It(`Contains all of the operations of SecurityAndComplianceCenterAPIV3`, func() {
+		// The service configuration methods are not operations, and the options and model
+		// constructors do not use the service.
+		configuration := map[string]bool{
+			"Clone":                    true,
+			"SetServiceURL":            true,
+			"GetServiceURL":            true,
+			"SetDefaultHeaders":        true,
+			"SetEnableGzipCompression": true,
+			"GetEnableGzipCompression": true,
+			"EnableRetries":            true,
+			"DisableRetries":           true,
+			"EnableTelemetry":          true,
+			"DisableTelemetry":         true,
+			"Use":                      true,
+			"SetRateLimiter":           true,
+		}
+
+		serviceType := reflect.TypeOf(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3{})
+		interfaceType := reflect.TypeOf((*securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Interface)(nil)).Elem()
+		var missing []string
+		for i := 0; i < serviceType.NumMethod(); i++ {
+			name := serviceType.Method(i).Name
+			if configuration[name] || (strings.HasPrefix(name, "New") && !strings.HasSuffix(name, "Pager")) {
+				continue
+			}
+			if _, ok := interfaceType.MethodByName(name); !ok {
+				missing = append(missing, name)
+			}
+		}
+		Expect(missing).To(BeEmpty())
+		Expect(interfaceType.NumMethod()).To(BeNumerically(">", 150))
+	})
+})
@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// evaluationMessage describes why an evaluation failed, including the expected and found
+// values of the evaluated properties.
+func evaluationMessage(evaluation *Evaluation, assessmentID string) string {
+	var lines []string
+	if evaluation.Reason != nil && *evaluation.Reason != "" {
+		lines = append(lines, *evaluation.Reason)
+	} else {
+		lines = append(lines, fmt.Sprintf("Assessment %s %s for %s.", assessmentID, evaluationStatusVerb(evaluation.Status), evaluationTargetName(evaluation.Target)))
+	}
+	if evaluation.Details != nil {
+		for _, property := range evaluation.Details.Properties {
+			name := "(unnamed property)"
+			if property.Property != nil {
+				name = *property.Property
+			}
+			operator := ""
+			if property.Operator != nil {
+				operator = " " + *property.Operator
+			}
+			lines = append(lines, fmt.Sprintf("%s%s: expected %s, found %s", name, operator, evaluationValue(property.ExpectedValue), evaluationValue(property.FoundValue)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// evaluationStatusVerb returns the verb used in the default message of an evaluation.
+func evaluationStatusVerb(status *string) string {
+	if status != nil && *status == EvaluationStatusErrorConst {
+		return "could not be evaluated"
+	}
+	return "failed"
+}
+
+// evaluationValue formats an expected or found value for a message.
+func evaluationValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "nothing"
+	case string:
+		return fmt.Sprintf("%q", v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
+
+// evaluationTargetName returns the most specific identifier of the target.
+func evaluationTargetName(target *TargetInfo) string {
+	if key := evaluationTargetKey(target); key != "" {
+		return key
+	}
+	return "unknown resource"
+}
+
+// evaluationTargetKey returns the key that identifies a target across reports.
+func evaluationTargetKey(target *TargetInfo) string {
+	if target != nil {
+		for _, key := range []*string{target.ResourceCRN, target.ID, target.ResourceName} {
+			if key != nil && *key != "" {
+				return *key
+			}
+		}
+	}
+	return ""
+}
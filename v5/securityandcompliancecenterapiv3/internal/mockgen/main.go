@@ -0,0 +1,260 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command mockgen generates the testify based mock of the SecurityAndComplianceCenterAPIV3Interface
+// in the sccmock package from the interface declarations in client_interface.go.
+//
+// It is invoked by "go generate" in the sccmock package:
+//
+//	go run ../internal/mockgen -source ../client_interface.go -output mock.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	interfaceName = "SecurityAndComplianceCenterAPIV3Interface"
+	mockName      = "SecurityAndComplianceCenterAPIV3"
+	mockPackage   = "sccmock"
+	servicePath   = "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	serviceName   = "securityandcompliancecenterapiv3"
+	mockPath      = "github.com/stretchr/testify/mock"
+)
+
+const license = `/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+`
+
+func main() {
+	source := flag.String("source", "../client_interface.go", "the file that declares the interfaces")
+	output := flag.String("output", "mock.go", "the file to write the mock to")
+	flag.Parse()
+
+	src, err := os.ReadFile(*source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	generated, err := generate(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, generated, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the source of the mock of the interfaces declared in "src".
+func generate(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := make(map[string]*ast.InterfaceType)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if iface, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+				interfaces[typeSpec.Name.Name] = iface
+			}
+		}
+	}
+	if interfaces[interfaceName] == nil {
+		return nil, fmt.Errorf("interface %s not found", interfaceName)
+	}
+
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+
+	g := &generator{imports: imports, used: map[string]bool{serviceName: true}}
+	if err := g.methods(interfaces, interfaces[interfaceName]); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	out.WriteString(license)
+	fmt.Fprintf(&out, "// Code generated by internal/mockgen from client_interface.go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", mockPackage)
+	standard, external := []string{}, []string{servicePath, mockPath}
+	for name := range g.used {
+		switch path := imports[name]; {
+		case name == serviceName:
+		case strings.Contains(strings.Split(path, "/")[0], "."):
+			external = append(external, path)
+		default:
+			standard = append(standard, path)
+		}
+	}
+	sort.Strings(standard)
+	sort.Strings(external)
+	fmt.Fprintf(&out, "import (\n")
+	for _, path := range standard {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	fmt.Fprintf(&out, "\n")
+	for _, path := range external {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	fmt.Fprintf(&out, ")\n\n")
+	fmt.Fprintf(&out, "// %s is a mock implementation of %s.%s.\n", mockName, serviceName, interfaceName)
+	fmt.Fprintf(&out, "type %s struct {\n\tmock.Mock\n}\n\n", mockName)
+	fmt.Fprintf(&out, "var _ %s.%s = (*%s)(nil)\n", serviceName, interfaceName, mockName)
+	out.Write(g.body.Bytes())
+	return format.Source(out.Bytes())
+}
+
+// generator accumulates the mock methods and the packages that they use.
+type generator struct {
+	imports map[string]string
+	used    map[string]bool
+	body    bytes.Buffer
+}
+
+// methods generates the mock methods of the interface, including those of the embedded interfaces.
+func (g *generator) methods(interfaces map[string]*ast.InterfaceType, iface *ast.InterfaceType) error {
+	for _, field := range iface.Methods.List {
+		switch t := field.Type.(type) {
+		case *ast.Ident:
+			embedded, ok := interfaces[t.Name]
+			if !ok {
+				return fmt.Errorf("embedded interface %s not found", t.Name)
+			}
+			if err := g.methods(interfaces, embedded); err != nil {
+				return err
+			}
+		case *ast.FuncType:
+			g.method(field.Names[0].Name, field.Doc, t)
+		default:
+			return fmt.Errorf("unsupported interface element %T", field.Type)
+		}
+	}
+	return nil
+}
+
+// method generates a mock method, which records the call and returns either the result of a function
+// passed to Return, or the values passed to Return.
+func (g *generator) method(name string, doc *ast.CommentGroup, fn *ast.FuncType) {
+	var params, args, paramTypes, resultTypes, results []string
+	for _, field := range fn.Params.List {
+		for _, ident := range field.Names {
+			params = append(params, ident.Name+" "+g.typeString(field.Type))
+			args = append(args, ident.Name)
+			paramTypes = append(paramTypes, g.typeString(field.Type))
+		}
+	}
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			count := len(field.Names)
+			if count == 0 {
+				count = 1
+			}
+			for i := 0; i < count; i++ {
+				resultType := g.typeString(field.Type)
+				results = append(results, fmt.Sprintf("get[%s](ret, %d)", resultType, len(resultTypes)))
+				resultTypes = append(resultTypes, resultType)
+			}
+		}
+	}
+
+	signature := "(" + strings.Join(resultTypes, ", ") + ")"
+	if len(resultTypes) == 1 {
+		signature = resultTypes[0]
+	}
+
+	fmt.Fprintf(&g.body, "\n")
+	if doc != nil {
+		for _, comment := range doc.List {
+			fmt.Fprintf(&g.body, "%s\n", comment.Text)
+		}
+	}
+	fmt.Fprintf(&g.body, "func (_m *%s) %s(%s) %s {\n", mockName, name, strings.Join(params, ", "), signature)
+	fmt.Fprintf(&g.body, "\tret := _m.Called(%s)\n", strings.Join(args, ", "))
+	fmt.Fprintf(&g.body, "\tif fn, ok := returnFunc(ret).(func(%s) %s); ok {\n", strings.Join(paramTypes, ", "), signature)
+	fmt.Fprintf(&g.body, "\t\treturn fn(%s)\n\t}\n", strings.Join(args, ", "))
+	fmt.Fprintf(&g.body, "\treturn %s\n}\n", strings.Join(results, ", "))
+}
+
+// typeString formats a type expression of the interface file for use in the mock package,
+// qualifying the types declared by the service package.
+func (g *generator) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return serviceName + "." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		g.used[pkg] = true
+		return pkg + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + g.typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + g.typeString(t.Key) + "]" + g.typeString(t.Value)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.IndexExpr:
+		return g.typeString(t.X) + "[" + g.typeString(t.Index) + "]"
+	case *ast.IndexListExpr:
+		var indices []string
+		for _, index := range t.Indices {
+			indices = append(indices, g.typeString(index))
+		}
+		return g.typeString(t.X) + "[" + strings.Join(indices, ", ") + "]"
+	default:
+		panic(fmt.Sprintf("unsupported type expression %T", expr))
+	}
+}
@@ -0,0 +1,42 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockIsUpToDate(t *testing.T) {
+	src, err := os.ReadFile("../../client_interface.go")
+	assert.Nil(t, err)
+	generated, err := generate(src)
+	assert.Nil(t, err)
+
+	existing, err := os.ReadFile("../../sccmock/mock.go")
+	assert.Nil(t, err)
+	assert.Equal(t, string(existing), string(generated), "run \"go generate\" in the sccmock package")
+}
+
+func TestGenerateRejectsUnknownInterfaces(t *testing.T) {
+	_, err := generate([]byte("package securityandcompliancecenterapiv3\n\ntype SecurityAndComplianceCenterAPIV3Interface interface {\n\tUnknownAPI\n}\n"))
+	assert.NotNil(t, err)
+
+	_, err = generate([]byte("package securityandcompliancecenterapiv3\n"))
+	assert.NotNil(t, err)
+}
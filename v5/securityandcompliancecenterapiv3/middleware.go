@@ -0,0 +1,74 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"net/http"
+)
+
+// Middleware wraps the transport that sends the requests of a client, to inspect or change the
+// requests and their responses: for example to log the requests, add headers, sign the requests,
+// validate the responses, or inject failures in tests.
+//
+// The context of each request identifies the operation that sends it, with GetOperationID and
+// GetOperationOptions. The middleware must not modify the request it is given: it passes a copy,
+// made with Clone, to change the request.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// Use : Add middleware to the requests of the client
+// Each middleware wraps the transport of the client in turn, so the last middleware sees the
+// requests first. With retries enabled, the middleware sees each retry. Add the middleware after
+// any call to SetHTTPClient.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) Use(middleware ...Middleware) {
+	securityAndComplianceCenterApi.wrapTransport(func(transport http.RoundTripper) http.RoundTripper {
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		for _, m := range middleware {
+			transport = newMiddlewareTransport(m, transport)
+		}
+		return transport
+	})
+}
+
+// middlewareTransport is the transport returned by a middleware, which keeps the transport that the
+// middleware wraps so that the layers of the client beneath it can be replaced.
+type middlewareTransport struct {
+	http.RoundTripper
+	middleware Middleware
+	next       http.RoundTripper
+}
+
+func newMiddlewareTransport(middleware Middleware, next http.RoundTripper) *middlewareTransport {
+	return &middlewareTransport{RoundTripper: middleware(next), middleware: middleware, next: next}
+}
+
+func (transport *middlewareTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *middlewareTransport) wrap(next http.RoundTripper) http.RoundTripper {
+	return newMiddlewareTransport(transport.middleware, next)
+}
+
+// RoundTripperFunc is an http.RoundTripper implemented by a function, to write middleware.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip invokes the function.
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,212 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Use`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	var testServer *httptest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var tenants []string
+	var status int
+
+	BeforeEach(func() {
+		tenants = nil
+		status = 200
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			tenants = append(tenants, req.Header.Get("X-Tenant"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(status)
+			if status >= 400 {
+				fmt.Fprint(res, `{"errors": [{"code": "unavailable", "message": "Service unavailable"}]}`)
+				return
+			}
+			fmt.Fprint(res, `{"id": "report-1", "instance_id": "`+instanceID+`"}`)
+		}))
+		var err error
+		securityAndComplianceCenterAPIService, err = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke operations with audit and header middleware`, func() {
+		var audit []string
+		auditLog := func(next http.RoundTripper) http.RoundTripper {
+			return securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				resp, err := next.RoundTrip(req)
+				entry := securityandcompliancecenterapiv3.GetOperationID(req.Context())
+				if options, ok := securityandcompliancecenterapiv3.GetOperationOptions(req.Context()).(*securityandcompliancecenterapiv3.GetReportOptions); ok {
+					entry += " " + *options.ReportID
+				}
+				if err == nil {
+					entry += fmt.Sprintf(" %d", resp.StatusCode)
+				}
+				audit = append(audit, entry)
+				return resp, err
+			})
+		}
+		tenantHeader := func(next http.RoundTripper) http.RoundTripper {
+			return securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req = req.Clone(req.Context())
+				req.Header.Set("X-Tenant", "tenant-"+securityandcompliancecenterapiv3.GetOperationID(req.Context()))
+				return next.RoundTrip(req)
+			})
+		}
+		securityAndComplianceCenterAPIService.Use(auditLog, tenantHeader)
+
+		_, _, err := securityAndComplianceCenterAPIService.GetReport(securityAndComplianceCenterAPIService.NewGetReportOptions("report-1", instanceID))
+		Expect(err).To(BeNil())
+		_, _, err = securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+
+		Expect(audit).To(Equal([]string{"GetReport report-1 200", "GetSettings 200"}))
+		Expect(tenants).To(Equal([]string{"tenant-GetReport", "tenant-GetSettings"}))
+	})
+	It(`Invoke operations with middleware in order`, func() {
+		var calls []string
+		named := func(name string) securityandcompliancecenterapiv3.Middleware {
+			return func(next http.RoundTripper) http.RoundTripper {
+				return securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					calls = append(calls, name)
+					return next.RoundTrip(req)
+				})
+			}
+		}
+		securityAndComplianceCenterAPIService.Use(named("first"), named("second"))
+		securityAndComplianceCenterAPIService.Use(named("third"))
+		securityAndComplianceCenterAPIService.EnableRetries(1, 10*time.Millisecond)
+
+		status = 503
+		_, _, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).ToNot(BeNil())
+		Expect(calls).To(Equal([]string{"third", "second", "first", "third", "second", "first"}))
+	})
+	It(`Invoke Use on a clone`, func() {
+		tenantHeader := func(next http.RoundTripper) http.RoundTripper {
+			return securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req = req.Clone(req.Context())
+				req.Header.Set("X-Tenant", "clone")
+				return next.RoundTrip(req)
+			})
+		}
+		clone := securityAndComplianceCenterAPIService.Clone()
+		clone.Use(tenantHeader)
+
+		_, _, err := clone.GetSettings(clone.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		_, _, err = securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(tenants).To(Equal([]string{"clone", ""}))
+	})
+	It(`Invoke Use on a clone with retries enabled`, func() {
+		tenantHeader := func(next http.RoundTripper) http.RoundTripper {
+			return securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req = req.Clone(req.Context())
+				req.Header.Set("X-Tenant", "clone")
+				return next.RoundTrip(req)
+			})
+		}
+		securityAndComplianceCenterAPIService.EnableRetries(1, 10*time.Millisecond)
+		clone := securityAndComplianceCenterAPIService.Clone()
+		clone.Use(tenantHeader)
+
+		// The clone still retries the requests, through the middleware.
+		status = 503
+		_, _, err := clone.GetSettings(clone.NewGetSettingsOptions(instanceID))
+		Expect(err).ToNot(BeNil())
+		status = 200
+		_, _, err = securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(tenants).To(Equal([]string{"clone", "clone", ""}))
+	})
+	It(`Invoke SetRateLimiter beneath middleware`, func() {
+		var calls int
+		securityAndComplianceCenterAPIService.SetRateLimiter(securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0))
+		securityAndComplianceCenterAPIService.Use(func(next http.RoundTripper) http.RoundTripper {
+			return securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls++
+				return next.RoundTrip(req)
+			})
+		})
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0)
+		securityAndComplianceCenterAPIService.SetRateLimiter(limiter)
+
+		_, _, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal(1))
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(1)))
+
+		securityAndComplianceCenterAPIService.SetRateLimiter(nil)
+		_, _, err = securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal(2))
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(1)))
+	})
+	It(`Invoke operations with failing middleware`, func() {
+		chaos := errors.New("injected failure")
+		securityAndComplianceCenterAPIService.Use(func(next http.RoundTripper) http.RoundTripper {
+			return securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if securityandcompliancecenterapiv3.GetOperationID(req.Context()) == "GetReport" {
+					return nil, chaos
+				}
+				return next.RoundTrip(req)
+			})
+		})
+
+		_, _, err := securityAndComplianceCenterAPIService.GetReport(securityAndComplianceCenterAPIService.NewGetReportOptions("report-1", instanceID))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("injected failure"))
+		Expect(tenants).To(BeEmpty())
+
+		_, _, err = securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+	})
+	It(`Invoke operations with response validation middleware`, func() {
+		securityAndComplianceCenterAPIService.Use(func(next http.RoundTripper) http.RoundTripper {
+			return securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				resp, err := next.RoundTrip(req)
+				if err == nil && !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+					resp.Body.Close()
+					return nil, fmt.Errorf("unexpected content type %q", resp.Header.Get("Content-Type"))
+				}
+				return resp, err
+			})
+		})
+		_, _, err := securityAndComplianceCenterAPIService.GetReport(securityAndComplianceCenterAPIService.NewGetReportOptions("report-1", instanceID))
+		Expect(err).To(BeNil())
+		Expect(securityandcompliancecenterapiv3.GetOperationOptions(context.Background())).To(BeNil())
+	})
+})
@@ -0,0 +1,349 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// defaultRegion is the region of DefaultServiceURL, which is preferred when no regions are given.
+const defaultRegion = "us-south"
+
+// GetRegions returns the regions of the built-in region table used by GetServiceURLForRegion.
+func GetRegions() []string {
+	regions := make([]string, 0, len(serviceURLsByRegion))
+	for region := range serviceURLsByRegion {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// MultiRegionOptions : The options of NewMultiRegionSecurityAndComplianceCenterAPIV3.
+type MultiRegionOptions struct {
+	// The authenticator, which is used in all regions.
+	Authenticator core.Authenticator
+
+	// The regions, in order of preference. The first region serves the requests that are not scoped
+	// to an instance, such as ListServices. Defaults to all the regions of the region table,
+	// starting with "us-south".
+	Regions []string
+
+	// The service URLs of the regions, which override or extend the built-in region table, for
+	// example with private or restricted endpoints.
+	ServiceURLs map[string]string
+
+	// The regions of the instances, by instance ID. The region of any other instance is discovered
+	// on its first request, by retrieving its settings in each region in turn with GetSettings,
+	// through the rate limiter, the telemetry and the middleware of the client.
+	InstanceRegions map[string]string
+
+	// Fail over the read-only requests, such as getting or listing reports and evaluations, to the
+	// next regions when a region can't be reached or responds with a 5xx status code.
+	Failover bool
+}
+
+// MultiRegionSecurityAndComplianceCenterAPIV3 : A client that routes the requests of each instance to the
+// region that owns the instance.
+//
+// All of the operations of SecurityAndComplianceCenterAPIV3 are available. The requests that are scoped to
+// an instance are sent to the region of the instance, and the other requests to the first region.
+type MultiRegionSecurityAndComplianceCenterAPIV3 struct {
+	*SecurityAndComplianceCenterAPIV3
+
+	routes *regionRoutes
+}
+
+// NewMultiRegionSecurityAndComplianceCenterAPIV3 : constructs an instance of
+// MultiRegionSecurityAndComplianceCenterAPIV3 with passed in options.
+func NewMultiRegionSecurityAndComplianceCenterAPIV3(options *MultiRegionOptions) (service *MultiRegionSecurityAndComplianceCenterAPIV3, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	routes := &regionRoutes{
+		urls:      make(map[string]string),
+		instances: make(map[string]string),
+		failover:  options.Failover,
+	}
+	for region, url := range serviceURLsByRegion {
+		routes.urls[region] = url
+	}
+	for region, url := range options.ServiceURLs {
+		routes.urls[region] = strings.TrimRight(url, "/")
+	}
+
+	routes.regions = options.Regions
+	if len(routes.regions) == 0 {
+		for region := range routes.urls {
+			if region != defaultRegion {
+				routes.regions = append(routes.regions, region)
+			}
+		}
+		sort.Strings(routes.regions)
+		if _, ok := routes.urls[defaultRegion]; ok {
+			routes.regions = append([]string{defaultRegion}, routes.regions...)
+		}
+	}
+	for _, region := range routes.regions {
+		if _, ok := routes.urls[region]; !ok {
+			err = core.SDKErrorf(nil, fmt.Sprintf("service URL for region '%s' not found", region), "invalid-region", common.GetComponentInfo())
+			return
+		}
+	}
+	for instanceID, region := range options.InstanceRegions {
+		err = routes.setInstanceRegion(instanceID, region)
+		if err != nil {
+			return
+		}
+	}
+
+	client, err := NewSecurityAndComplianceCenterAPIV3(&SecurityAndComplianceCenterAPIV3Options{
+		URL:           routes.urls[routes.regions[0]],
+		Authenticator: options.Authenticator,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "new-client-error")
+		return
+	}
+
+	routes.service = client
+	service = &MultiRegionSecurityAndComplianceCenterAPIV3{
+		SecurityAndComplianceCenterAPIV3: client,
+		routes:                           routes,
+	}
+	service.SetHTTPClient(client.Service.GetHTTPClient())
+	return
+}
+
+// SetHTTPClient sets the HTTP client used to send the requests to all regions. The service uses a
+// copy of the client, whose transport routes the requests, and leaves the client unchanged.
+func (service *MultiRegionSecurityAndComplianceCenterAPIV3) SetHTTPClient(client *http.Client) {
+	routed := *client
+	routed.Transport = withTransportLayer[*regionRouter](routed.Transport, func(next http.RoundTripper) http.RoundTripper {
+		return &regionRouter{next: next, regionRoutes: service.routes}
+	})
+	service.Service.SetHTTPClient(&routed)
+}
+
+// GetRegions returns the regions of the client, in order of preference.
+func (service *MultiRegionSecurityAndComplianceCenterAPIV3) GetRegions() []string {
+	return append([]string(nil), service.routes.regions...)
+}
+
+// GetInstanceRegion returns the region of an instance, if it is known.
+func (service *MultiRegionSecurityAndComplianceCenterAPIV3) GetInstanceRegion(instanceID string) (region string, ok bool) {
+	service.routes.mutex.Lock()
+	defer service.routes.mutex.Unlock()
+	region, ok = service.routes.instances[instanceID]
+	return
+}
+
+// SetInstanceRegion sets the region of an instance, which must be one of the regions of the client.
+func (service *MultiRegionSecurityAndComplianceCenterAPIV3) SetInstanceRegion(instanceID string, region string) error {
+	return service.routes.setInstanceRegion(instanceID, region)
+}
+
+// regionRoutes are the regions of a MultiRegionSecurityAndComplianceCenterAPIV3 and the regions of
+// its instances, which are shared by the routers of the HTTP clients of the service.
+type regionRoutes struct {
+	regions  []string
+	urls     map[string]string
+	failover bool
+
+	// The service whose operations probe the regions of the instances.
+	service *SecurityAndComplianceCenterAPIV3
+
+	mutex     sync.Mutex
+	instances map[string]string
+}
+
+func (routes *regionRoutes) setInstanceRegion(instanceID string, region string) error {
+	if !containsString(routes.regions, region) {
+		return core.SDKErrorf(nil, fmt.Sprintf("region '%s' is not one of the regions of the client", region), "invalid-region", common.GetComponentInfo())
+	}
+	routes.mutex.Lock()
+	defer routes.mutex.Unlock()
+	routes.instances[instanceID] = region
+	return nil
+}
+
+// regionRouter is the transport of a MultiRegionSecurityAndComplianceCenterAPIV3. The requests are
+// built for the service URL of the first region, and sent to the region of their instance.
+type regionRouter struct {
+	next http.RoundTripper
+	*regionRoutes
+}
+
+func (router *regionRouter) unwrap() http.RoundTripper {
+	return router.next
+}
+
+func (router *regionRouter) wrap(next http.RoundTripper) http.RoundTripper {
+	return &regionRouter{next: next, regionRoutes: router.regionRoutes}
+}
+
+// RoundTrip sends the request to the region of its instance and, for read-only requests, fails
+// over to the next regions.
+func (router *regionRouter) RoundTrip(req *http.Request) (resp *http.Response, err error) {
+	// Requests for another service URL, set with SetServiceURL, are sent as they are.
+	primaryURL := router.urls[router.regions[0]]
+	target := req.URL.String()
+	if !strings.HasPrefix(target, primaryURL+"/") {
+		return router.next.RoundTrip(req)
+	}
+	relativeURL := strings.TrimPrefix(target, primaryURL)
+
+	// The probes of instanceRegion are sent to the region that they probe.
+	if region, ok := req.Context().Value(probeRegionContextKey{}).(string); ok {
+		return router.send(req, region, relativeURL)
+	}
+
+	regions := router.regions
+	if instanceID := requestInstanceID(relativeURL); instanceID != "" {
+		var region string
+		region, err = router.instanceRegion(req, instanceID)
+		if err != nil {
+			return
+		}
+		regions = append([]string{region}, removeString(router.regions, region)...)
+	}
+	if !router.failover || req.Method != http.MethodGet {
+		regions = regions[:1]
+	}
+
+	for i, region := range regions {
+		resp, err = router.send(req, region, relativeURL)
+		if i == len(regions)-1 || req.Context().Err() != nil || (err == nil && resp.StatusCode < 500) {
+			return
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+	}
+	return
+}
+
+// send sends a request to a region.
+func (router *regionRouter) send(req *http.Request, region string, relativeURL string) (*http.Response, error) {
+	target, err := url.Parse(router.urls[region] + relativeURL)
+	if err != nil {
+		return nil, err
+	}
+	routed := req.Clone(req.Context())
+	routed.URL = target
+	if req.Host == req.URL.Host {
+		routed.Host = ""
+	}
+	return router.next.RoundTrip(routed)
+}
+
+// instanceRegion returns the region of an instance, discovering it if needed. The first region
+// whose settings of the instance can be retrieved owns the instance. The first region of the client
+// is assumed if every region responds without the settings, and an error is returned if a region
+// can't be reached, since the region might own the instance.
+func (router *regionRouter) instanceRegion(req *http.Request, instanceID string) (string, error) {
+	router.mutex.Lock()
+	region, ok := router.instances[instanceID]
+	router.mutex.Unlock()
+	if ok || len(router.regions) == 1 {
+		if !ok {
+			region = router.regions[0]
+		}
+		return region, nil
+	}
+
+	var probeErr error
+	for _, region := range router.regions {
+		getSettingsOptions := &GetSettingsOptions{
+			InstanceID: core.StringPtr(instanceID),
+		}
+		_, response, err := router.service.GetSettingsWithContext(probeContext(req.Context(), region), getSettingsOptions)
+		if err == nil {
+			router.mutex.Lock()
+			router.instances[instanceID] = region
+			router.mutex.Unlock()
+			return region, nil
+		}
+		if req.Context().Err() != nil {
+			return "", req.Context().Err()
+		}
+		if response == nil {
+			probeErr = err
+		}
+	}
+	if probeErr != nil {
+		return "", core.SDKErrorf(probeErr, fmt.Sprintf("the region of instance %s could not be determined", instanceID), "instance-region-error", common.GetComponentInfo())
+	}
+
+	router.mutex.Lock()
+	router.instances[instanceID] = router.regions[0]
+	router.mutex.Unlock()
+	return router.regions[0], nil
+}
+
+// probeRegionContextKey is the key of the region that a probe of instanceRegion is sent to.
+type probeRegionContextKey struct{}
+
+// probeContext returns a copy of "ctx", the context of a request, for the probe of "region" sent
+// for the request. The probe is neither the page of a pager nor the request whose ID is recorded
+// by WithSentRequestID.
+func probeContext(ctx context.Context, region string) context.Context {
+	ctx = context.WithValue(ctx, probeRegionContextKey{}, region)
+	ctx = context.WithValue(ctx, pagerContextKey{}, false)
+	ctx = context.WithValue(ctx, pageStartContextKey{}, nil)
+	return context.WithValue(ctx, sentRequestIDContextKey{}, (*sentRequestID)(nil))
+}
+
+// requestInstanceID returns the ID of the instance that a request is scoped to, if any, from the
+// URL of the request relative to the service URL.
+func requestInstanceID(relativeURL string) string {
+	path, _, _ := strings.Cut(relativeURL, "?")
+	segments := strings.Split(path, "/")
+	if len(segments) < 3 || segments[1] != "instances" {
+		return ""
+	}
+	instanceID, err := url.PathUnescape(segments[2])
+	if err != nil {
+		return ""
+	}
+	return instanceID
+}
+
+// removeString returns a copy of "list" without "s".
+func removeString(list []string, s string) []string {
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		if item != s {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,279 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// regionServer is a fake region of the service that owns some instances.
+type regionServer struct {
+	*httptest.Server
+
+	name      string
+	instances map[string]bool
+
+	// The status of the responses to the requests other than the settings, if not 200.
+	status int
+
+	mutex    sync.Mutex
+	requests []string
+}
+
+func newRegionServer(name string, instances ...string) *regionServer {
+	region := &regionServer{name: name, instances: make(map[string]bool)}
+	for _, instanceID := range instances {
+		region.instances[instanceID] = true
+	}
+	region.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		region.mutex.Lock()
+		region.requests = append(region.requests, req.Method+" "+req.URL.Path)
+		region.mutex.Unlock()
+
+		res.Header().Set("Content-type", "application/json")
+		segments := strings.Split(req.URL.Path, "/")
+		if len(segments) > 2 && segments[1] == "instances" && !region.instances[segments[2]] {
+			res.WriteHeader(404)
+			fmt.Fprintf(res, `{"errors": [{"code": "not_found", "message": "instance %s not found"}]}`, segments[2])
+			return
+		}
+		if region.status != 0 && !strings.HasSuffix(req.URL.Path, "/settings") {
+			res.WriteHeader(region.status)
+			fmt.Fprint(res, `{"errors": [{"code": "unavailable", "message": "the region is unavailable"}]}`)
+			return
+		}
+		res.WriteHeader(200)
+		fmt.Fprintf(res, `{"id": "%s"}`, region.name)
+	}))
+	return region
+}
+
+func (region *regionServer) Requests() []string {
+	region.mutex.Lock()
+	defer region.mutex.Unlock()
+	return append([]string(nil), region.requests...)
+}
+
+var _ = Describe(`MultiRegionSecurityAndComplianceCenterAPIV3`, func() {
+	const dallasInstance = "1c13d739-b6b5-4e7c-ab9c-b5f3d7d56e5a"
+	const madridInstance = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	var dallas, madrid *regionServer
+
+	newClient := func(options *securityandcompliancecenterapiv3.MultiRegionOptions) *securityandcompliancecenterapiv3.MultiRegionSecurityAndComplianceCenterAPIV3 {
+		options.Authenticator = &core.NoAuthAuthenticator{}
+		options.ServiceURLs = map[string]string{
+			"us-south": dallas.URL,
+			"eu-es":    madrid.URL + "/",
+		}
+		if options.Regions == nil {
+			options.Regions = []string{"us-south", "eu-es"}
+		}
+		client, err := securityandcompliancecenterapiv3.NewMultiRegionSecurityAndComplianceCenterAPIV3(options)
+		Expect(err).To(BeNil())
+		return client
+	}
+
+	BeforeEach(func() {
+		dallas = newRegionServer("us-south", dallasInstance)
+		madrid = newRegionServer("eu-es", madridInstance)
+	})
+	AfterEach(func() {
+		dallas.Close()
+		madrid.Close()
+	})
+
+	It(`Invoke GetRegions successfully`, func() {
+		Expect(securityandcompliancecenterapiv3.GetRegions()).To(Equal([]string{"au-syd", "ca-tor", "eu-de", "eu-es", "eu-fr2", "us-south"}))
+
+		client, err := securityandcompliancecenterapiv3.NewMultiRegionSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.MultiRegionOptions{
+			Authenticator: &core.NoAuthAuthenticator{},
+			ServiceURLs: map[string]string{
+				"eu-fr2":     "https://private.eu-fr2.compliance.cloud.ibm.com",
+				"us-private": "https://private.us-south.compliance.cloud.ibm.com",
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(client.GetRegions()).To(Equal([]string{"us-south", "au-syd", "ca-tor", "eu-de", "eu-es", "eu-fr2", "us-private"}))
+		Expect(client.GetServiceURL()).To(Equal("https://us-south.compliance.cloud.ibm.com"))
+	})
+	It(`Invoke NewMultiRegionSecurityAndComplianceCenterAPIV3 with an unknown region`, func() {
+		client, err := securityandcompliancecenterapiv3.NewMultiRegionSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.MultiRegionOptions{
+			Authenticator: &core.NoAuthAuthenticator{},
+			Regions:       []string{"us-south", "mars-1"},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("service URL for region 'mars-1' not found"))
+		Expect(client).To(BeNil())
+
+		client, err = securityandcompliancecenterapiv3.NewMultiRegionSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.MultiRegionOptions{
+			Authenticator:   &core.NoAuthAuthenticator{},
+			Regions:         []string{"us-south"},
+			InstanceRegions: map[string]string{madridInstance: "eu-es"},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("region 'eu-es' is not one of the regions of the client"))
+		Expect(client).To(BeNil())
+	})
+	It(`Invoke operations of instances of known regions`, func() {
+		client := newClient(&securityandcompliancecenterapiv3.MultiRegionOptions{
+			InstanceRegions: map[string]string{madridInstance: "eu-es"},
+		})
+		Expect(client.SetInstanceRegion(dallasInstance, "us-south")).To(Succeed())
+
+		report, _, err := client.GetReport(client.NewGetReportOptions("report-1", madridInstance))
+		Expect(err).To(BeNil())
+		Expect(*report.ID).To(Equal("eu-es"))
+		report, _, err = client.GetReport(client.NewGetReportOptions("report-2", dallasInstance))
+		Expect(err).To(BeNil())
+		Expect(*report.ID).To(Equal("us-south"))
+		_, _, err = client.ListServices(client.NewListServicesOptions())
+		Expect(err).To(BeNil())
+
+		Expect(madrid.Requests()).To(Equal([]string{"GET /instances/" + madridInstance + "/v3/reports/report-1"}))
+		Expect(dallas.Requests()).To(Equal([]string{"GET /instances/" + dallasInstance + "/v3/reports/report-2", "GET /v3/services"}))
+	})
+	It(`Invoke operations of instances of unknown regions`, func() {
+		client := newClient(&securityandcompliancecenterapiv3.MultiRegionOptions{})
+
+		for i := 0; i < 2; i++ {
+			report, _, err := client.GetReport(client.NewGetReportOptions("report-1", madridInstance))
+			Expect(err).To(BeNil())
+			Expect(*report.ID).To(Equal("eu-es"))
+		}
+		region, ok := client.GetInstanceRegion(madridInstance)
+		Expect(ok).To(BeTrue())
+		Expect(region).To(Equal("eu-es"))
+		Expect(dallas.Requests()).To(Equal([]string{"GET /instances/" + madridInstance + "/v3/settings"}))
+		Expect(madrid.Requests()).To(Equal([]string{
+			"GET /instances/" + madridInstance + "/v3/settings",
+			"GET /instances/" + madridInstance + "/v3/reports/report-1",
+			"GET /instances/" + madridInstance + "/v3/reports/report-1",
+		}))
+
+		// An instance that no region owns is left to the first region, which reports it, and the
+		// regions are not probed again.
+		for i := 0; i < 2; i++ {
+			_, response, err := client.GetReport(client.NewGetReportOptions("report-1", "missing"))
+			Expect(errors.Is(err, securityandcompliancecenterapiv3.ErrNotFound)).To(BeTrue())
+			Expect(response.StatusCode).To(Equal(404))
+		}
+		region, ok = client.GetInstanceRegion("missing")
+		Expect(ok).To(BeTrue())
+		Expect(region).To(Equal("us-south"))
+		Expect(madrid.Requests()).To(HaveLen(4))
+		Expect(dallas.Requests()).To(HaveLen(4))
+	})
+	It(`Invoke operations of instances of unknown regions through the middleware`, func() {
+		client := newClient(&securityandcompliancecenterapiv3.MultiRegionOptions{})
+		var operations []string
+		client.Use(func(next http.RoundTripper) http.RoundTripper {
+			return securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				operations = append(operations, securityandcompliancecenterapiv3.GetOperationID(req.Context())+" "+req.URL.Path)
+				return next.RoundTrip(req)
+			})
+		})
+
+		ctx := securityandcompliancecenterapiv3.WithSentRequestID(common.WithRequestID(context.Background(), "inbound-request-1"))
+		report, _, err := client.GetReportWithContext(ctx, client.NewGetReportOptions("report-1", madridInstance))
+		Expect(err).To(BeNil())
+		Expect(*report.ID).To(Equal("eu-es"))
+		Expect(securityandcompliancecenterapiv3.GetSentRequestID(ctx)).To(Equal("inbound-request-1"))
+		Expect(operations).To(Equal([]string{
+			"GetReport /instances/" + madridInstance + "/v3/reports/report-1",
+			"GetSettings /instances/" + madridInstance + "/v3/settings",
+			"GetSettings /instances/" + madridInstance + "/v3/settings",
+		}))
+		Expect(dallas.Requests()).To(Equal([]string{"GET /instances/" + madridInstance + "/v3/settings"}))
+	})
+	It(`Invoke operations of instances of unknown regions with an unreachable region`, func() {
+		client := newClient(&securityandcompliancecenterapiv3.MultiRegionOptions{})
+		dallas.Close()
+
+		_, response, err := client.GetReport(client.NewGetReportOptions("report-1", "missing"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the region of instance missing could not be determined"))
+		Expect(response).To(BeNil())
+		_, ok := client.GetInstanceRegion("missing")
+		Expect(ok).To(BeFalse())
+		Expect(madrid.Requests()).To(Equal([]string{"GET /instances/missing/v3/settings"}))
+	})
+	It(`Invoke SetHTTPClient without modifying the client`, func() {
+		client := newClient(&securityandcompliancecenterapiv3.MultiRegionOptions{
+			InstanceRegions: map[string]string{madridInstance: "eu-es"},
+		})
+		httpClient := &http.Client{}
+		client.SetHTTPClient(httpClient)
+		Expect(httpClient.Transport).To(BeNil())
+
+		// The client of the service can be set again without routing the requests twice.
+		client.SetHTTPClient(client.Service.GetHTTPClient())
+		report, _, err := client.GetReport(client.NewGetReportOptions("report-1", madridInstance))
+		Expect(err).To(BeNil())
+		Expect(*report.ID).To(Equal("eu-es"))
+		Expect(madrid.Requests()).To(Equal([]string{"GET /instances/" + madridInstance + "/v3/reports/report-1"}))
+	})
+	It(`Invoke read-only operations with failover`, func() {
+		madrid.instances[dallasInstance] = true
+		dallas.status = 503
+		client := newClient(&securityandcompliancecenterapiv3.MultiRegionOptions{
+			InstanceRegions: map[string]string{dallasInstance: "us-south"},
+			Failover:        true,
+		})
+
+		report, _, err := client.GetReport(client.NewGetReportOptions("report-1", dallasInstance))
+		Expect(err).To(BeNil())
+		Expect(*report.ID).To(Equal("eu-es"))
+
+		// Requests that change resources are not failed over.
+		_, response, err := client.CreateRule(client.NewCreateRuleOptions(dallasInstance, "A rule",
+			&securityandcompliancecenterapiv3.RuleTargetPrototype{ServiceName: core.StringPtr("cloud-object-storage"), ResourceKind: core.StringPtr("bucket")},
+			&securityandcompliancecenterapiv3.RequiredConfigConditionBase{Property: core.StringPtr("storage_class"), Operator: core.StringPtr("is_not_empty")}))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(503))
+		Expect(madrid.Requests()).To(Equal([]string{"GET /instances/" + dallasInstance + "/v3/reports/report-1"}))
+
+		// Unreachable regions are failed over too.
+		dallas.Close()
+		report, _, err = client.GetReport(client.NewGetReportOptions("report-2", dallasInstance))
+		Expect(err).To(BeNil())
+		Expect(*report.ID).To(Equal("eu-es"))
+	})
+	It(`Invoke read-only operations without failover`, func() {
+		madrid.instances[dallasInstance] = true
+		dallas.status = 500
+		client := newClient(&securityandcompliancecenterapiv3.MultiRegionOptions{
+			InstanceRegions: map[string]string{dallasInstance: "us-south"},
+		})
+
+		_, response, err := client.GetReport(client.NewGetReportOptions("report-1", dallasInstance))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(500))
+		Expect(madrid.Requests()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// operationContextKey is the key of the operation of a request within its context.
+type operationContextKey struct{}
+
+// pagerContextKey marks the context of the requests of a pager.
+type pagerContextKey struct{}
+
+// pageStartContextKey is the key of the start of the page that a pager requests, for the list
+// operations whose options have no start parameter.
+type pageStartContextKey struct{}
+
+// operation is the state of an invocation of an operation, shared by the requests sent for it
+// when retries are enabled.
+type operation struct {
+	id       string
+	options  interface{}
+	attempts atomic.Int32
+}
+
+// withOperation returns a copy of "ctx" for the requests of an operation, identified by the
+// operationId of the API definition, such as "GetReport", and invoked with "options".
+func withOperation(ctx context.Context, operationID string, options interface{}) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, &operation{id: operationID, options: options})
+}
+
+// operationFromContext returns the operation of a request, or nil.
+func operationFromContext(ctx context.Context) *operation {
+	op, _ := ctx.Value(operationContextKey{}).(*operation)
+	return op
+}
+
+// GetOperationID returns the operationId of the API definition, such as "GetReport", of the
+// operation that sends a request, given the context of the request. It returns an empty string
+// for the requests that are not sent by an operation of the service.
+func GetOperationID(ctx context.Context) string {
+	if op := operationFromContext(ctx); op != nil {
+		return op.id
+	}
+	return ""
+}
+
+// GetOperationOptions returns the options, such as a *GetReportOptions, that the operation that
+// sends a request was invoked with, given the context of the request. It returns nil for the
+// requests that are not sent by an operation of the service.
+func GetOperationOptions(ctx context.Context) interface{} {
+	if op := operationFromContext(ctx); op != nil {
+		return op.options
+	}
+	return nil
+}
+
+// withPager returns a copy of "ctx" for the requests of a pager.
+func withPager(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pagerContextKey{}, true)
+}
+
+// isPagerRequest returns true if a request is sent by a pager, given its context.
+func isPagerRequest(ctx context.Context) bool {
+	pager, _ := ctx.Value(pagerContextKey{}).(bool)
+	return pager
+}
+
+// withPageStart returns a copy of "ctx" for the request of the page that starts at "start", or
+// "ctx" itself for the first page.
+func withPageStart(ctx context.Context, start *string) context.Context {
+	if start == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, pageStartContextKey{}, *start)
+}
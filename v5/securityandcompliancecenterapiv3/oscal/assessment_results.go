@@ -0,0 +1,504 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oscal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/google/uuid"
+)
+
+// AssessmentResults is an OSCAL assessment results document.
+type AssessmentResults struct {
+	UUID     string   `json:"uuid"`
+	Metadata Metadata `json:"metadata"`
+	ImportAP ImportAP `json:"import-ap"`
+	Results  []Result `json:"results"`
+}
+
+// ImportAP is the reference to the assessment plan of assessment results.
+type ImportAP struct {
+	Href    string `json:"href"`
+	Remarks string `json:"remarks,omitempty"`
+}
+
+// Result is a set of assessment results, such as the evaluations of one scan.
+type Result struct {
+	UUID             string            `json:"uuid"`
+	Title            string            `json:"title"`
+	Description      string            `json:"description"`
+	Start            time.Time         `json:"start"`
+	End              *time.Time        `json:"end,omitempty"`
+	Props            []Property        `json:"props,omitempty"`
+	LocalDefinitions *LocalDefinitions `json:"local-definitions,omitempty"`
+	ReviewedControls ReviewedControls  `json:"reviewed-controls"`
+	Observations     []Observation     `json:"observations,omitempty"`
+	Findings         []Finding         `json:"findings,omitempty"`
+}
+
+// LocalDefinitions holds the objects referenced by a result.
+type LocalDefinitions struct {
+	InventoryItems []InventoryItem `json:"inventory-items,omitempty"`
+}
+
+// InventoryItem is an assessed resource.
+type InventoryItem struct {
+	UUID        string     `json:"uuid"`
+	Description string     `json:"description"`
+	Props       []Property `json:"props,omitempty"`
+}
+
+// ReviewedControls identifies the controls assessed by a result.
+type ReviewedControls struct {
+	ControlSelections []ControlSelection `json:"control-selections"`
+}
+
+// ControlSelection selects all of the controls, or the listed controls.
+type ControlSelection struct {
+	IncludeAll      *struct{}           `json:"include-all,omitempty"`
+	IncludeControls []SelectControlByID `json:"include-controls,omitempty"`
+}
+
+// SelectControlByID selects a control by ID.
+type SelectControlByID struct {
+	ControlID string `json:"control-id"`
+}
+
+// Observation is the outcome of an assessment of a resource, which is an evaluation in Security
+// and Compliance Center terms.
+type Observation struct {
+	UUID        string             `json:"uuid"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description"`
+	Props       []Property         `json:"props,omitempty"`
+	Methods     []string           `json:"methods"`
+	Subjects    []SubjectReference `json:"subjects,omitempty"`
+	Collected   time.Time          `json:"collected"`
+}
+
+// SubjectReference is the reference to the subject of an observation.
+type SubjectReference struct {
+	SubjectUUID string     `json:"subject-uuid"`
+	Type        string     `json:"type"`
+	Title       string     `json:"title,omitempty"`
+	Props       []Property `json:"props,omitempty"`
+}
+
+// Finding is the conclusion of the assessment of a control.
+type Finding struct {
+	UUID                string               `json:"uuid"`
+	Title               string               `json:"title"`
+	Description         string               `json:"description"`
+	Props               []Property           `json:"props,omitempty"`
+	Target              FindingTarget        `json:"target"`
+	RelatedObservations []RelatedObservation `json:"related-observations,omitempty"`
+}
+
+// FindingTarget is the control statement targeted by a finding, with its status.
+type FindingTarget struct {
+	Type     string          `json:"type"`
+	TargetID string          `json:"target-id"`
+	Title    string          `json:"title,omitempty"`
+	Status   ObjectiveStatus `json:"status"`
+}
+
+// ObjectiveStatus tells whether the target of a finding is satisfied.
+type ObjectiveStatus struct {
+	State   string `json:"state"`
+	Reason  string `json:"reason,omitempty"`
+	Remarks string `json:"remarks,omitempty"`
+}
+
+// RelatedObservation is the reference to an observation that supports a finding.
+type RelatedObservation struct {
+	ObservationUUID string `json:"observation-uuid"`
+}
+
+// Constants associated with the ObjectiveStatus.State property.
+const (
+	ObjectiveStatusStateSatisfiedConst    = "satisfied"
+	ObjectiveStatusStateNotSatisfiedConst = "not-satisfied"
+)
+
+// Constants associated with the ObjectiveStatus.Reason property.
+const (
+	ObjectiveStatusReasonPassConst  = "pass"
+	ObjectiveStatusReasonFailConst  = "fail"
+	ObjectiveStatusReasonOtherConst = "other"
+)
+
+// assessmentResultsDocument is the root object of an OSCAL assessment results JSON document.
+type assessmentResultsDocument struct {
+	AssessmentResults *AssessmentResults `json:"assessment-results"`
+}
+
+// ReadAssessmentResults decodes an OSCAL assessment results JSON document.
+func ReadAssessmentResults(reader io.Reader) (*AssessmentResults, error) {
+	var document assessmentResultsDocument
+	err := json.NewDecoder(reader).Decode(&document)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "assessment-results-decode-error", common.GetComponentInfo())
+	}
+	if document.AssessmentResults == nil {
+		return nil, core.SDKErrorf(nil, "the document is not an OSCAL assessment results", "assessment-results-decode-error", common.GetComponentInfo())
+	}
+	return document.AssessmentResults, nil
+}
+
+// WriteJSON encodes the assessment results as an indented OSCAL assessment results JSON document.
+func (results *AssessmentResults) WriteJSON(writer io.Writer) error {
+	return writeJSON(writer, assessmentResultsDocument{AssessmentResults: results})
+}
+
+// ExportAssessmentResults retrieves a report, its controls and all of its evaluations and returns
+// their OSCAL assessment results.
+func ExportAssessmentResults(ctx context.Context, service scc.ReportsAPI, instanceID string, reportID string) (*AssessmentResults, error) {
+	report, _, err := service.GetReportWithContext(ctx, &scc.GetReportOptions{
+		InstanceID: core.StringPtr(instanceID),
+		ReportID:   core.StringPtr(reportID),
+	})
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-report-error")
+	}
+	controls, _, err := service.GetReportControlsWithContext(ctx, &scc.GetReportControlsOptions{
+		InstanceID: core.StringPtr(instanceID),
+		ReportID:   core.StringPtr(reportID),
+	})
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-report-controls-error")
+	}
+	var evaluations []scc.Evaluation
+	for evaluation, err := range service.AllReportEvaluations(ctx, &scc.ListReportEvaluationsOptions{
+		InstanceID: core.StringPtr(instanceID),
+		ReportID:   core.StringPtr(reportID),
+	}) {
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "list-report-evaluations-error")
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+	return NewAssessmentResults(report, controls, evaluations)
+}
+
+// NewAssessmentResults returns the OSCAL assessment results of a report, with one result that
+// holds a finding per control and an observation per evaluation. The subjects of the observations
+// are the evaluated resources, defined as inventory items of the result.
+//
+// The UUIDs are derived from the IDs of the report, controls, assessments and resources, so that
+// exporting the same report twice yields the same document. There is no OSCAL assessment plan of
+// a report: the assessment plan reference is the URN of the attachment, which can be replaced by
+// the location of a published plan.
+func NewAssessmentResults(report *scc.Report, controls *scc.ReportControls, evaluations []scc.Evaluation) (*AssessmentResults, error) {
+	if report == nil || report.ID == nil || *report.ID == "" {
+		return nil, core.SDKErrorf(nil, "the report has no ID", "invalid-report", common.GetComponentInfo())
+	}
+	exporter := &resultsExporter{
+		reportID:    *report.ID,
+		items:       map[string]int{},
+		assessments: map[string][]string{},
+		controls:    map[string]bool{},
+	}
+
+	created := parseTime(report.CreatedOn, time.Now().UTC())
+	start := parseTime(report.ScanTime, created)
+	profileName := "Security and Compliance Center"
+	profileVersion := defaultControlLibraryVersion
+	if report.Profile != nil {
+		if report.Profile.Name != nil && *report.Profile.Name != "" {
+			profileName = *report.Profile.Name
+		}
+		if report.Profile.Version != nil && *report.Profile.Version != "" {
+			profileVersion = *report.Profile.Version
+		}
+	}
+
+	props := appendProperty(nil, "report-id", report.ID)
+	if report.Account != nil {
+		props = appendProperty(props, "account-id", report.Account.ID)
+	}
+	if report.Profile != nil {
+		props = appendProperty(props, "profile-id", report.Profile.ID)
+	}
+	attachmentID := ""
+	if report.Attachment != nil {
+		props = appendProperty(props, "attachment-id", report.Attachment.ID)
+		attachmentID = core.StringNilMapper(report.Attachment.ID)
+	}
+	if report.Scope != nil {
+		props = appendProperty(props, "scope-id", report.Scope.ID)
+	}
+
+	results := &AssessmentResults{
+		UUID: exporter.uuid("assessment-results"),
+		Metadata: Metadata{
+			Title:        profileName + " assessment results",
+			LastModified: created,
+			Version:      profileVersion,
+			OSCALVersion: Version,
+			Props:        props,
+		},
+		ImportAP: ImportAP{
+			Href: "urn:uuid:" + uuid.NewSHA1(uuid.NameSpaceURL, []byte(Namespace+"/attachments/"+attachmentID)).String(),
+		},
+	}
+
+	result := Result{
+		UUID:        exporter.uuid("result"),
+		Title:       fmt.Sprintf("Scan of %s", *report.ID),
+		Description: fmt.Sprintf("The evaluations of the %s profile by the Security and Compliance Center.", profileName),
+		Start:       start,
+		End:         &created,
+		Props:       props,
+	}
+	for i := range evaluations {
+		result.Observations = append(result.Observations, exporter.observation(i, &evaluations[i], start))
+	}
+	if controls != nil {
+		for i := range controls.Controls {
+			result.Findings = append(result.Findings, exporter.finding(&controls.Controls[i]))
+		}
+	}
+
+	selection := ControlSelection{}
+	for _, controlID := range exporter.controlIDs {
+		selection.IncludeControls = append(selection.IncludeControls, SelectControlByID{ControlID: controlID})
+	}
+	if len(selection.IncludeControls) == 0 {
+		selection.IncludeAll = &struct{}{}
+	}
+	result.ReviewedControls = ReviewedControls{ControlSelections: []ControlSelection{selection}}
+	if len(exporter.inventoryItems) > 0 {
+		result.LocalDefinitions = &LocalDefinitions{InventoryItems: exporter.inventoryItems}
+	}
+
+	results.Results = []Result{result}
+	return results, nil
+}
+
+// resultsExporter converts the controls and the evaluations of a report.
+type resultsExporter struct {
+	reportID string
+
+	// The inventory items and their index by resource.
+	inventoryItems []InventoryItem
+	items          map[string]int
+
+	// The UUIDs of the observations by assessment ID.
+	assessments map[string][]string
+
+	// The IDs of the reviewed controls and their set.
+	controlIDs []string
+	controls   map[string]bool
+}
+
+// uuid returns the UUID of an object of the report.
+func (exporter *resultsExporter) uuid(path ...string) string {
+	name := Namespace + "/reports/" + exporter.reportID + "/" + strings.Join(path, "/")
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(name)).String()
+}
+
+// observation converts the i-th evaluation of the report.
+func (exporter *resultsExporter) observation(i int, evaluation *scc.Evaluation, collected time.Time) Observation {
+	assessment := evaluation.Assessment
+	if assessment == nil {
+		assessment = &scc.Assessment{}
+	}
+	assessmentID := core.StringNilMapper(assessment.AssessmentID)
+	status := core.StringNilMapper(evaluation.Status)
+
+	observation := Observation{
+		UUID:      exporter.uuid("evaluations", strconv.Itoa(i)),
+		Title:     core.StringNilMapper(assessment.AssessmentDescription),
+		Methods:   []string{"TEST"},
+		Collected: parseTime(evaluation.EvaluateTime, collected),
+	}
+	observation.Props = appendProperty(observation.Props, "assessment-id", assessment.AssessmentID)
+	observation.Props = appendProperty(observation.Props, "evaluation-status", evaluation.Status)
+	observation.Props = appendProperty(observation.Props, "component-id", evaluation.ComponentID)
+	observation.Props = appendProperty(observation.Props, "evaluated-by", evaluation.EvaluatedBy)
+
+	lines := []string{}
+	if reason := core.StringNilMapper(evaluation.Reason); reason != "" {
+		lines = append(lines, reason)
+	} else {
+		lines = append(lines, fmt.Sprintf("The evaluation of assessment %s is %s.", assessmentID, status))
+	}
+	if evaluation.Details != nil {
+		for n, property := range evaluation.Details.Properties {
+			group := "property-" + strconv.Itoa(n+1)
+			name := core.StringNilMapper(property.Property)
+			operator := core.StringNilMapper(property.Operator)
+			expected := formatEvaluationValue(property.ExpectedValue)
+			found := formatEvaluationValue(property.FoundValue)
+			lines = append(lines, fmt.Sprintf("%s %s: expected %s, found %s", name, operator, expected, found))
+
+			for _, prop := range []Property{
+				{Name: "property", Value: name},
+				{Name: "operator", Value: operator},
+				{Name: "expected-value", Value: expected},
+				{Name: "found-value", Value: found},
+			} {
+				prop.Value = propertyValue(prop.Value)
+				if prop.Value != "" {
+					prop.NS = Namespace
+					prop.Group = group
+					observation.Props = append(observation.Props, prop)
+				}
+			}
+		}
+	}
+	observation.Description = strings.Join(lines, "\n")
+
+	if subject, ok := exporter.subject(evaluation.Target); ok {
+		observation.Subjects = []SubjectReference{subject}
+	}
+	if assessmentID != "" {
+		exporter.assessments[assessmentID] = append(exporter.assessments[assessmentID], observation.UUID)
+	}
+	return observation
+}
+
+// subject returns the reference to the inventory item of an evaluated resource.
+func (exporter *resultsExporter) subject(target *scc.TargetInfo) (SubjectReference, bool) {
+	if target == nil {
+		return SubjectReference{}, false
+	}
+	key := ""
+	for _, value := range []*string{target.ResourceCRN, target.ID, target.ResourceName} {
+		if value != nil && *value != "" {
+			key = *value
+			break
+		}
+	}
+	if key == "" {
+		return SubjectReference{}, false
+	}
+
+	index, ok := exporter.items[key]
+	if !ok {
+		item := InventoryItem{
+			UUID:        exporter.uuid("resources", key),
+			Description: key,
+		}
+		if name := core.StringNilMapper(target.ResourceName); name != "" {
+			item.Description = name
+		}
+		item.Props = appendProperty(item.Props, "resource-id", target.ID)
+		item.Props = appendProperty(item.Props, "resource-crn", target.ResourceCRN)
+		item.Props = appendProperty(item.Props, "resource-name", target.ResourceName)
+		item.Props = appendProperty(item.Props, "service-name", target.ServiceName)
+		item.Props = appendProperty(item.Props, "account-id", target.AccountID)
+		index = len(exporter.inventoryItems)
+		exporter.items[key] = index
+		exporter.inventoryItems = append(exporter.inventoryItems, item)
+	}
+	item := exporter.inventoryItems[index]
+	return SubjectReference{SubjectUUID: item.UUID, Type: "inventory-item", Title: item.Description}, true
+}
+
+// finding converts a control of the report. The finding targets the statement of the control
+// in the catalog of its control library, as exported by NewCatalog.
+func (exporter *resultsExporter) finding(control *scc.ControlWithStats) Finding {
+	name := core.StringNilMapper(control.ControlName)
+	status := core.StringNilMapper(control.Status)
+	id := token(name)
+	if !exporter.controls[id] {
+		exporter.controls[id] = true
+		exporter.controlIDs = append(exporter.controlIDs, id)
+	}
+
+	finding := Finding{
+		UUID:        exporter.uuid("controls", core.StringNilMapper(control.ID), name),
+		Title:       name,
+		Description: core.StringNilMapper(control.ControlDescription),
+		Target: FindingTarget{
+			Type:     "statement-id",
+			TargetID: id + "_smt",
+			Status:   objectiveStatus(status),
+		},
+	}
+	if finding.Description == "" {
+		finding.Description = name
+	}
+	finding.Props = appendProperty(finding.Props, "control-id", control.ID)
+	finding.Props = appendProperty(finding.Props, "control-library-id", control.ControlLibraryID)
+	finding.Props = appendProperty(finding.Props, "control-category", control.ControlCategory)
+	finding.Props = appendProperty(finding.Props, "control-status", control.Status)
+
+	related := map[string]bool{}
+	for _, specification := range control.ControlSpecifications {
+		for _, assessment := range specification.Assessments {
+			for _, observationUUID := range exporter.assessments[core.StringNilMapper(assessment.AssessmentID)] {
+				if !related[observationUUID] {
+					related[observationUUID] = true
+					finding.RelatedObservations = append(finding.RelatedObservations, RelatedObservation{ObservationUUID: observationUUID})
+				}
+			}
+		}
+	}
+	return finding
+}
+
+// objectiveStatus converts the status of a control. A control that is not applicable is
+// satisfied, and a control that could not be evaluated is not.
+func objectiveStatus(status string) ObjectiveStatus {
+	objective := ObjectiveStatus{State: ObjectiveStatusStateNotSatisfiedConst, Reason: ObjectiveStatusReasonOtherConst}
+	switch status {
+	case scc.ControlWithStatsStatusCompliantConst:
+		objective = ObjectiveStatus{State: ObjectiveStatusStateSatisfiedConst, Reason: ObjectiveStatusReasonPassConst}
+	case scc.ControlWithStatsStatusNotCompliantConst:
+		objective.Reason = ObjectiveStatusReasonFailConst
+	case scc.ControlWithStatsStatusNotApplicableConst:
+		objective.State = ObjectiveStatusStateSatisfiedConst
+	}
+	if objective.Reason == ObjectiveStatusReasonOtherConst && status != "" {
+		objective.Remarks = "The status of the control is " + status + "."
+	}
+	return objective
+}
+
+// formatEvaluationValue formats an expected or found value as JSON.
+func formatEvaluationValue(value interface{}) string {
+	if value == nil {
+		return "null"
+	}
+	buffer, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(buffer)
+}
+
+// parseTime parses a date-time of the API, or returns the default value.
+func parseTime(value *string, defaultValue time.Time) time.Time {
+	if value != nil {
+		parsed, err := time.Parse(time.RFC3339, *value)
+		if err == nil {
+			return parsed.UTC()
+		}
+	}
+	return defaultValue
+}
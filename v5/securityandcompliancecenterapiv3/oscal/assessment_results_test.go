@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oscal_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/oscal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// Run "go test ./securityandcompliancecenterapiv3/oscal -args -update-golden" to regenerate the golden files.
+var updateGoldenFiles = flag.Bool("update-golden", false, "update the golden files in testdata")
+
+// oscalSchema is the OSCAL 1.1.2 complete JSON schema published by NIST, which validates any
+// OSCAL document.
+var oscalSchema = jsonschema.MustCompile(filepath.Join("testdata", "oscal_complete_schema.json"))
+
+// expectValidOSCAL validates an OSCAL JSON document against the OSCAL schema.
+func expectValidOSCAL(document []byte) {
+	var value interface{}
+	Expect(json.Unmarshal(document, &value)).To(Succeed())
+	err := oscalSchema.Validate(value)
+	if err != nil {
+		if validationErr, ok := err.(*jsonschema.ValidationError); ok {
+			Fail(fmt.Sprintf("%#v", validationErr))
+		}
+		Fail(err.Error())
+	}
+}
+
+var _ = Describe(`Assessment results`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	const reportID = "30b434b3-cb08-4845-af10-7a8fc682b6a8"
+	var testServer *httptest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			files := map[string]string{
+				"/instances/" + instanceID + "/v3/reports/" + reportID + "?start=":                   "report.json",
+				"/instances/" + instanceID + "/v3/reports/" + reportID + "/controls?start=":          "controls.json",
+				"/instances/" + instanceID + "/v3/reports/" + reportID + "/evaluations?start=":       "evaluations_1.json",
+				"/instances/" + instanceID + "/v3/reports/" + reportID + "/evaluations?start=page-2": "evaluations_2.json",
+			}
+			file, ok := files[req.URL.EscapedPath()+"?start="+req.URL.Query().Get("start")]
+			res.Header().Set("Content-type", "application/json")
+			if !ok {
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors":[{"code":"not_found","message":"not found"}]}`)
+				return
+			}
+			body, err := os.ReadFile(filepath.Join("testdata", "report", file))
+			Expect(err).To(BeNil())
+			res.WriteHeader(200)
+			_, _ = res.Write(body)
+		}))
+
+		var serviceErr error
+		securityAndComplianceCenterAPIService, serviceErr = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Export the assessment results of a report`, func() {
+		results, err := oscal.ExportAssessmentResults(context.Background(), securityAndComplianceCenterAPIService, instanceID, reportID)
+		Expect(err).To(BeNil())
+
+		var buffer bytes.Buffer
+		Expect(results.WriteJSON(&buffer)).To(Succeed())
+		expectValidOSCAL(buffer.Bytes())
+
+		golden := filepath.Join("testdata", "report", "assessment_results.json")
+		if *updateGoldenFiles {
+			Expect(os.WriteFile(golden, buffer.Bytes(), 0644)).To(Succeed())
+		}
+		expected, err := os.ReadFile(golden)
+		Expect(err).To(BeNil())
+		Expect(buffer.String()).To(Equal(string(expected)))
+
+		decoded, err := oscal.ReadAssessmentResults(&buffer)
+		Expect(err).To(BeNil())
+		Expect(decoded).To(Equal(results))
+	})
+	It(`Relate the findings to the observations of their assessments`, func() {
+		results, err := oscal.ExportAssessmentResults(context.Background(), securityAndComplianceCenterAPIService, instanceID, reportID)
+		Expect(err).To(BeNil())
+		Expect(results.Metadata.Title).To(Equal("Storage baseline assessment results"))
+		Expect(results.Results).To(HaveLen(1))
+
+		result := results.Results[0]
+		Expect(result.Observations).To(HaveLen(4))
+		Expect(result.Findings).To(HaveLen(3))
+		Expect(result.LocalDefinitions.InventoryItems).To(HaveLen(3))
+		Expect(result.ReviewedControls.ControlSelections[0].IncludeControls).To(Equal([]oscal.SelectControlByID{
+			{ControlID: "_1.1-Encryption"}, {ControlID: "IA-2"}, {ControlID: "CM-8"},
+		}))
+
+		failure := result.Observations[0]
+		Expect(failure.Description).To(Equal("Public access is enabled for the bucket.\n" +
+			"public_access_enabled is_false: expected false, found true\n" +
+			"storage_class string_equals: expected \"smart\", found \"standard\""))
+		Expect(failure.Props).To(ContainElement(oscal.Property{Name: "found-value", NS: oscal.Namespace, Group: "property-2", Value: `"standard"`}))
+		Expect(failure.Subjects[0].Type).To(Equal("inventory-item"))
+		Expect(failure.Subjects[0].Title).To(Equal("bucket-1"))
+		Expect(result.Observations[3].Subjects[0].SubjectUUID).To(Equal(failure.Subjects[0].SubjectUUID))
+
+		encryption := result.Findings[0]
+		Expect(encryption.Target.TargetID).To(Equal("_1.1-Encryption_smt"))
+		Expect(encryption.Target.Status).To(Equal(oscal.ObjectiveStatus{State: "not-satisfied", Reason: "fail"}))
+		Expect(encryption.RelatedObservations).To(Equal([]oscal.RelatedObservation{
+			{ObservationUUID: result.Observations[0].UUID},
+			{ObservationUUID: result.Observations[1].UUID},
+		}))
+		Expect(result.Findings[1].Target.Status.State).To(Equal("satisfied"))
+		Expect(result.Findings[2].Target.Status).To(Equal(oscal.ObjectiveStatus{
+			State:   "not-satisfied",
+			Reason:  "other",
+			Remarks: "The status of the control is user_evaluation_required.",
+		}))
+		Expect(result.Findings[2].RelatedObservations).To(BeEmpty())
+	})
+	It(`Export the assessment results of a report without controls and evaluations`, func() {
+		results, err := oscal.NewAssessmentResults(&securityandcompliancecenterapiv3.Report{ID: core.StringPtr(reportID)}, nil, nil)
+		Expect(err).To(BeNil())
+		Expect(results.Results[0].ReviewedControls.ControlSelections[0].IncludeAll).ToNot(BeNil())
+
+		var buffer bytes.Buffer
+		Expect(results.WriteJSON(&buffer)).To(Succeed())
+		expectValidOSCAL(buffer.Bytes())
+	})
+	It(`Invoke ExportAssessmentResults with error: Unknown report`, func() {
+		results, err := oscal.ExportAssessmentResults(context.Background(), securityAndComplianceCenterAPIService, instanceID, "unknown")
+		Expect(err).ToNot(BeNil())
+		Expect(results).To(BeNil())
+		Expect(err).To(MatchError(securityandcompliancecenterapiv3.ErrNotFound))
+
+		_, err = oscal.NewAssessmentResults(nil, nil, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package oscal converts Security and Compliance Center resources to and from the OSCAL
+// (Open Security Controls Assessment Language) JSON formats.
+//
+// A catalog is imported as the options that create a custom control library, and any
+// control library can be exported as a catalog:
+//
+//   - the groups of the catalog are the categories of the controls,
+//   - the title and the statement parts of a control are its description,
+//   - the sub-controls of a control are the controls whose parent is that control,
+//   - a withdrawn control is a disabled control.
+//
+// The names and the specifications of the controls, which have no OSCAL equivalent, are stored
+// as properties and parts in the Namespace namespace so that they survive a round trip.
+//
+// A report is exported as assessment results, with a finding per control that targets the
+// statement of the control in the catalog of its control library, and an observation per
+// evaluation whose subject is the evaluated resource.
+package oscal
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// Version is the version of OSCAL of the documents written by this package.
+const Version = "1.1.2"
+
+// Namespace is the namespace of the OSCAL properties and parts specific to the Security and
+// Compliance Center.
+const Namespace = "https://ibm.com/ns/oscal/scc"
+
+// Catalog is an OSCAL catalog.
+type Catalog struct {
+	UUID     string      `json:"uuid"`
+	Metadata Metadata    `json:"metadata"`
+	Params   []Parameter `json:"params,omitempty"`
+	Controls []Control   `json:"controls,omitempty"`
+	Groups   []Group     `json:"groups,omitempty"`
+}
+
+// Metadata is the metadata of an OSCAL document.
+type Metadata struct {
+	Title        string     `json:"title"`
+	Published    *time.Time `json:"published,omitempty"`
+	LastModified time.Time  `json:"last-modified"`
+	Version      string     `json:"version"`
+	OSCALVersion string     `json:"oscal-version"`
+	Props        []Property `json:"props,omitempty"`
+	Remarks      string     `json:"remarks,omitempty"`
+}
+
+// Group is a group of controls of a catalog.
+type Group struct {
+	ID       string      `json:"id,omitempty"`
+	Class    string      `json:"class,omitempty"`
+	Title    string      `json:"title"`
+	Params   []Parameter `json:"params,omitempty"`
+	Props    []Property  `json:"props,omitempty"`
+	Parts    []Part      `json:"parts,omitempty"`
+	Groups   []Group     `json:"groups,omitempty"`
+	Controls []Control   `json:"controls,omitempty"`
+}
+
+// Control is a control of a catalog.
+type Control struct {
+	ID       string      `json:"id"`
+	Class    string      `json:"class,omitempty"`
+	Title    string      `json:"title"`
+	Params   []Parameter `json:"params,omitempty"`
+	Props    []Property  `json:"props,omitempty"`
+	Parts    []Part      `json:"parts,omitempty"`
+	Controls []Control   `json:"controls,omitempty"`
+}
+
+// Part is a part of a control, such as its statement or its guidance.
+type Part struct {
+	ID    string     `json:"id,omitempty"`
+	Name  string     `json:"name"`
+	NS    string     `json:"ns,omitempty"`
+	Class string     `json:"class,omitempty"`
+	Title string     `json:"title,omitempty"`
+	Props []Property `json:"props,omitempty"`
+	Prose string     `json:"prose,omitempty"`
+	Parts []Part     `json:"parts,omitempty"`
+}
+
+// Parameter is a parameter of a control, inserted in the prose of its parts.
+type Parameter struct {
+	ID     string     `json:"id"`
+	Class  string     `json:"class,omitempty"`
+	Props  []Property `json:"props,omitempty"`
+	Label  string     `json:"label,omitempty"`
+	Values []string   `json:"values,omitempty"`
+}
+
+// Property is a name and value pair qualifying an OSCAL object.
+type Property struct {
+	Name    string `json:"name"`
+	UUID    string `json:"uuid,omitempty"`
+	NS      string `json:"ns,omitempty"`
+	Value   string `json:"value"`
+	Class   string `json:"class,omitempty"`
+	Group   string `json:"group,omitempty"`
+	Remarks string `json:"remarks,omitempty"`
+}
+
+// catalogDocument is the root object of an OSCAL catalog JSON document.
+type catalogDocument struct {
+	Catalog *Catalog `json:"catalog"`
+}
+
+// ReadCatalog decodes an OSCAL catalog JSON document.
+func ReadCatalog(reader io.Reader) (*Catalog, error) {
+	var document catalogDocument
+	err := json.NewDecoder(reader).Decode(&document)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "catalog-decode-error", common.GetComponentInfo())
+	}
+	if document.Catalog == nil {
+		return nil, core.SDKErrorf(nil, "the document is not an OSCAL catalog", "catalog-decode-error", common.GetComponentInfo())
+	}
+	return document.Catalog, nil
+}
+
+// ReadCatalogFile decodes the OSCAL catalog JSON document stored in a file.
+func ReadCatalogFile(path string) (*Catalog, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "catalog-open-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+	return ReadCatalog(file)
+}
+
+// WriteJSON encodes the catalog as an indented OSCAL catalog JSON document.
+func (catalog *Catalog) WriteJSON(writer io.Writer) error {
+	return writeJSON(writer, catalogDocument{Catalog: catalog})
+}
+
+func writeJSON(writer io.Writer, document interface{}) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(document)
+	if err != nil {
+		return core.SDKErrorf(err, "", "oscal-encode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// property returns the value of the property with the given name and namespace.
+// The empty namespace matches the properties of the default OSCAL namespace.
+func property(props []Property, ns string, name string) (string, bool) {
+	for _, prop := range props {
+		if prop.Name == name && prop.NS == ns {
+			return prop.Value, true
+		}
+	}
+	return "", false
+}
+
+// sccProperty returns a property of the Namespace namespace.
+func sccProperty(name string, value string) Property {
+	return Property{Name: name, NS: Namespace, Value: value}
+}
+
+// propertyValue collapses the whitespace of a property value, which cannot contain line breaks
+// nor start or end with a space.
+func propertyValue(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,483 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oscal
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/google/uuid"
+)
+
+// defaultControlLibraryVersion is the version of a control library imported from a catalog
+// without a version.
+const defaultControlLibraryVersion = "1.0.0"
+
+// The names of the parts of a control.
+const (
+	partStatement           = "statement"
+	partAssessmentObjective = "assessment-objective"
+	partAssessmentMethod    = "assessment-method"
+)
+
+// insertParamPattern matches the insertion of a parameter in the prose of a part.
+var insertParamPattern = regexp.MustCompile(`\{\{\s*insert:\s*param,\s*([^\s}]+)\s*\}\}`)
+
+// NewCreateControlLibraryOptions returns the options that create a custom control library with the
+// controls of the catalog.
+//
+// The name, the description and the version of the control library are the title, the remarks and
+// the version of the catalog. The controls are listed depth first, each control before its
+// sub-controls.
+func NewCreateControlLibraryOptions(instanceID string, catalog *Catalog) (*scc.CreateControlLibraryOptions, error) {
+	if catalog == nil || catalog.Metadata.Title == "" {
+		return nil, core.SDKErrorf(nil, "the catalog has no title", "invalid-catalog", common.GetComponentInfo())
+	}
+
+	importer := &catalogImporter{
+		params: map[string]Parameter{},
+		names:  map[string]bool{},
+	}
+	importer.collectParams(catalog.Params, catalog.Controls, catalog.Groups)
+	err := importer.importControls(catalog.Controls, "", "")
+	if err == nil {
+		err = importer.importGroups(catalog.Groups)
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(nil, err.Error(), "invalid-catalog", common.GetComponentInfo())
+	}
+
+	description := catalog.Metadata.Remarks
+	if description == "" {
+		description = catalog.Metadata.Title
+	}
+	version := catalog.Metadata.Version
+	if version == "" {
+		version = defaultControlLibraryVersion
+	}
+	options := &scc.CreateControlLibraryOptions{
+		InstanceID:                core.StringPtr(instanceID),
+		ControlLibraryName:        core.StringPtr(catalog.Metadata.Title),
+		ControlLibraryDescription: core.StringPtr(description),
+		ControlLibraryType:        core.StringPtr(scc.CreateControlLibraryOptionsControlLibraryTypeCustomConst),
+		ControlLibraryVersion:     core.StringPtr(version),
+		Controls:                  importer.controls,
+	}
+	return options, nil
+}
+
+// catalogImporter accumulates the controls of a catalog.
+type catalogImporter struct {
+	params   map[string]Parameter
+	names    map[string]bool
+	controls []scc.ControlPrototype
+}
+
+// collectParams indexes the parameters of the catalog by ID.
+func (importer *catalogImporter) collectParams(params []Parameter, controls []Control, groups []Group) {
+	for _, param := range params {
+		importer.params[param.ID] = param
+	}
+	for _, control := range controls {
+		importer.collectParams(control.Params, control.Controls, nil)
+	}
+	for _, group := range groups {
+		importer.collectParams(group.Params, group.Controls, group.Groups)
+	}
+}
+
+// importGroups imports the controls of the groups. The category of a control is the title of the
+// group that contains it.
+func (importer *catalogImporter) importGroups(groups []Group) error {
+	for _, group := range groups {
+		err := importer.importControls(group.Controls, group.Title, "")
+		if err != nil {
+			return err
+		}
+		err = importer.importGroups(group.Groups)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (importer *catalogImporter) importControls(controls []Control, category string, parent string) error {
+	for _, control := range controls {
+		if control.ID == "" {
+			return fmt.Errorf("a control of the catalog has no ID")
+		}
+		name, ok := property(control.Props, Namespace, "control-name")
+		if !ok {
+			name = control.ID
+		}
+		if importer.names[name] {
+			return fmt.Errorf("the control %q is declared more than once", name)
+		}
+		importer.names[name] = true
+
+		description := control.Title
+		if statement := importer.statement(control.Parts); statement != "" {
+			description += "\n\n" + statement
+		}
+		status := "enabled"
+		if value, _ := property(control.Props, "", "status"); value == "withdrawn" {
+			status = "disabled"
+		}
+		prototype := scc.ControlPrototype{
+			ControlName:           core.StringPtr(name),
+			ControlDescription:    core.StringPtr(description),
+			ControlCategory:       core.StringPtr(category),
+			ControlRequirement:    core.BoolPtr(true),
+			ControlSpecifications: importSpecifications(control.Parts),
+			Status:                core.StringPtr(status),
+		}
+		if parent != "" {
+			prototype.ControlParent = core.StringPtr(parent)
+		}
+		docsID, hasDocsID := property(control.Props, Namespace, "control-docs-id")
+		docsType, hasDocsType := property(control.Props, Namespace, "control-docs-type")
+		if hasDocsID || hasDocsType {
+			prototype.ControlDocs = &scc.ControlDoc{}
+			if hasDocsID {
+				prototype.ControlDocs.ControlDocsID = core.StringPtr(docsID)
+			}
+			if hasDocsType {
+				prototype.ControlDocs.ControlDocsType = core.StringPtr(docsType)
+			}
+		}
+		importer.controls = append(importer.controls, prototype)
+
+		err := importer.importControls(control.Controls, category, name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// statement renders the statement parts of a control as text, with one line per item indented
+// by its depth, and with the values or the labels of the inserted parameters.
+func (importer *catalogImporter) statement(parts []Part) string {
+	var lines []string
+	for _, part := range parts {
+		if part.Name == partStatement && part.NS == "" {
+			lines = importer.renderPart(lines, part, 0)
+			for _, item := range part.Parts {
+				lines = importer.renderItem(lines, item, 0)
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (importer *catalogImporter) renderItem(lines []string, part Part, depth int) []string {
+	lines = importer.renderPart(lines, part, depth)
+	for _, item := range part.Parts {
+		lines = importer.renderItem(lines, item, depth+1)
+	}
+	return lines
+}
+
+func (importer *catalogImporter) renderPart(lines []string, part Part, depth int) []string {
+	var words []string
+	if label, ok := property(part.Props, "", "label"); ok && label != "" {
+		words = append(words, label)
+	}
+	if prose := strings.TrimSpace(importer.insertParams(part.Prose)); prose != "" {
+		words = append(words, prose)
+	}
+	if len(words) == 0 {
+		return lines
+	}
+	return append(lines, strings.Repeat("  ", depth)+strings.Join(words, " "))
+}
+
+func (importer *catalogImporter) insertParams(prose string) string {
+	return insertParamPattern.ReplaceAllStringFunc(prose, func(insertion string) string {
+		id := insertParamPattern.FindStringSubmatch(insertion)[1]
+		param, ok := importer.params[id]
+		switch {
+		case ok && len(param.Values) > 0:
+			return strings.Join(param.Values, ", ")
+		case ok && param.Label != "":
+			return "[Assignment: " + param.Label + "]"
+		default:
+			return "[Assignment: " + id + "]"
+		}
+	})
+}
+
+// importSpecifications returns the control specifications stored in the assessment objective
+// parts of a control.
+func importSpecifications(parts []Part) []scc.ControlSpecificationPrototype {
+	specifications := []scc.ControlSpecificationPrototype{}
+	for _, part := range parts {
+		if part.Name != partAssessmentObjective || part.NS != Namespace {
+			continue
+		}
+		specification := scc.ControlSpecificationPrototype{
+			ControlSpecificationID:          optionalProperty(part.Props, "control-specification-id"),
+			ControlSpecificationDescription: optionalString(part.Prose),
+			ComponentID:                     optionalProperty(part.Props, "component-id"),
+			Environment:                     optionalProperty(part.Props, "environment"),
+		}
+		for _, method := range part.Parts {
+			if method.Name != partAssessmentMethod || method.NS != Namespace {
+				continue
+			}
+			specification.Assessments = append(specification.Assessments, scc.AssessmentPrototype{
+				AssessmentID:          optionalProperty(method.Props, "assessment-id"),
+				AssessmentDescription: optionalString(method.Prose),
+			})
+		}
+		specifications = append(specifications, specification)
+	}
+	return specifications
+}
+
+// NewCatalog returns the OSCAL catalog of a control library, such as the result of
+// GetControlLibrary.
+//
+// The controls of each category are grouped, and the sub-controls are nested in their parent.
+// The first paragraph of the description of a control is its title, and the following ones are
+// its statement. The UUID of the catalog is derived from the ID of the control library, so that
+// exporting the same control library twice yields the same catalog.
+func NewCatalog(library *scc.ControlLibrary) (*Catalog, error) {
+	if library == nil || library.ControlLibraryName == nil || *library.ControlLibraryName == "" {
+		return nil, core.SDKErrorf(nil, "the control library has no name", "invalid-control-library", common.GetComponentInfo())
+	}
+
+	catalog := &Catalog{
+		UUID: uuid.NewString(),
+		Metadata: Metadata{
+			Title:        *library.ControlLibraryName,
+			LastModified: time.Now().UTC(),
+			Version:      defaultControlLibraryVersion,
+			OSCALVersion: Version,
+		},
+	}
+	if library.ID != nil {
+		catalog.UUID = uuid.NewSHA1(uuid.NameSpaceURL, []byte(Namespace+"/control-libraries/"+*library.ID)).String()
+	}
+	if library.UpdatedOn != nil {
+		catalog.Metadata.LastModified = time.Time(*library.UpdatedOn).UTC()
+	} else if library.CreatedOn != nil {
+		catalog.Metadata.LastModified = time.Time(*library.CreatedOn).UTC()
+	}
+	if library.ControlLibraryVersion != nil && *library.ControlLibraryVersion != "" {
+		catalog.Metadata.Version = *library.ControlLibraryVersion
+	}
+	if description := core.StringNilMapper(library.ControlLibraryDescription); description != catalog.Metadata.Title {
+		catalog.Metadata.Remarks = description
+	}
+
+	exporter := &catalogExporter{
+		ids:      map[string]bool{},
+		children: map[string][]int{},
+		exported: make([]bool, len(library.Controls)),
+		controls: library.Controls,
+	}
+	names := map[string]bool{}
+	for _, control := range library.Controls {
+		names[core.StringNilMapper(control.ControlName)] = true
+	}
+	var roots []int
+	for i, control := range library.Controls {
+		parent := core.StringNilMapper(control.ControlParent)
+		if parent != "" && parent != core.StringNilMapper(control.ControlName) && names[parent] {
+			exporter.children[parent] = append(exporter.children[parent], i)
+		} else {
+			roots = append(roots, i)
+		}
+	}
+
+	groups := map[string]int{}
+	groupIDs := map[string]bool{}
+	export := func(i int) {
+		control := exporter.export(i)
+		category := core.StringNilMapper(library.Controls[i].ControlCategory)
+		if category == "" {
+			catalog.Controls = append(catalog.Controls, control)
+			return
+		}
+		index, ok := groups[category]
+		if !ok {
+			index = len(catalog.Groups)
+			groups[category] = index
+			catalog.Groups = append(catalog.Groups, Group{ID: uniqueToken(groupIDs, strings.ToLower(category)), Title: category})
+		}
+		catalog.Groups[index].Controls = append(catalog.Groups[index].Controls, control)
+	}
+	for _, i := range roots {
+		export(i)
+	}
+	// The controls whose parents form a cycle are not reachable from a root.
+	for i := range library.Controls {
+		if !exporter.exported[i] {
+			export(i)
+		}
+	}
+	return catalog, nil
+}
+
+// catalogExporter converts the controls of a control library.
+type catalogExporter struct {
+	ids      map[string]bool
+	children map[string][]int
+	exported []bool
+	controls []scc.Control
+}
+
+// export converts a control and its sub-controls.
+func (exporter *catalogExporter) export(i int) Control {
+	exporter.exported[i] = true
+	source := exporter.controls[i]
+	name := core.StringNilMapper(source.ControlName)
+
+	control := Control{ID: uniqueToken(exporter.ids, name)}
+	if control.ID != name {
+		control.Props = append(control.Props, sccProperty("control-name", name))
+	}
+	control.Title = name
+	title, statement := splitDescription(core.StringNilMapper(source.ControlDescription))
+	if title != "" {
+		control.Title = title
+	}
+	if statement != "" {
+		control.Parts = append(control.Parts, Part{ID: control.ID + "_smt", Name: partStatement, Prose: statement})
+	}
+	if core.StringNilMapper(source.Status) == "disabled" {
+		control.Props = append(control.Props, Property{Name: "status", Value: "withdrawn"})
+	}
+	if source.ControlSeverity != nil {
+		control.Props = append(control.Props, sccProperty("severity", *source.ControlSeverity))
+	}
+	for _, tag := range source.ControlTags {
+		control.Props = append(control.Props, sccProperty("tag", tag))
+	}
+	if source.ControlDocs != nil {
+		control.Props = appendProperty(control.Props, "control-docs-id", source.ControlDocs.ControlDocsID)
+		control.Props = appendProperty(control.Props, "control-docs-type", source.ControlDocs.ControlDocsType)
+	}
+
+	for n, specification := range source.ControlSpecifications {
+		objective := Part{
+			ID:    control.ID + "_obj." + strconv.Itoa(n+1),
+			Name:  partAssessmentObjective,
+			NS:    Namespace,
+			Prose: core.StringNilMapper(specification.Description),
+		}
+		objective.Props = appendProperty(objective.Props, "control-specification-id", specification.ID)
+		objective.Props = appendProperty(objective.Props, "component-id", specification.ComponentID)
+		objective.Props = appendProperty(objective.Props, "component-name", specification.ComponentName)
+		objective.Props = appendProperty(objective.Props, "component-type", specification.ComponentType)
+		objective.Props = appendProperty(objective.Props, "environment", specification.Environment)
+		objective.Props = appendProperty(objective.Props, "responsibility", specification.Responsibility)
+		for m, assessment := range specification.Assessments {
+			method := Part{
+				ID:    objective.ID + "." + strconv.Itoa(m+1),
+				Name:  partAssessmentMethod,
+				NS:    Namespace,
+				Prose: core.StringNilMapper(assessment.AssessmentDescription),
+			}
+			method.Props = appendProperty(method.Props, "assessment-id", assessment.AssessmentID)
+			method.Props = appendProperty(method.Props, "assessment-type", assessment.AssessmentType)
+			method.Props = appendProperty(method.Props, "assessment-method", assessment.AssessmentMethod)
+			objective.Parts = append(objective.Parts, method)
+		}
+		control.Parts = append(control.Parts, objective)
+	}
+
+	for _, child := range exporter.children[name] {
+		if !exporter.exported[child] {
+			control.Controls = append(control.Controls, exporter.export(child))
+		}
+	}
+	return control
+}
+
+// splitDescription splits the description of a control into its first paragraph and the
+// following ones.
+func splitDescription(description string) (title string, statement string) {
+	title, statement, _ = strings.Cut(strings.TrimSpace(description), "\n\n")
+	return strings.TrimSpace(title), strings.Trim(statement, "\n")
+}
+
+// uniqueToken converts a name to an OSCAL token that is not in the set, and adds it to the set.
+func uniqueToken(tokens map[string]bool, name string) string {
+	base := token(name)
+	unique := base
+	for n := 2; tokens[unique]; n++ {
+		unique = base + "-" + strconv.Itoa(n)
+	}
+	tokens[unique] = true
+	return unique
+}
+
+// token converts a name to an OSCAL token by replacing the characters that a token cannot contain.
+func token(name string) string {
+	var builder strings.Builder
+	for i, r := range name {
+		switch {
+		case unicode.IsLetter(r) || r == '_':
+			builder.WriteRune(r)
+		case i == 0:
+			builder.WriteRune('_')
+			if unicode.IsDigit(r) || r == '.' || r == '-' {
+				builder.WriteRune(r)
+			}
+		case unicode.IsDigit(r) || r == '.' || r == '-':
+			builder.WriteRune(r)
+		default:
+			builder.WriteRune('-')
+		}
+	}
+	if builder.Len() == 0 {
+		return "_"
+	}
+	return builder.String()
+}
+
+func appendProperty(props []Property, name string, value *string) []Property {
+	if value == nil || propertyValue(*value) == "" {
+		return props
+	}
+	return append(props, sccProperty(name, propertyValue(*value)))
+}
+
+func optionalProperty(props []Property, name string) *string {
+	value, ok := property(props, Namespace, name)
+	if !ok {
+		return nil
+	}
+	return core.StringPtr(value)
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return core.StringPtr(value)
+}
@@ -0,0 +1,223 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oscal_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/oscal"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/scctest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Control library catalogs`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+
+	controlNames := func(controls []securityandcompliancecenterapiv3.ControlPrototype) (names []string) {
+		for _, control := range controls {
+			names = append(names, *control.ControlName)
+		}
+		return
+	}
+
+	Describe(`NewCreateControlLibraryOptions`, func() {
+		It(`Import a catalog with groups, parameters and sub-controls`, func() {
+			catalog, err := oscal.ReadCatalogFile("testdata/nist_catalog.json")
+			Expect(err).To(BeNil())
+			options, err := oscal.NewCreateControlLibraryOptions(instanceID, catalog)
+			Expect(err).To(BeNil())
+			Expect(*options.InstanceID).To(Equal(instanceID))
+			Expect(*options.ControlLibraryName).To(Equal("NIST SP 800-53 Rev 5 excerpt"))
+			Expect(*options.ControlLibraryDescription).To(Equal("Access control and audit controls of NIST SP 800-53."))
+			Expect(*options.ControlLibraryType).To(Equal("custom"))
+			Expect(*options.ControlLibraryVersion).To(Equal("5.1.1"))
+			Expect(controlNames(options.Controls)).To(Equal([]string{"ac-1", "ac-2", "ac-2.1", "ac-2.10", "au-2"}))
+
+			ac1 := options.Controls[0]
+			Expect(*ac1.ControlCategory).To(Equal("Access Control"))
+			Expect(*ac1.ControlDescription).To(Equal(strings.Join([]string{
+				"Policy and Procedures",
+				"",
+				"a. Develop, document, and disseminate to [Assignment: organization-defined personnel or roles]:",
+				"  1. An access control policy; and",
+				"  2. Procedures to facilitate the implementation of the access control policy;",
+				"b. Review and update the access control policy annually.",
+			}, "\n")))
+			Expect(ac1.ControlParent).To(BeNil())
+			Expect(*ac1.ControlRequirement).To(BeTrue())
+			Expect(*ac1.Status).To(Equal("enabled"))
+			Expect(ac1.ControlSpecifications).To(BeEmpty())
+
+			Expect(*options.Controls[2].ControlParent).To(Equal("ac-2"))
+			Expect(*options.Controls[2].ControlCategory).To(Equal("Access Control"))
+			Expect(*options.Controls[3].ControlDescription).To(Equal("Shared and Group Account Credential Change"))
+			Expect(*options.Controls[3].Status).To(Equal("disabled"))
+			Expect(*options.Controls[4].ControlCategory).To(Equal("Audit and Accountability"))
+		})
+		It(`Import a catalog with control specifications`, func() {
+			catalog, err := oscal.ReadCatalogFile("testdata/scc_catalog.json")
+			Expect(err).To(BeNil())
+			options, err := oscal.NewCreateControlLibraryOptions(instanceID, catalog)
+			Expect(err).To(BeNil())
+			Expect(*options.ControlLibraryDescription).To(Equal("Storage baseline"))
+			Expect(*options.ControlLibraryVersion).To(Equal("1.2.0"))
+			Expect(controlNames(options.Controls)).To(Equal([]string{"1.1 Encryption", "net-1"}))
+
+			encryption := options.Controls[0]
+			Expect(*encryption.ControlCategory).To(Equal(""))
+			Expect(*encryption.ControlDescription).To(Equal("Encryption at rest\n\nBuckets are encrypted with customer managed keys."))
+			Expect(*encryption.ControlDocs.ControlDocsID).To(Equal("sc-28"))
+			Expect(*encryption.ControlDocs.ControlDocsType).To(Equal("ibm-cloud"))
+			Expect(encryption.ControlSpecifications).To(HaveLen(1))
+			specification := encryption.ControlSpecifications[0]
+			Expect(*specification.ControlSpecificationID).To(Equal("5c7d6f88-a92f-4734-9b49-bd22b0900184"))
+			Expect(*specification.ControlSpecificationDescription).To(Equal("Cloud Object Storage"))
+			Expect(*specification.ComponentID).To(Equal("cloud-object-storage"))
+			Expect(*specification.Environment).To(Equal("ibm-cloud"))
+			Expect(specification.Assessments).To(HaveLen(1))
+			Expect(*specification.Assessments[0].AssessmentID).To(Equal("rule-a637949b-7e51-46c4-afd4-b96619001bf1"))
+			Expect(*specification.Assessments[0].AssessmentDescription).To(Equal("Buckets are encrypted with Key Protect"))
+
+			Expect(*options.Controls[1].ControlCategory).To(Equal("Private network"))
+		})
+		It(`Invoke NewCreateControlLibraryOptions with error: Invalid catalog`, func() {
+			_, err := oscal.ReadCatalog(strings.NewReader(`{"profile": {}}`))
+			Expect(err).ToNot(BeNil())
+
+			_, err = oscal.NewCreateControlLibraryOptions(instanceID, &oscal.Catalog{})
+			Expect(err).ToNot(BeNil())
+
+			_, err = oscal.NewCreateControlLibraryOptions(instanceID, &oscal.Catalog{
+				Metadata: oscal.Metadata{Title: "Duplicates"},
+				Controls: []oscal.Control{{ID: "c-1", Title: "One"}},
+				Groups:   []oscal.Group{{Title: "Group", Controls: []oscal.Control{{ID: "c-1", Title: "Other"}}}},
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring(`the control "c-1" is declared more than once`))
+			var problem *core.SDKProblem
+			Expect(errors.As(err, &problem)).To(BeTrue())
+		})
+	})
+
+	Describe(`NewCatalog`, func() {
+		var server *scctest.Server
+		var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+
+		BeforeEach(func() {
+			server = scctest.NewServer()
+			var err error
+			securityAndComplianceCenterAPIService, err = server.NewService()
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		// roundTrip creates the control library of a catalog and exports it.
+		roundTrip := func(path string) (*securityandcompliancecenterapiv3.CreateControlLibraryOptions, *securityandcompliancecenterapiv3.ControlLibrary, *oscal.Catalog) {
+			catalog, err := oscal.ReadCatalogFile(path)
+			Expect(err).To(BeNil())
+			options, err := oscal.NewCreateControlLibraryOptions(instanceID, catalog)
+			Expect(err).To(BeNil())
+			created, _, err := securityAndComplianceCenterAPIService.CreateControlLibrary(options)
+			Expect(err).To(BeNil())
+			library, _, err := securityAndComplianceCenterAPIService.GetControlLibrary(securityAndComplianceCenterAPIService.NewGetControlLibraryOptions(instanceID, *created.ID))
+			Expect(err).To(BeNil())
+			exported, err := oscal.NewCatalog(library)
+			Expect(err).To(BeNil())
+			return options, library, exported
+		}
+
+		It(`Export a control library with groups and sub-controls`, func() {
+			_, library, catalog := roundTrip("testdata/nist_catalog.json")
+			Expect(catalog.Metadata.Title).To(Equal("NIST SP 800-53 Rev 5 excerpt"))
+			Expect(catalog.Metadata.Version).To(Equal("5.1.1"))
+			Expect(catalog.Metadata.OSCALVersion).To(Equal(oscal.Version))
+			Expect(catalog.Metadata.Remarks).To(Equal("Access control and audit controls of NIST SP 800-53."))
+			Expect(catalog.Controls).To(BeEmpty())
+			Expect(catalog.Groups).To(HaveLen(2))
+
+			accessControl := catalog.Groups[0]
+			Expect(accessControl.ID).To(Equal("access-control"))
+			Expect(accessControl.Title).To(Equal("Access Control"))
+			Expect(accessControl.Controls).To(HaveLen(2))
+			Expect(accessControl.Controls[0].ID).To(Equal("ac-1"))
+			Expect(accessControl.Controls[0].Title).To(Equal("Policy and Procedures"))
+			Expect(accessControl.Controls[0].Parts[0].Name).To(Equal("statement"))
+			Expect(accessControl.Controls[0].Parts[0].Prose).To(HavePrefix("a. Develop, document, and disseminate"))
+
+			accountManagement := accessControl.Controls[1]
+			Expect(accountManagement.ID).To(Equal("ac-2"))
+			Expect(accountManagement.Controls).To(HaveLen(2))
+			Expect(accountManagement.Controls[0].ID).To(Equal("ac-2.1"))
+			Expect(accountManagement.Controls[1].ID).To(Equal("ac-2.10"))
+			Expect(accountManagement.Controls[1].Parts).To(BeEmpty())
+			Expect(accountManagement.Controls[1].Props).To(ContainElement(oscal.Property{Name: "status", Value: "withdrawn"}))
+
+			Expect(catalog.Groups[1].Title).To(Equal("Audit and Accountability"))
+			Expect(catalog.Groups[1].Controls[0].ID).To(Equal("au-2"))
+
+			again, err := oscal.NewCatalog(library)
+			Expect(err).To(BeNil())
+			Expect(again.UUID).To(Equal(catalog.UUID))
+		})
+		It(`Export a control library with control specifications`, func() {
+			_, _, catalog := roundTrip("testdata/scc_catalog.json")
+			Expect(catalog.Controls).To(HaveLen(1))
+			encryption := catalog.Controls[0]
+			Expect(encryption.ID).To(Equal("_1.1-Encryption"))
+			Expect(encryption.Props).To(ContainElement(oscal.Property{Name: "control-name", NS: oscal.Namespace, Value: "1.1 Encryption"}))
+			Expect(encryption.Parts).To(HaveLen(2))
+			objective := encryption.Parts[1]
+			Expect(objective.Name).To(Equal("assessment-objective"))
+			Expect(objective.Prose).To(Equal("Cloud Object Storage"))
+			Expect(objective.Parts).To(HaveLen(1))
+			Expect(objective.Parts[0].Name).To(Equal("assessment-method"))
+
+			Expect(catalog.Groups).To(HaveLen(1))
+			Expect(catalog.Groups[0].Title).To(Equal("Private network"))
+		})
+		for _, path := range []string{"testdata/nist_catalog.json", "testdata/scc_catalog.json"} {
+			path := path
+			It(`Round trip `+path, func() {
+				options, _, catalog := roundTrip(path)
+
+				var buffer bytes.Buffer
+				Expect(catalog.WriteJSON(&buffer)).To(Succeed())
+				expectValidOSCAL(buffer.Bytes())
+				decoded, err := oscal.ReadCatalog(&buffer)
+				Expect(err).To(BeNil())
+				Expect(decoded).To(Equal(catalog))
+
+				imported, err := oscal.NewCreateControlLibraryOptions(instanceID, decoded)
+				Expect(err).To(BeNil())
+				Expect(imported).To(Equal(options))
+			})
+		}
+		It(`Invoke NewCatalog with error: Invalid control library`, func() {
+			_, err := oscal.NewCatalog(nil)
+			Expect(err).ToNot(BeNil())
+			_, err = oscal.NewCatalog(&securityandcompliancecenterapiv3.ControlLibrary{ControlLibraryType: core.StringPtr("custom")})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
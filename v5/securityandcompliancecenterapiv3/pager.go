@@ -0,0 +1,286 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// Pager is the common interface implemented by all of the pagers in this package,
+// such as RulesPager or ReportEvaluationsPager, which share the implementation of basePager.
+type Pager[T any] interface {
+	// HasNext returns true if there are potentially more results to be retrieved.
+	HasNext() bool
+
+	// GetNextWithContext returns the next page of results using the specified Context.
+	GetNextWithContext(ctx context.Context) ([]T, error)
+
+	// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly.
+	GetAllWithContext(ctx context.Context) ([]T, error)
+
+	// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+	GetNext() ([]T, error)
+
+	// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+	GetAll() ([]T, error)
+}
+
+var (
+	_ Pager[ProfileAttachment] = (*InstanceAttachmentsPager)(nil)
+	_ Pager[ControlLibrary]    = (*ControlLibrariesPager)(nil)
+	_ Pager[Profile]           = (*ProfilesPager)(nil)
+	_ Pager[Scope]             = (*ScopesPager)(nil)
+	_ Pager[SubScope]          = (*SubscopesPager)(nil)
+	_ Pager[Report]            = (*ReportsPager)(nil)
+	_ Pager[Evaluation]        = (*ReportEvaluationsPager)(nil)
+	_ Pager[Resource]          = (*ReportResourcesPager)(nil)
+	_ Pager[Rule]              = (*RulesPager)(nil)
+	_ Pager[ProfileAttachment] = (*ProfileAttachmentsPager)(nil)
+	_ Pager[Target]            = (*TargetsPager)(nil)
+	_ Pager[ScanReport]        = (*ScanReportsPager)(nil)
+)
+
+// basePager implements the Pager interface for all of the pagers in this package, which only
+// provide the function that retrieves a page.
+type basePager[T any] struct {
+	hasNext bool
+	next    *string
+
+	// getPage retrieves the page that starts at "start" (nil for the first page), and returns
+	// its items and the start of the next page, or nil for the last page.
+	getPage func(ctx context.Context, start *string) (page []T, next *string, err error)
+}
+
+// newBasePager returns a basePager that retrieves the pages with "getPage".
+func newBasePager[T any](getPage func(ctx context.Context, start *string) ([]T, *string, error)) basePager[T] {
+	return basePager[T]{
+		hasNext: true,
+		getPage: getPage,
+	}
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *basePager[T]) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *basePager[T]) GetNextWithContext(ctx context.Context) (page []T, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	page, next, err := pager.getPage(withPager(ctx), pager.next)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	pager.next = next
+	pager.hasNext = pager.next != nil && *pager.next != ""
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *basePager[T]) GetAllWithContext(ctx context.Context) (allItems []T, err error) {
+	for pager.HasNext() {
+		var nextPage []T
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *basePager[T]) GetNext() (page []T, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *basePager[T]) GetAll() (allItems []T, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// nextPageStart returns the start of the next page, from its start token or else from the start
+// query parameter of its URL, or nil for the last page.
+func nextPageStart(next *PageHRefNext) (start *string, err error) {
+	if next == nil {
+		return
+	}
+	if next.Start != nil {
+		return next.Start, nil
+	}
+	return core.GetQueryParam(next.Href, "start")
+}
+
+// setPageStart sets the start query parameter of a request of a pager to the start of the page,
+// for the list operations whose options have no start parameter, such as ListTargets.
+func setPageStart(req *http.Request) {
+	start, ok := req.Context().Value(pageStartContextKey{}).(string)
+	if !ok {
+		return
+	}
+	query := req.URL.Query()
+	query.Set("start", start)
+	req.URL.RawQuery = query.Encode()
+}
+
+// PagerItems returns an iterator over the items of all remaining pages of "pager".
+// Pages are retrieved lazily, one at a time, so only a single page is held in memory.
+// If retrieving a page fails, the error is yielded once and the iteration ends.
+// Breaking out of the loop stops any further page retrieval.
+func PagerItems[T any](ctx context.Context, pager Pager[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for pager.HasNext() {
+			if err := ctx.Err(); err != nil {
+				yield(zero, core.SDKErrorf(err, "", "context-done", common.GetComponentInfo()))
+				return
+			}
+			page, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				yield(zero, core.RepurposeSDKProblem(err, "error-getting-next-page"))
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// PagerPages returns an iterator over all remaining pages of "pager".
+// If retrieving a page fails, the error is yielded once and the iteration ends.
+func PagerPages[T any](ctx context.Context, pager Pager[T]) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		for pager.HasNext() {
+			if err := ctx.Err(); err != nil {
+				yield(nil, core.SDKErrorf(err, "", "context-done", common.GetComponentInfo()))
+				return
+			}
+			page, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				yield(nil, core.RepurposeSDKProblem(err, "error-getting-next-page"))
+				return
+			}
+			if !yield(page, nil) {
+				return
+			}
+		}
+	}
+}
+
+// pagerItemsOrError adapts the result of a pager constructor to an iterator, yielding
+// the constructor error (if any) as the only element.
+func pagerItemsOrError[T any, P Pager[T]](ctx context.Context, pager P, err error) iter.Seq2[T, error] {
+	if err != nil {
+		return func(yield func(T, error) bool) {
+			var zero T
+			yield(zero, err)
+		}
+	}
+	return PagerItems[T](ctx, pager)
+}
+
+// AllInstanceAttachments returns an iterator over all of the results of the "ListInstanceAttachments" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllInstanceAttachments(ctx context.Context, options *ListInstanceAttachmentsOptions) iter.Seq2[ProfileAttachment, error] {
+	pager, err := securityAndComplianceCenterApi.NewInstanceAttachmentsPager(options)
+	return pagerItemsOrError[ProfileAttachment](ctx, pager, err)
+}
+
+// AllControlLibraries returns an iterator over all of the results of the "ListControlLibraries" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllControlLibraries(ctx context.Context, options *ListControlLibrariesOptions) iter.Seq2[ControlLibrary, error] {
+	pager, err := securityAndComplianceCenterApi.NewControlLibrariesPager(options)
+	return pagerItemsOrError[ControlLibrary](ctx, pager, err)
+}
+
+// AllProfiles returns an iterator over all of the results of the "ListProfiles" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllProfiles(ctx context.Context, options *ListProfilesOptions) iter.Seq2[Profile, error] {
+	pager, err := securityAndComplianceCenterApi.NewProfilesPager(options)
+	return pagerItemsOrError[Profile](ctx, pager, err)
+}
+
+// AllScopes returns an iterator over all of the results of the "ListScopes" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllScopes(ctx context.Context, options *ListScopesOptions) iter.Seq2[Scope, error] {
+	pager, err := securityAndComplianceCenterApi.NewScopesPager(options)
+	return pagerItemsOrError[Scope](ctx, pager, err)
+}
+
+// AllSubscopes returns an iterator over all of the results of the "ListSubscopes" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllSubscopes(ctx context.Context, options *ListSubscopesOptions) iter.Seq2[SubScope, error] {
+	pager, err := securityAndComplianceCenterApi.NewSubscopesPager(options)
+	return pagerItemsOrError[SubScope](ctx, pager, err)
+}
+
+// AllReports returns an iterator over all of the results of the "ListReports" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllReports(ctx context.Context, options *ListReportsOptions) iter.Seq2[Report, error] {
+	pager, err := securityAndComplianceCenterApi.NewReportsPager(options)
+	return pagerItemsOrError[Report](ctx, pager, err)
+}
+
+// AllReportEvaluations returns an iterator over all of the results of the "ListReportEvaluations" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllReportEvaluations(ctx context.Context, options *ListReportEvaluationsOptions) iter.Seq2[Evaluation, error] {
+	pager, err := securityAndComplianceCenterApi.NewReportEvaluationsPager(options)
+	return pagerItemsOrError[Evaluation](ctx, pager, err)
+}
+
+// AllReportResources returns an iterator over all of the results of the "ListReportResources" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllReportResources(ctx context.Context, options *ListReportResourcesOptions) iter.Seq2[Resource, error] {
+	pager, err := securityAndComplianceCenterApi.NewReportResourcesPager(options)
+	return pagerItemsOrError[Resource](ctx, pager, err)
+}
+
+// AllRules returns an iterator over all of the results of the "ListRules" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllRules(ctx context.Context, options *ListRulesOptions) iter.Seq2[Rule, error] {
+	pager, err := securityAndComplianceCenterApi.NewRulesPager(options)
+	return pagerItemsOrError[Rule](ctx, pager, err)
+}
+
+// AllProfileAttachments returns an iterator over all of the results of the "ListProfileAttachments" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllProfileAttachments(ctx context.Context, options *ListProfileAttachmentsOptions) iter.Seq2[ProfileAttachment, error] {
+	pager, err := securityAndComplianceCenterApi.NewProfileAttachmentsPager(options)
+	return pagerItemsOrError[ProfileAttachment](ctx, pager, err)
+}
+
+// AllTargets returns an iterator over all of the results of the "ListTargets" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllTargets(ctx context.Context, options *ListTargetsOptions) iter.Seq2[Target, error] {
+	pager, err := securityAndComplianceCenterApi.NewTargetsPager(options)
+	return pagerItemsOrError[Target](ctx, pager, err)
+}
+
+// AllScanReports returns an iterator over all of the results of the "ListScanReports" method.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) AllScanReports(ctx context.Context, options *ListScanReportsOptions) iter.Seq2[ScanReport, error] {
+	pager, err := securityAndComplianceCenterApi.NewScanReportsPager(options)
+	return pagerItemsOrError[ScanReport](ctx, pager, err)
+}
@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Pager iterators`, func() {
+	var testServer *httptest.Server
+	var requestCount int
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	listReportEvaluationsPath := "/instances/acd7032c-15a3-484f-bf5b-67d41534d940/v3/reports/30b434b3-cb08-4845-af10-7a8fc682b6a8/evaluations"
+	options := func() *securityandcompliancecenterapiv3.ListReportEvaluationsOptions {
+		return &securityandcompliancecenterapiv3.ListReportEvaluationsOptions{
+			InstanceID: core.StringPtr("acd7032c-15a3-484f-bf5b-67d41534d940"),
+			ReportID:   core.StringPtr("30b434b3-cb08-4845-af10-7a8fc682b6a8"),
+			Limit:      core.Int64Ptr(int64(2)),
+		}
+	}
+
+	BeforeEach(func() {
+		requestCount = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal(listReportEvaluationsPath))
+			Expect(req.Method).To(Equal("GET"))
+			requestCount++
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Query().Get("start") {
+			case "":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"next":{"start":"page2"},"evaluations":[{"status":"pass","reason":"e1"},{"status":"failure","reason":"e2"}],"total_count":5,"limit":2}`)
+			case "page2":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"next":{"start":"page3"},"evaluations":[{"status":"pass","reason":"e3"},{"status":"pass","reason":"e4"}],"total_count":5,"limit":2}`)
+			case "page3":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"evaluations":[{"status":"skipped","reason":"e5"}],"total_count":5,"limit":2}`)
+			default:
+				res.WriteHeader(500)
+				fmt.Fprintf(res, "%s", `{"errors":[{"message":"boom"}]}`)
+			}
+		}))
+
+		var serviceErr error
+		securityAndComplianceCenterAPIService, serviceErr = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Iterate over all evaluations with AllReportEvaluations`, func() {
+		var reasons []string
+		for evaluation, err := range securityAndComplianceCenterAPIService.AllReportEvaluations(context.Background(), options()) {
+			Expect(err).To(BeNil())
+			reasons = append(reasons, *evaluation.Reason)
+		}
+		Expect(reasons).To(Equal([]string{"e1", "e2", "e3", "e4", "e5"}))
+		Expect(requestCount).To(Equal(3))
+	})
+	It(`Stop fetching pages when the loop breaks early`, func() {
+		count := 0
+		for _, err := range securityAndComplianceCenterAPIService.AllReportEvaluations(context.Background(), options()) {
+			Expect(err).To(BeNil())
+			count++
+			if count == 3 {
+				break
+			}
+		}
+		Expect(count).To(Equal(3))
+		Expect(requestCount).To(Equal(2))
+	})
+	It(`Iterate over pages with PagerPages`, func() {
+		pager, err := securityAndComplianceCenterAPIService.NewReportEvaluationsPager(options())
+		Expect(err).To(BeNil())
+		var sizes []int
+		for page, err := range securityandcompliancecenterapiv3.PagerPages[securityandcompliancecenterapiv3.Evaluation](context.Background(), pager) {
+			Expect(err).To(BeNil())
+			sizes = append(sizes, len(page))
+		}
+		Expect(sizes).To(Equal([]int{2, 2, 1}))
+		Expect(pager.HasNext()).To(BeFalse())
+	})
+	It(`Yield the error of a failed page and stop`, func() {
+		pager, err := securityAndComplianceCenterAPIService.NewReportEvaluationsPager(options())
+		Expect(err).To(BeNil())
+		_, err = pager.GetNext()
+		Expect(err).To(BeNil())
+
+		var errs []error
+		count := 0
+		for _, err := range securityandcompliancecenterapiv3.PagerItems[securityandcompliancecenterapiv3.Evaluation](context.Background(), pager) {
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			count++
+		}
+		Expect(count).To(Equal(3))
+		Expect(errs).To(BeEmpty())
+
+		failing := options()
+		failing.Start = core.StringPtr("bogus")
+		errs = nil
+		for _, err := range securityAndComplianceCenterAPIService.AllReportEvaluations(context.Background(), failing) {
+			errs = append(errs, err)
+		}
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).ToNot(BeNil())
+		Expect(requestCount).To(Equal(3))
+	})
+	It(`Yield an error when the context is cancelled`, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		var errs []error
+		for _, err := range securityAndComplianceCenterAPIService.AllReportEvaluations(ctx, options()) {
+			errs = append(errs, err)
+		}
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).ToNot(BeNil())
+		Expect(requestCount).To(Equal(0))
+	})
+})
@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultRetryAfter is how long requests are held back after a 429 response without a
+// Retry-After header.
+const defaultRetryAfter = time.Second
+
+// RateLimiter limits the rate of the requests of a client. A RateLimiter is set on a client with
+// SetRateLimiter.
+type RateLimiter interface {
+	// Wait blocks until a request of the operation, identified by its operationId such as
+	// "GetReport", may be sent, or until the context is done.
+	Wait(ctx context.Context, operationID string) error
+
+	// Throttled informs the limiter that the service rejected a request of the operation with
+	// status 429 (Too Many Requests), and asked for no requests before "retryAfter" elapses.
+	Throttled(operationID string, retryAfter time.Duration)
+}
+
+// RateLimiterMetrics : The counts and wait times of the requests of a TokenBucketRateLimiter.
+type RateLimiterMetrics struct {
+	// The number of requests.
+	Requests int64 `json:"requests"`
+
+	// The number of requests that were held back.
+	Delayed int64 `json:"delayed"`
+
+	// The number of requests rejected by the service with status 429.
+	Throttled int64 `json:"throttled"`
+
+	// The total time that the requests were held back.
+	WaitTime time.Duration `json:"wait_time"`
+}
+
+// TokenBucketRateLimiter : A RateLimiter with a token bucket for all requests and, optionally, a
+// token bucket per operation.
+//
+// A bucket holds up to "burst" tokens and is refilled with "rate" tokens per second. Each request
+// takes a token from the global bucket and from the bucket of its operation, if any, and waits
+// for the tokens that are missing. After a 429 response, all requests wait for the Retry-After
+// delay of the response.
+type TokenBucketRateLimiter struct {
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	mutex        sync.Mutex
+	global       *tokenBucket
+	operations   map[string]*tokenBucket
+	blockedUntil time.Time
+	metrics      RateLimiterMetrics
+	byOperation  map[string]*RateLimiterMetrics
+}
+
+// NewTokenBucketRateLimiter returns a TokenBucketRateLimiter that allows "rate" requests per
+// second, with bursts of up to "burst" requests. A rate that is not positive does not limit the
+// requests, other than after 429 responses.
+func NewTokenBucketRateLimiter(rate float64, burst int) *TokenBucketRateLimiter {
+	return &TokenBucketRateLimiter{
+		global:      newTokenBucket(rate, burst),
+		operations:  make(map[string]*tokenBucket),
+		byOperation: make(map[string]*RateLimiterMetrics),
+	}
+}
+
+// NewTokenBucketRateLimiterForEndpoint returns a TokenBucketRateLimiter that allows the advisory
+// call limit of the endpoint, taken as a number of requests per second, or nil if the endpoint has
+// no advisory call limit.
+func NewTokenBucketRateLimiterForEndpoint(endpoint *Endpoint) *TokenBucketRateLimiter {
+	if endpoint == nil || endpoint.AdvisoryCallLimit == nil || *endpoint.AdvisoryCallLimit <= 0 {
+		return nil
+	}
+	limit := *endpoint.AdvisoryCallLimit
+	return NewTokenBucketRateLimiter(float64(limit), int(limit))
+}
+
+// SetOperationLimit additionally limits the requests of an operation, identified by its
+// operationId such as "ListReportEvaluations", to "rate" requests per second, with bursts of up
+// to "burst" requests. A rate that is not positive removes the limit of the operation.
+func (limiter *TokenBucketRateLimiter) SetOperationLimit(operationID string, rate float64, burst int) *TokenBucketRateLimiter {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	if rate <= 0 {
+		delete(limiter.operations, operationID)
+	} else {
+		limiter.operations[operationID] = newTokenBucket(rate, burst)
+	}
+	return limiter
+}
+
+// Wait blocks until a request of the operation may be sent, or until the context is done.
+func (limiter *TokenBucketRateLimiter) Wait(ctx context.Context, operationID string) error {
+	limiter.mutex.Lock()
+	now := limiter.now()
+	delay := limiter.global.reserve(now)
+	bucket := limiter.operations[operationID]
+	if bucket != nil {
+		if operationDelay := bucket.reserve(now); operationDelay > delay {
+			delay = operationDelay
+		}
+	}
+	if blocked := limiter.blockedUntil.Sub(now); blocked > delay {
+		delay = blocked
+	}
+	limiter.record(operationID, func(metrics *RateLimiterMetrics) {
+		metrics.Requests++
+		if delay > 0 {
+			metrics.Delayed++
+			metrics.WaitTime += delay
+		}
+	})
+	limiter.mutex.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	err := sleepWithContext(ctx, delay)
+	if err != nil {
+		// The request is not sent, so its tokens are given back.
+		limiter.mutex.Lock()
+		limiter.global.cancel()
+		if bucket != nil {
+			bucket.cancel()
+		}
+		limiter.mutex.Unlock()
+	}
+	return err
+}
+
+// Throttled holds back all requests until "retryAfter" elapses.
+func (limiter *TokenBucketRateLimiter) Throttled(operationID string, retryAfter time.Duration) {
+	if retryAfter <= 0 {
+		retryAfter = defaultRetryAfter
+	}
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	if until := limiter.now().Add(retryAfter); until.After(limiter.blockedUntil) {
+		limiter.blockedUntil = until
+	}
+	limiter.record(operationID, func(metrics *RateLimiterMetrics) {
+		metrics.Throttled++
+	})
+}
+
+// GetMetrics returns the metrics of all requests.
+func (limiter *TokenBucketRateLimiter) GetMetrics() RateLimiterMetrics {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	return limiter.metrics
+}
+
+// GetOperationMetrics returns the metrics of the requests of each operation, by operationId.
+func (limiter *TokenBucketRateLimiter) GetOperationMetrics() map[string]RateLimiterMetrics {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	result := make(map[string]RateLimiterMetrics, len(limiter.byOperation))
+	for operationID, metrics := range limiter.byOperation {
+		result[operationID] = *metrics
+	}
+	return result
+}
+
+// record updates the global metrics and the metrics of the operation.
+// The caller must hold the mutex.
+func (limiter *TokenBucketRateLimiter) record(operationID string, update func(metrics *RateLimiterMetrics)) {
+	update(&limiter.metrics)
+	metrics, ok := limiter.byOperation[operationID]
+	if !ok {
+		metrics = &RateLimiterMetrics{}
+		limiter.byOperation[operationID] = metrics
+	}
+	update(metrics)
+}
+
+func (limiter *TokenBucketRateLimiter) now() time.Time {
+	if limiter.Now != nil {
+		return limiter.Now()
+	}
+	return time.Now()
+}
+
+// tokenBucket is a token bucket whose tokens can be reserved ahead of time: the number of tokens
+// goes below zero while requests wait for tokens.
+type tokenBucket struct {
+	rate    float64
+	burst   float64
+	tokens  float64
+	updated time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst)}
+}
+
+// reserve takes a token and returns how long to wait for it.
+func (bucket *tokenBucket) reserve(now time.Time) time.Duration {
+	if bucket.rate <= 0 {
+		return 0
+	}
+	if !bucket.updated.IsZero() {
+		bucket.tokens += now.Sub(bucket.updated).Seconds() * bucket.rate
+		if bucket.tokens > bucket.burst {
+			bucket.tokens = bucket.burst
+		}
+	}
+	bucket.updated = now
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+}
+
+// cancel gives back a reserved token.
+func (bucket *tokenBucket) cancel() {
+	if bucket.rate > 0 {
+		bucket.tokens++
+	}
+}
+
+// rateLimitedTransport holds back the requests of a client as instructed by its RateLimiter, and
+// reports the 429 responses to the limiter.
+type rateLimitedTransport struct {
+	next    http.RoundTripper
+	limiter RateLimiter
+}
+
+func (transport *rateLimitedTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *rateLimitedTransport) wrap(next http.RoundTripper) http.RoundTripper {
+	return &rateLimitedTransport{next: next, limiter: transport.limiter}
+}
+
+// RoundTrip waits for the limiter and sends the request.
+func (transport *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operationID := GetOperationID(req.Context())
+	err := transport.limiter.Wait(req.Context(), operationID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := transport.next.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		transport.limiter.Throttled(operationID, retryAfter(resp.Header.Get("Retry-After"), time.Now()))
+	}
+	return resp, err
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a number of seconds or
+// an HTTP date. It returns 0 if the value is missing or invalid.
+func retryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// SetRateLimiter : Limit the rate of the requests of the client
+// Every request of the client, including the retries enabled with EnableRetries, waits for the
+// limiter before it is sent. A nil limiter removes the limit; set the limiter after any call to
+// SetHTTPClient.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) SetRateLimiter(limiter RateLimiter) {
+	securityAndComplianceCenterApi.wrapTransport(func(transport http.RoundTripper) http.RoundTripper {
+		return withTransportLayer[*rateLimitedTransport](transport, func(next http.RoundTripper) http.RoundTripper {
+			if limiter == nil {
+				return next
+			}
+			return &rateLimitedTransport{next: next, limiter: limiter}
+		})
+	})
+}
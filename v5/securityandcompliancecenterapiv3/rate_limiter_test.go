@@ -0,0 +1,230 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`TokenBucketRateLimiter`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	var testServer *httptest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var throttled int32
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&throttled, 0)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if atomic.AddInt32(&throttled, -1) >= 0 {
+				res.Header().Set("Retry-After", "1")
+				res.WriteHeader(429)
+				fmt.Fprint(res, `{"errors": [{"code": "too_many_requests", "message": "Too many requests"}]}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{}`)
+		}))
+		var err error
+		securityAndComplianceCenterAPIService, err = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke operations with a global limit`, func() {
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(20, 2)
+		securityAndComplianceCenterAPIService.SetRateLimiter(limiter)
+
+		start := time.Now()
+		for i := 0; i < 4; i++ {
+			_, _, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+			Expect(err).To(BeNil())
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+
+		metrics := limiter.GetMetrics()
+		Expect(metrics.Requests).To(Equal(int64(4)))
+		Expect(metrics.Delayed).To(Equal(int64(2)))
+		Expect(metrics.Throttled).To(BeZero())
+		Expect(metrics.WaitTime).To(BeNumerically(">=", 90*time.Millisecond))
+		Expect(limiter.GetOperationMetrics()).To(HaveKeyWithValue("GetSettings", metrics))
+	})
+	It(`Invoke operations with a limit per operation`, func() {
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0).
+			SetOperationLimit("ListReports", 10, 1)
+		securityAndComplianceCenterAPIService.SetRateLimiter(limiter)
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			_, _, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+			Expect(err).To(BeNil())
+		}
+		Expect(time.Since(start)).To(BeNumerically("<", 50*time.Millisecond))
+
+		for i := 0; i < 2; i++ {
+			_, _, err := securityAndComplianceCenterAPIService.ListReports(securityAndComplianceCenterAPIService.NewListReportsOptions(instanceID))
+			Expect(err).To(BeNil())
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 90*time.Millisecond))
+
+		metrics := limiter.GetOperationMetrics()
+		Expect(metrics["GetSettings"].Delayed).To(BeZero())
+		Expect(metrics["ListReports"].Requests).To(Equal(int64(2)))
+		Expect(metrics["ListReports"].Delayed).To(Equal(int64(1)))
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(5)))
+
+		// The limit of the operation can be removed.
+		limiter.SetOperationLimit("ListReports", 0, 0)
+		securityAndComplianceCenterAPIService.SetRateLimiter(nil)
+		_, _, err := securityAndComplianceCenterAPIService.ListReports(securityAndComplianceCenterAPIService.NewListReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(5)))
+	})
+	It(`Invoke SetRateLimiter on a clone`, func() {
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0)
+		clone := securityAndComplianceCenterAPIService.Clone()
+		clone.SetRateLimiter(limiter)
+
+		_, _, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(limiter.GetMetrics().Requests).To(BeZero())
+		_, _, err = clone.GetSettings(clone.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(1)))
+
+		// Replacing the limiter of the clone leaves the service unlimited too.
+		replacement := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0)
+		clone.SetRateLimiter(replacement)
+		_, _, err = clone.GetSettings(clone.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		_, _, err = securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(1)))
+		Expect(replacement.GetMetrics().Requests).To(Equal(int64(1)))
+	})
+	It(`Invoke SetRateLimiter on a clone with retries enabled`, func() {
+		securityAndComplianceCenterAPIService.EnableRetries(2, 2*time.Second)
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0)
+		clone := securityAndComplianceCenterAPIService.Clone()
+		clone.SetRateLimiter(limiter)
+
+		_, _, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(limiter.GetMetrics().Requests).To(BeZero())
+
+		// The clone still retries the requests, through the limiter.
+		atomic.StoreInt32(&throttled, 1)
+		_, _, err = clone.GetSettings(clone.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(2)))
+		Expect(limiter.GetMetrics().Throttled).To(Equal(int64(1)))
+
+		_, _, err = securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(2)))
+	})
+	It(`Invoke operations after a 429 response`, func() {
+		atomic.StoreInt32(&throttled, 1)
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0)
+		securityAndComplianceCenterAPIService.SetRateLimiter(limiter)
+
+		_, response, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(429))
+
+		start := time.Now()
+		_, _, err = securityAndComplianceCenterAPIService.GetLatestReports(securityAndComplianceCenterAPIService.NewGetLatestReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(time.Since(start)).To(BeNumerically(">=", 900*time.Millisecond))
+
+		Expect(limiter.GetMetrics().Throttled).To(Equal(int64(1)))
+		Expect(limiter.GetOperationMetrics()["GetSettings"].Throttled).To(Equal(int64(1)))
+		Expect(limiter.GetOperationMetrics()["GetLatestReports"].Delayed).To(Equal(int64(1)))
+	})
+	It(`Invoke operations with retries after a 429 response`, func() {
+		atomic.StoreInt32(&throttled, 1)
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0)
+		securityAndComplianceCenterAPIService.EnableRetries(2, 2*time.Second)
+		securityAndComplianceCenterAPIService.SetRateLimiter(limiter)
+
+		_, _, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(2)))
+		Expect(limiter.GetMetrics().Throttled).To(Equal(int64(1)))
+	})
+	It(`Invoke Wait with a canceled context`, func() {
+		now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(1, 1)
+		limiter.Now = func() time.Time { return now }
+
+		Expect(limiter.Wait(context.Background(), "GetReport")).To(Succeed())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		Expect(limiter.Wait(ctx, "GetReport")).To(MatchError(context.Canceled))
+		Expect(limiter.GetMetrics().WaitTime).To(Equal(time.Second))
+
+		// The token of the canceled request was given back.
+		now = now.Add(time.Second)
+		Expect(limiter.Wait(context.Background(), "GetReport")).To(Succeed())
+		Expect(limiter.GetMetrics().Delayed).To(Equal(int64(1)))
+	})
+	It(`Invoke NewTokenBucketRateLimiterForEndpoint successfully`, func() {
+		Expect(securityandcompliancecenterapiv3.NewTokenBucketRateLimiterForEndpoint(&securityandcompliancecenterapiv3.Endpoint{})).To(BeNil())
+
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiterForEndpoint(&securityandcompliancecenterapiv3.Endpoint{
+			Host:              core.StringPtr("s3.us-south.cloud-object-storage.appdomain.cloud"),
+			AdvisoryCallLimit: core.Int64Ptr(2),
+		})
+		Expect(limiter).ToNot(BeNil())
+		now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+		limiter.Now = func() time.Time { return now }
+		for i := 0; i < 2; i++ {
+			Expect(limiter.Wait(context.Background(), "")).To(Succeed())
+		}
+		Expect(limiter.GetMetrics().Delayed).To(BeZero())
+	})
+	It(`Invoke operations with their operation ID in the request context`, func() {
+		var operationIDs []string
+		client := &http.Client{Transport: securityandcompliancecenterapiv3.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			operationIDs = append(operationIDs, securityandcompliancecenterapiv3.GetOperationID(req.Context()))
+			return http.DefaultTransport.RoundTrip(req)
+		})}
+		securityAndComplianceCenterAPIService.Service.SetHTTPClient(client)
+
+		_, _, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		_, _, err = securityAndComplianceCenterAPIService.ListServices(securityAndComplianceCenterAPIService.NewListServicesOptions())
+		Expect(err).To(BeNil())
+		Expect(operationIDs).To(Equal([]string{"GetSettings", "ListServices"}))
+		Expect(securityandcompliancecenterapiv3.GetOperationID(context.Background())).To(BeEmpty())
+	})
+})
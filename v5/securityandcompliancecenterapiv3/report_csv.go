@@ -0,0 +1,498 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"unicode"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// Canonical names of the columns of a report CSV file that are mapped onto a ReportCSVRow.
+const (
+	reportCSVColumnReportID              = "report_id"
+	reportCSVColumnControlID             = "control_id"
+	reportCSVColumnControlName           = "control_name"
+	reportCSVColumnControlDescription    = "control_description"
+	reportCSVColumnControlCategory       = "control_category"
+	reportCSVColumnComponentID           = "component_id"
+	reportCSVColumnComponentName         = "component_name"
+	reportCSVColumnAssessmentID          = "assessment_id"
+	reportCSVColumnAssessmentType        = "assessment_type"
+	reportCSVColumnAssessmentMethod      = "assessment_method"
+	reportCSVColumnAssessmentDescription = "assessment_description"
+	reportCSVColumnEvaluateTime          = "evaluate_time"
+	reportCSVColumnTargetID              = "target_id"
+	reportCSVColumnAccountID             = "account_id"
+	reportCSVColumnServiceName           = "service_name"
+	reportCSVColumnServiceDisplayName    = "service_display_name"
+	reportCSVColumnResourceCRN           = "resource_crn"
+	reportCSVColumnResourceName          = "resource_name"
+	reportCSVColumnStatus                = "status"
+	reportCSVColumnReason                = "reason"
+	reportCSVColumnProperty              = "property"
+	reportCSVColumnPropertyDescription   = "property_description"
+	reportCSVColumnOperator              = "operator"
+	reportCSVColumnExpectedValue         = "expected_value"
+	reportCSVColumnFoundValue            = "found_value"
+	reportCSVColumnEvaluatedBy           = "evaluated_by"
+)
+
+// reportCSVColumnNames lists the canonical names of the columns of the evaluation rows, which are
+// the property names of the evaluations of the API. The headers of the file are matched with
+// normalizeReportCSVName, so "Control ID" is the control_id column.
+var reportCSVColumnNames = []string{
+	reportCSVColumnControlID,
+	reportCSVColumnControlName,
+	reportCSVColumnControlDescription,
+	reportCSVColumnControlCategory,
+	reportCSVColumnComponentID,
+	reportCSVColumnComponentName,
+	reportCSVColumnAssessmentID,
+	reportCSVColumnAssessmentType,
+	reportCSVColumnAssessmentMethod,
+	reportCSVColumnAssessmentDescription,
+	reportCSVColumnEvaluateTime,
+	reportCSVColumnTargetID,
+	reportCSVColumnAccountID,
+	reportCSVColumnServiceName,
+	reportCSVColumnServiceDisplayName,
+	reportCSVColumnResourceCRN,
+	reportCSVColumnResourceName,
+	reportCSVColumnStatus,
+	reportCSVColumnReason,
+	reportCSVColumnProperty,
+	reportCSVColumnPropertyDescription,
+	reportCSVColumnOperator,
+	reportCSVColumnExpectedValue,
+	reportCSVColumnFoundValue,
+	reportCSVColumnEvaluatedBy,
+}
+
+// reportCSVColumnsByName maps the normalized names of the columns onto their canonical names.
+var reportCSVColumnsByName = func() map[string]string {
+	columns := make(map[string]string, len(reportCSVColumnNames))
+	for _, column := range reportCSVColumnNames {
+		columns[normalizeReportCSVName(column)] = column
+	}
+	return columns
+}()
+
+// reportCSVStatuses maps the status values found in report CSV files, in lower case, onto the
+// Evaluation.Status constants.
+var reportCSVStatuses = map[string]string{
+	"pass":    EvaluationStatusPassConst,
+	"failure": EvaluationStatusFailureConst,
+	"error":   EvaluationStatusErrorConst,
+	"skipped": EvaluationStatusSkippedConst,
+}
+
+// ReportCSVSummaryField is a single name/value line of the summary block of a report CSV file.
+type ReportCSVSummaryField struct {
+	// The name of the field, as found in the file.
+	Name string
+
+	// The value of the field.
+	Value string
+}
+
+// ReportCSVSummary is the summary block (report, account, profile and score metadata) that precedes
+// the evaluation rows of a report CSV file unless it was downloaded with ExcludeSummary.
+type ReportCSVSummary struct {
+	// The fields of the summary block, in file order.
+	Fields []ReportCSVSummaryField
+}
+
+// Get returns the value of the summary field with the specified name. Names are matched
+// case-insensitively, ignoring spaces and punctuation, so "Report ID" matches "report_id".
+func (summary *ReportCSVSummary) Get(name string) (value string, ok bool) {
+	if summary == nil {
+		return
+	}
+	normalized := normalizeReportCSVName(name)
+	for _, field := range summary.Fields {
+		if normalizeReportCSVName(field.Name) == normalized {
+			return field.Value, true
+		}
+	}
+	return
+}
+
+// ReportCSVRow is a single evaluation row of a report CSV file.
+type ReportCSVRow struct {
+	// The line of the file on which the row starts.
+	Line int
+
+	// The ID of the control that the evaluation belongs to.
+	ControlID *string
+
+	// The name of the control that the evaluation belongs to.
+	ControlName *string
+
+	// The description of the control that the evaluation belongs to.
+	ControlDescription *string
+
+	// The category of the control that the evaluation belongs to.
+	ControlCategory *string
+
+	// The evaluation described by the row. ReportID is taken from the summary block, if any.
+	// Details.Properties holds at most one property, with the expected and found values as the
+	// strings found in the file.
+	Evaluation *Evaluation
+
+	// The values of all of the columns of the row, keyed by column header, including the columns
+	// that are not mapped onto the fields above.
+	Values map[string]string
+}
+
+// ReportCSVDecoder reads the CSV file returned by GetReportDownloadFile, one evaluation row at a time.
+type ReportCSVDecoder struct {
+	source         io.Reader
+	reader         *csv.Reader
+	excludeSummary bool
+
+	started bool
+	summary *ReportCSVSummary
+	header  []string
+	columns map[string]int
+	err     error
+}
+
+// NewReportCSVDecoder returns a decoder that reads a report CSV file from "r". Set
+// "excludeSummary" to the value of GetReportDownloadFileOptions.ExcludeSummary that was used to
+// download the file; when false, the decoder expects a summary block before the evaluation rows.
+func NewReportCSVDecoder(r io.Reader, excludeSummary bool) *ReportCSVDecoder {
+	reader := csv.NewReader(skipUTF8BOM(r))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	return &ReportCSVDecoder{
+		source:         r,
+		reader:         reader,
+		excludeSummary: excludeSummary,
+	}
+}
+
+// GetReportDownloadFileCSV : Get report evaluation details as CSV rows
+// Download the evaluation details of a report as CSV and return a decoder for its rows. The caller
+// must Close the decoder.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetReportDownloadFileCSV(getReportDownloadFileOptions *GetReportDownloadFileOptions) (result *ReportCSVDecoder, response *core.DetailedResponse, err error) {
+	result, response, err = securityAndComplianceCenterApi.GetReportDownloadFileCSVWithContext(context.Background(), getReportDownloadFileOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetReportDownloadFileCSVWithContext is an alternate form of the GetReportDownloadFileCSV method which supports a Context parameter
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetReportDownloadFileCSVWithContext(ctx context.Context, getReportDownloadFileOptions *GetReportDownloadFileOptions) (result *ReportCSVDecoder, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(getReportDownloadFileOptions, "getReportDownloadFileOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	csvOptions := *getReportDownloadFileOptions
+	csvOptions.Accept = core.StringPtr("application/csv")
+	body, response, err := securityAndComplianceCenterApi.GetReportDownloadFileWithContext(ctx, &csvOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-report-download-file-error")
+		return
+	}
+
+	excludeSummary := csvOptions.ExcludeSummary != nil && *csvOptions.ExcludeSummary
+	result = NewReportCSVDecoder(body, excludeSummary)
+	return
+}
+
+// Summary returns the summary block of the file, reading it first if needed. The summary is
+// empty if the file was downloaded with ExcludeSummary.
+func (decoder *ReportCSVDecoder) Summary() (*ReportCSVSummary, error) {
+	err := decoder.start()
+	if err != nil {
+		return nil, err
+	}
+	return decoder.summary, nil
+}
+
+// Header returns the header of the evaluation rows, reading it first if needed.
+func (decoder *ReportCSVDecoder) Header() ([]string, error) {
+	err := decoder.start()
+	if err != nil {
+		return nil, err
+	}
+	return decoder.header, nil
+}
+
+// Next returns the next evaluation row. It returns io.EOF once all of the rows have been read.
+func (decoder *ReportCSVDecoder) Next() (row *ReportCSVRow, err error) {
+	err = decoder.start()
+	if err != nil {
+		return
+	}
+	if decoder.header == nil {
+		err = io.EOF
+		return
+	}
+
+	var record []string
+	for {
+		record, err = decoder.reader.Read()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, "", "csv-read-error", common.GetComponentInfo())
+			return
+		}
+		if !isBlankReportCSVRecord(record) {
+			break
+		}
+	}
+
+	line, _ := decoder.reader.FieldPos(0)
+	row = decoder.decodeRow(record, line)
+	return
+}
+
+// Rows returns an iterator over the remaining evaluation rows. If reading a row fails, the error
+// is yielded once and the iteration ends.
+func (decoder *ReportCSVDecoder) Rows() iter.Seq2[*ReportCSVRow, error] {
+	return func(yield func(*ReportCSVRow, error) bool) {
+		for {
+			row, err := decoder.Next()
+			if err == io.EOF {
+				return
+			}
+			if !yield(row, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Close closes the underlying reader if it implements io.Closer.
+func (decoder *ReportCSVDecoder) Close() error {
+	if closer, ok := decoder.source.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// start reads the summary block and the header of the evaluation rows, once.
+func (decoder *ReportCSVDecoder) start() error {
+	if decoder.started {
+		return decoder.err
+	}
+	decoder.started = true
+	decoder.summary = &ReportCSVSummary{}
+
+	for {
+		record, err := decoder.reader.Read()
+		if err == io.EOF {
+			if len(decoder.summary.Fields) > 0 {
+				decoder.err = core.SDKErrorf(nil, "the report CSV file has no evaluation header", "missing-header", common.GetComponentInfo())
+			}
+			return decoder.err
+		}
+		if err != nil {
+			decoder.err = core.SDKErrorf(err, "", "csv-read-error", common.GetComponentInfo())
+			return decoder.err
+		}
+		if isBlankReportCSVRecord(record) {
+			continue
+		}
+
+		columns := reportCSVColumns(record)
+		if isReportCSVHeader(columns) {
+			decoder.header = record
+			decoder.columns = columns
+			return nil
+		}
+		if decoder.excludeSummary {
+			line, _ := decoder.reader.FieldPos(0)
+			decoder.err = core.SDKErrorf(nil, fmt.Sprintf("line %d of the report CSV file is not an evaluation header", line), "missing-header", common.GetComponentInfo())
+			return decoder.err
+		}
+
+		field := ReportCSVSummaryField{
+			Name: strings.TrimSpace(record[0]),
+		}
+		if len(record) > 1 {
+			field.Value = strings.TrimSpace(record[1])
+		}
+		decoder.summary.Fields = append(decoder.summary.Fields, field)
+	}
+}
+
+// decodeRow maps a CSV record onto a ReportCSVRow.
+func (decoder *ReportCSVDecoder) decodeRow(record []string, line int) *ReportCSVRow {
+	value := func(column string) *string {
+		index, ok := decoder.columns[column]
+		if !ok || index >= len(record) {
+			return nil
+		}
+		v := strings.TrimSpace(record[index])
+		if v == "" {
+			return nil
+		}
+		return &v
+	}
+
+	row := &ReportCSVRow{
+		Line:               line,
+		ControlID:          value(reportCSVColumnControlID),
+		ControlName:        value(reportCSVColumnControlName),
+		ControlDescription: value(reportCSVColumnControlDescription),
+		ControlCategory:    value(reportCSVColumnControlCategory),
+		Values:             make(map[string]string, len(decoder.header)),
+	}
+	for i, name := range decoder.header {
+		if i < len(record) {
+			row.Values[name] = record[i]
+		} else {
+			row.Values[name] = ""
+		}
+	}
+
+	evaluation := &Evaluation{
+		ComponentID:   value(reportCSVColumnComponentID),
+		ComponentName: value(reportCSVColumnComponentName),
+		EvaluateTime:  value(reportCSVColumnEvaluateTime),
+		Status:        value(reportCSVColumnStatus),
+		Reason:        value(reportCSVColumnReason),
+		EvaluatedBy:   value(reportCSVColumnEvaluatedBy),
+	}
+	if reportID, ok := decoder.summary.Get(reportCSVColumnReportID); ok && reportID != "" {
+		evaluation.ReportID = core.StringPtr(reportID)
+	}
+	if evaluation.Status != nil {
+		if status, ok := reportCSVStatuses[strings.ToLower(*evaluation.Status)]; ok {
+			evaluation.Status = core.StringPtr(status)
+		}
+	}
+
+	assessment := &Assessment{
+		AssessmentID:          value(reportCSVColumnAssessmentID),
+		AssessmentType:        value(reportCSVColumnAssessmentType),
+		AssessmentMethod:      value(reportCSVColumnAssessmentMethod),
+		AssessmentDescription: value(reportCSVColumnAssessmentDescription),
+	}
+	if assessment.AssessmentID != nil || assessment.AssessmentType != nil || assessment.AssessmentMethod != nil || assessment.AssessmentDescription != nil {
+		evaluation.Assessment = assessment
+	}
+
+	target := &TargetInfo{
+		ID:                 value(reportCSVColumnTargetID),
+		AccountID:          value(reportCSVColumnAccountID),
+		ServiceName:        value(reportCSVColumnServiceName),
+		ServiceDisplayName: value(reportCSVColumnServiceDisplayName),
+		ResourceCRN:        value(reportCSVColumnResourceCRN),
+		ResourceName:       value(reportCSVColumnResourceName),
+	}
+	if target.ID != nil || target.AccountID != nil || target.ServiceName != nil || target.ServiceDisplayName != nil || target.ResourceCRN != nil || target.ResourceName != nil {
+		evaluation.Target = target
+	}
+
+	property := EvaluationProperty{
+		Property:            value(reportCSVColumnProperty),
+		PropertyDescription: value(reportCSVColumnPropertyDescription),
+		Operator:            value(reportCSVColumnOperator),
+	}
+	if expected := value(reportCSVColumnExpectedValue); expected != nil {
+		property.ExpectedValue = *expected
+	}
+	if found := value(reportCSVColumnFoundValue); found != nil {
+		property.FoundValue = *found
+	}
+	if property.Property != nil || property.Operator != nil || property.ExpectedValue != nil || property.FoundValue != nil {
+		evaluation.Details = &EvaluationDetails{
+			Properties: []EvaluationProperty{property},
+		}
+	}
+
+	row.Evaluation = evaluation
+	return row
+}
+
+// reportCSVColumns maps the canonical column names onto their index in "header". Blank headers
+// and headers that don't match a column are ignored. When a column appears more than once, the
+// first occurrence wins.
+func reportCSVColumns(header []string) map[string]int {
+	columns := make(map[string]int)
+	for i, name := range header {
+		column, ok := reportCSVColumnsByName[normalizeReportCSVName(name)]
+		if !ok {
+			continue
+		}
+		if _, exists := columns[column]; !exists {
+			columns[column] = i
+		}
+	}
+	return columns
+}
+
+// isReportCSVHeader returns true if the columns identify the header of the evaluation rows:
+// a status column plus at least one column identifying what was evaluated.
+func isReportCSVHeader(columns map[string]int) bool {
+	if _, ok := columns[reportCSVColumnStatus]; !ok {
+		return false
+	}
+	for _, column := range []string{reportCSVColumnAssessmentID, reportCSVColumnControlID, reportCSVColumnResourceCRN, reportCSVColumnTargetID} {
+		if _, ok := columns[column]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeReportCSVName lower-cases a name and drops everything but letters and digits.
+func normalizeReportCSVName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// isBlankReportCSVRecord returns true if all of the fields of the record are empty.
+func isBlankReportCSVRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// skipUTF8BOM drops the UTF-8 byte order mark that spreadsheet-friendly CSV files often start with.
+func skipUTF8BOM(r io.Reader) io.Reader {
+	buffered := bufio.NewReader(r)
+	prefix, _ := buffered.Peek(3)
+	if bytes.Equal(prefix, []byte{0xEF, 0xBB, 0xBF}) {
+		buffered.Discard(3)
+	}
+	return buffered
+}
@@ -0,0 +1,239 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ReportCSVDecoder`, func() {
+	var evaluationsCSV, summaryCSV string
+	BeforeEach(func() {
+		data, err := os.ReadFile("testdata/csv/evaluations.csv")
+		Expect(err).To(BeNil())
+		evaluationsCSV = string(data)
+		data, err = os.ReadFile("testdata/csv/report.csv")
+		Expect(err).To(BeNil())
+		summaryCSV = string(data)
+	})
+
+	It(`Decode a report with a summary block`, func() {
+		decoder := securityandcompliancecenterapiv3.NewReportCSVDecoder(strings.NewReader(summaryCSV), false)
+
+		summary, err := decoder.Summary()
+		Expect(err).To(BeNil())
+		Expect(summary.Fields).To(HaveLen(4))
+		Expect(summary.Fields[0]).To(Equal(securityandcompliancecenterapiv3.ReportCSVSummaryField{Name: "Report ID", Value: "30b434b3-cb08-4845-af10-7a8fc682b6a8"}))
+		profileName, ok := summary.Get("profile_name")
+		Expect(ok).To(BeTrue())
+		Expect(profileName).To(Equal("IBM Cloud Framework for Financial Services"))
+		_, ok = summary.Get("Missing")
+		Expect(ok).To(BeFalse())
+
+		header, err := decoder.Header()
+		Expect(err).To(BeNil())
+		Expect(header[0]).To(Equal("Control ID"))
+
+		var rows []*securityandcompliancecenterapiv3.ReportCSVRow
+		for row, err := range decoder.Rows() {
+			Expect(err).To(BeNil())
+			rows = append(rows, row)
+		}
+		Expect(rows).To(HaveLen(3))
+
+		first := rows[0]
+		Expect(first.Line).To(Equal(7))
+		Expect(*first.ControlID).To(Equal("SC-7"))
+		Expect(*first.ControlName).To(Equal("Boundary Protection"))
+		Expect(*first.Evaluation.ReportID).To(Equal("30b434b3-cb08-4845-af10-7a8fc682b6a8"))
+		Expect(*first.Evaluation.Status).To(Equal(securityandcompliancecenterapiv3.EvaluationStatusPassConst))
+		Expect(*first.Evaluation.Assessment.AssessmentID).To(Equal("rule-a"))
+		Expect(*first.Evaluation.Assessment.AssessmentType).To(Equal("automated"))
+		Expect(*first.Evaluation.ComponentID).To(Equal("cloud-object-storage"))
+		Expect(*first.Evaluation.Target.ResourceName).To(Equal("bucket-1"))
+		Expect(*first.Evaluation.Target.ServiceName).To(Equal("cloud-object-storage"))
+		Expect(first.Evaluation.Reason).To(BeNil())
+		Expect(*first.Evaluation.EvaluateTime).To(Equal("2025-01-02T03:04:05Z"))
+		Expect(first.Evaluation.Details.Properties).To(HaveLen(1))
+		Expect(*first.Evaluation.Details.Properties[0].Property).To(Equal("public_access_enabled"))
+		Expect(*first.Evaluation.Details.Properties[0].Operator).To(Equal("is_false"))
+		Expect(first.Evaluation.Details.Properties[0].ExpectedValue).To(Equal("false"))
+		Expect(first.Evaluation.Details.Properties[0].FoundValue).To(Equal("false"))
+		Expect(first.Values["Resource CRN"]).To(HavePrefix("crn:v1:bluemix"))
+
+		second := rows[1]
+		Expect(second.Line).To(Equal(8))
+		Expect(*second.Evaluation.Status).To(Equal(securityandcompliancecenterapiv3.EvaluationStatusFailureConst))
+		Expect(*second.Evaluation.Reason).To(Equal("MFA is not\nenabled"))
+		Expect(*second.Evaluation.Assessment.AssessmentDescription).To(Equal("Check MFA, for all users"))
+		Expect(second.Evaluation.Details.Properties[0].FoundValue).To(Equal("NONE"))
+
+		third := rows[2]
+		Expect(third.Line).To(Equal(11))
+		Expect(*third.Evaluation.Status).To(Equal(securityandcompliancecenterapiv3.EvaluationStatusSkippedConst))
+		Expect(third.Evaluation.Target.ResourceName).To(BeNil())
+		Expect(third.Evaluation.Details).To(BeNil())
+
+		_, err = decoder.Next()
+		Expect(err).To(Equal(io.EOF))
+	})
+	It(`Decode a report without a summary block`, func() {
+		decoder := securityandcompliancecenterapiv3.NewReportCSVDecoder(strings.NewReader(evaluationsCSV), true)
+
+		row, err := decoder.Next()
+		Expect(err).To(BeNil())
+		Expect(row.Line).To(Equal(2))
+		Expect(row.Evaluation.ReportID).To(BeNil())
+
+		summary, err := decoder.Summary()
+		Expect(err).To(BeNil())
+		Expect(summary.Fields).To(BeEmpty())
+	})
+	It(`Decode a report that does not start with a header when the summary is excluded`, func() {
+		decoder := securityandcompliancecenterapiv3.NewReportCSVDecoder(strings.NewReader(summaryCSV), true)
+
+		row, err := decoder.Next()
+		Expect(row).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("line 1"))
+	})
+	It(`Decode a report without evaluation header`, func() {
+		decoder := securityandcompliancecenterapiv3.NewReportCSVDecoder(strings.NewReader("Report ID,1\nAccount ID,2\n"), false)
+
+		_, err := decoder.Summary()
+		Expect(err).ToNot(BeNil())
+		_, err = decoder.Next()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Keep the values of unknown columns`, func() {
+		decoder := securityandcompliancecenterapiv3.NewReportCSVDecoder(strings.NewReader("Control ID,Rule ID,Status,Result,\nSC-7,rule-a,Pass,Pass,\n"), true)
+
+		row, err := decoder.Next()
+		Expect(err).To(BeNil())
+		Expect(*row.ControlID).To(Equal("SC-7"))
+		Expect(*row.Evaluation.Status).To(Equal(securityandcompliancecenterapiv3.EvaluationStatusPassConst))
+		Expect(row.Evaluation.Assessment).To(BeNil())
+		Expect(row.Values).To(HaveKeyWithValue("Rule ID", "rule-a"))
+		Expect(row.Values).To(HaveKeyWithValue("Result", "Pass"))
+		header, err := decoder.Header()
+		Expect(err).To(BeNil())
+		Expect(header).To(Equal([]string{"Control ID", "Rule ID", "Status", "Result", ""}))
+	})
+	It(`Accept the property names of the API as headers`, func() {
+		decoder := securityandcompliancecenterapiv3.NewReportCSVDecoder(strings.NewReader("control_id,assessment_id,status\nSC-7,rule-a,failure\n"), true)
+
+		row, err := decoder.Next()
+		Expect(err).To(BeNil())
+		Expect(*row.ControlID).To(Equal("SC-7"))
+		Expect(*row.Evaluation.Status).To(Equal(securityandcompliancecenterapiv3.EvaluationStatusFailureConst))
+	})
+	It(`Decode an empty report`, func() {
+		decoder := securityandcompliancecenterapiv3.NewReportCSVDecoder(strings.NewReader(""), false)
+
+		_, err := decoder.Next()
+		Expect(err).To(Equal(io.EOF))
+	})
+	It(`Stop reading rows when the loop breaks`, func() {
+		decoder := securityandcompliancecenterapiv3.NewReportCSVDecoder(strings.NewReader(evaluationsCSV), true)
+
+		for range decoder.Rows() {
+			break
+		}
+		row, err := decoder.Next()
+		Expect(err).To(BeNil())
+		Expect(*row.ControlID).To(Equal("AC-2"))
+	})
+
+	Describe(`GetReportDownloadFileCSV`, func() {
+		var testServer *httptest.Server
+		var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+		getReportDownloadFilePath := "/instances/acd7032c-15a3-484f-bf5b-67d41534d940/v3/reports/30b434b3-cb08-4845-af10-7a8fc682b6a8/download"
+
+		BeforeEach(func() {
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal(getReportDownloadFilePath))
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.Header["Accept"]).To(ContainElement("application/csv"))
+				Expect(req.Header["Accept"]).ToNot(ContainElement("application/pdf"))
+				res.Header().Set("Content-type", "application/csv")
+				res.WriteHeader(200)
+				if req.URL.Query().Get("exclude_summary") == "true" {
+					fmt.Fprint(res, evaluationsCSV)
+				} else {
+					fmt.Fprint(res, summaryCSV)
+				}
+			}))
+
+			var serviceErr error
+			securityAndComplianceCenterAPIService, serviceErr = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Invoke GetReportDownloadFileCSV successfully`, func() {
+			for _, excludeSummary := range []bool{false, true} {
+				getReportDownloadFileOptionsModel := securityAndComplianceCenterAPIService.NewGetReportDownloadFileOptions("acd7032c-15a3-484f-bf5b-67d41534d940", "30b434b3-cb08-4845-af10-7a8fc682b6a8").
+					SetAccept("application/pdf").
+					SetExcludeSummary(excludeSummary)
+
+				decoder, response, err := securityAndComplianceCenterAPIService.GetReportDownloadFileCSV(getReportDownloadFileOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(*getReportDownloadFileOptionsModel.Accept).To(Equal("application/pdf"))
+
+				summary, err := decoder.Summary()
+				Expect(err).To(BeNil())
+				Expect(summary.Fields).To(HaveLen(map[bool]int{false: 4, true: 0}[excludeSummary]))
+				count := 0
+				for _, err := range decoder.Rows() {
+					Expect(err).To(BeNil())
+					count++
+				}
+				Expect(count).To(Equal(3))
+				Expect(decoder.Close()).To(Succeed())
+			}
+		})
+		It(`Invoke GetReportDownloadFileCSV with error: Operation validation and request error`, func() {
+			decoder, response, err := securityAndComplianceCenterAPIService.GetReportDownloadFileCSV(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(decoder).To(BeNil())
+
+			decoder, response, err = securityAndComplianceCenterAPIService.GetReportDownloadFileCSV(&securityandcompliancecenterapiv3.GetReportDownloadFileOptions{})
+			Expect(err).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(decoder).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,369 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// ReportComparison is the evaluation-level difference between a previous and a current report.
+// Evaluations are matched by assessment and target. All of the lists are sorted by assessment ID
+// and target.
+type ReportComparison struct {
+	// The ID of the previous report, if the comparison was made by CompareReports.
+	PreviousReportID string
+
+	// The ID of the current report, if the comparison was made by CompareReports.
+	CurrentReportID string
+
+	// The evaluations that failed in the current report but not in the previous report.
+	NewlyFailing []ReportComparisonEntry
+
+	// The evaluations that failed in the previous report and passed in the current report.
+	NewlyPassing []ReportComparisonEntry
+
+	// The evaluations that failed in both reports.
+	PersistingFailures []ReportComparisonEntry
+
+	// The evaluations that failed in the previous report and were skipped or ended with an error
+	// in the current report, so it is not known whether the failure was fixed.
+	Inconclusive []ReportComparisonEntry
+
+	// The evaluations that are only in the current report.
+	Appeared []ReportComparisonEntry
+
+	// The evaluations that are only in the previous report.
+	Disappeared []ReportComparisonEntry
+
+	// The resources that are only evaluated in the current report.
+	AppearedResources []TargetInfo
+
+	// The resources that are only evaluated in the previous report.
+	DisappearedResources []TargetInfo
+
+	// The counts of the comparison.
+	Summary ReportComparisonSummary
+}
+
+// ReportComparisonEntry is an assessment of a target, as evaluated in the previous and the current report.
+type ReportComparisonEntry struct {
+	// The ID of the assessment.
+	AssessmentID string
+
+	// The key that identifies the target: its resource CRN, ID or name.
+	TargetKey string
+
+	// The evaluation in the previous report, or nil if the evaluation appeared.
+	Previous *Evaluation
+
+	// The evaluation in the current report, or nil if the evaluation disappeared.
+	Current *Evaluation
+}
+
+// Target returns the target of the current evaluation, or of the previous evaluation if the
+// evaluation disappeared.
+func (entry *ReportComparisonEntry) Target() *TargetInfo {
+	if entry.Current != nil {
+		return entry.Current.Target
+	}
+	if entry.Previous != nil {
+		return entry.Previous.Target
+	}
+	return nil
+}
+
+// ReportComparisonSummary holds the counts of a ReportComparison.
+type ReportComparisonSummary struct {
+	PreviousEvaluations  int
+	CurrentEvaluations   int
+	NewlyFailing         int
+	NewlyPassing         int
+	PersistingFailures   int
+	Inconclusive         int
+	Unchanged            int
+	Appeared             int
+	AppearedFailing      int
+	Disappeared          int
+	AppearedResources    int
+	DisappearedResources int
+}
+
+// CompareEvaluations compares the evaluations of a previous and a current report. When a report
+// holds several evaluations of the same assessment and target, the worst status wins.
+func CompareEvaluations(previous []Evaluation, current []Evaluation) *ReportComparison {
+	comparison := &ReportComparison{}
+	comparison.Summary.PreviousEvaluations = len(previous)
+	comparison.Summary.CurrentEvaluations = len(current)
+
+	previousByKey, previousTargets := indexEvaluations(previous)
+	currentByKey, currentTargets := indexEvaluations(current)
+
+	for key, previousEvaluation := range previousByKey {
+		entry := ReportComparisonEntry{
+			AssessmentID: key.assessmentID,
+			TargetKey:    key.targetKey,
+			Previous:     previousEvaluation,
+			Current:      currentByKey[key],
+		}
+		if entry.Current == nil {
+			comparison.Disappeared = append(comparison.Disappeared, entry)
+			continue
+		}
+
+		wasFailing := isFailingEvaluation(entry.Previous)
+		isFailing := isFailingEvaluation(entry.Current)
+		switch {
+		case wasFailing && isFailing:
+			comparison.PersistingFailures = append(comparison.PersistingFailures, entry)
+		case isFailing:
+			comparison.NewlyFailing = append(comparison.NewlyFailing, entry)
+		case wasFailing && stringValue(entry.Current.Status, "") == EvaluationStatusPassConst:
+			comparison.NewlyPassing = append(comparison.NewlyPassing, entry)
+		case wasFailing:
+			comparison.Inconclusive = append(comparison.Inconclusive, entry)
+		default:
+			comparison.Summary.Unchanged++
+		}
+	}
+	for key, currentEvaluation := range currentByKey {
+		if _, ok := previousByKey[key]; ok {
+			continue
+		}
+		comparison.Appeared = append(comparison.Appeared, ReportComparisonEntry{
+			AssessmentID: key.assessmentID,
+			TargetKey:    key.targetKey,
+			Current:      currentEvaluation,
+		})
+		if isFailingEvaluation(currentEvaluation) {
+			comparison.Summary.AppearedFailing++
+		}
+	}
+
+	comparison.AppearedResources = missingTargets(currentTargets, previousTargets)
+	comparison.DisappearedResources = missingTargets(previousTargets, currentTargets)
+
+	for _, entries := range [][]ReportComparisonEntry{comparison.NewlyFailing, comparison.NewlyPassing, comparison.PersistingFailures, comparison.Inconclusive, comparison.Appeared, comparison.Disappeared} {
+		sortReportComparisonEntries(entries)
+	}
+	comparison.Summary.NewlyFailing = len(comparison.NewlyFailing)
+	comparison.Summary.NewlyPassing = len(comparison.NewlyPassing)
+	comparison.Summary.PersistingFailures = len(comparison.PersistingFailures)
+	comparison.Summary.Inconclusive = len(comparison.Inconclusive)
+	comparison.Summary.Appeared = len(comparison.Appeared)
+	comparison.Summary.Disappeared = len(comparison.Disappeared)
+	comparison.Summary.AppearedResources = len(comparison.AppearedResources)
+	comparison.Summary.DisappearedResources = len(comparison.DisappearedResources)
+	return comparison
+}
+
+// evaluationKey identifies the evaluation of an assessment on a target.
+type evaluationKey struct {
+	assessmentID string
+	targetKey    string
+}
+
+// indexEvaluations indexes evaluations by assessment and target, keeping the evaluation with the
+// worst status for each key, and collects the distinct targets.
+func indexEvaluations(evaluations []Evaluation) (byKey map[evaluationKey]*Evaluation, targets map[string]*TargetInfo) {
+	byKey = make(map[evaluationKey]*Evaluation)
+	targets = make(map[string]*TargetInfo)
+	for i := range evaluations {
+		evaluation := &evaluations[i]
+		key := evaluationKey{
+			targetKey: evaluationTargetKey(evaluation.Target),
+		}
+		if evaluation.Assessment != nil && evaluation.Assessment.AssessmentID != nil {
+			key.assessmentID = *evaluation.Assessment.AssessmentID
+		}
+		if existing, ok := byKey[key]; !ok || evaluationSeverity(evaluation) > evaluationSeverity(existing) {
+			byKey[key] = evaluation
+		}
+		if _, ok := targets[key.targetKey]; !ok && evaluation.Target != nil {
+			targets[key.targetKey] = evaluation.Target
+		}
+	}
+	return
+}
+
+// evaluationSeverity orders the statuses of evaluations from best to worst.
+func evaluationSeverity(evaluation *Evaluation) int {
+	switch stringValue(evaluation.Status, "") {
+	case EvaluationStatusFailureConst:
+		return 3
+	case EvaluationStatusErrorConst:
+		return 2
+	case EvaluationStatusPassConst:
+		return 1
+	}
+	return 0
+}
+
+// isFailingEvaluation returns true if the evaluation has the failure status.
+func isFailingEvaluation(evaluation *Evaluation) bool {
+	return stringValue(evaluation.Status, "") == EvaluationStatusFailureConst
+}
+
+// missingTargets returns the targets of "from" that are not in "other", sorted by key.
+func missingTargets(from map[string]*TargetInfo, other map[string]*TargetInfo) (targets []TargetInfo) {
+	var keys []string
+	for key := range from {
+		if _, ok := other[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		targets = append(targets, *from[key])
+	}
+	return
+}
+
+// sortReportComparisonEntries sorts entries by assessment ID and target.
+func sortReportComparisonEntries(entries []ReportComparisonEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].AssessmentID != entries[j].AssessmentID {
+			return entries[i].AssessmentID < entries[j].AssessmentID
+		}
+		return entries[i].TargetKey < entries[j].TargetKey
+	})
+}
+
+// CompareReportsOptions : The CompareReports options.
+type CompareReportsOptions struct {
+	// The ID of the Security and Compliance Center instance.
+	InstanceID *string `json:"instance_id" validate:"required,ne="`
+
+	// The ID of the previous report.
+	PreviousReportID *string `json:"previous_report_id" validate:"required,ne="`
+
+	// The ID of the current report.
+	CurrentReportID *string `json:"current_report_id" validate:"required,ne="`
+
+	// The ID of the scope. Only the evaluations of this scope are compared.
+	ScopeID *string `json:"scope_id,omitempty"`
+
+	// The ID of the subscope. Only the evaluations of this subscope are compared.
+	SubscopeID *string `json:"subscope_id,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewCompareReportsOptions : Instantiate CompareReportsOptions
+func (*SecurityAndComplianceCenterAPIV3) NewCompareReportsOptions(instanceID string, previousReportID string, currentReportID string) *CompareReportsOptions {
+	return &CompareReportsOptions{
+		InstanceID:       core.StringPtr(instanceID),
+		PreviousReportID: core.StringPtr(previousReportID),
+		CurrentReportID:  core.StringPtr(currentReportID),
+	}
+}
+
+// SetInstanceID : Allow user to set InstanceID
+func (_options *CompareReportsOptions) SetInstanceID(instanceID string) *CompareReportsOptions {
+	_options.InstanceID = core.StringPtr(instanceID)
+	return _options
+}
+
+// SetPreviousReportID : Allow user to set PreviousReportID
+func (_options *CompareReportsOptions) SetPreviousReportID(previousReportID string) *CompareReportsOptions {
+	_options.PreviousReportID = core.StringPtr(previousReportID)
+	return _options
+}
+
+// SetCurrentReportID : Allow user to set CurrentReportID
+func (_options *CompareReportsOptions) SetCurrentReportID(currentReportID string) *CompareReportsOptions {
+	_options.CurrentReportID = core.StringPtr(currentReportID)
+	return _options
+}
+
+// SetScopeID : Allow user to set ScopeID
+func (_options *CompareReportsOptions) SetScopeID(scopeID string) *CompareReportsOptions {
+	_options.ScopeID = core.StringPtr(scopeID)
+	return _options
+}
+
+// SetSubscopeID : Allow user to set SubscopeID
+func (_options *CompareReportsOptions) SetSubscopeID(subscopeID string) *CompareReportsOptions {
+	_options.SubscopeID = core.StringPtr(subscopeID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CompareReportsOptions) SetHeaders(param map[string]string) *CompareReportsOptions {
+	options.Headers = param
+	return options
+}
+
+// CompareReports : Compare the evaluations of two reports
+// Retrieve all of the evaluations of a previous and a current report and determine which
+// evaluations regressed, which were fixed and which resources appeared or disappeared.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CompareReports(compareReportsOptions *CompareReportsOptions) (result *ReportComparison, err error) {
+	result, err = securityAndComplianceCenterApi.CompareReportsWithContext(context.Background(), compareReportsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CompareReportsWithContext is an alternate form of the CompareReports method which supports a Context parameter
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CompareReportsWithContext(ctx context.Context, compareReportsOptions *CompareReportsOptions) (result *ReportComparison, err error) {
+	err = core.ValidateNotNil(compareReportsOptions, "compareReportsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(compareReportsOptions, "compareReportsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	previous, err := securityAndComplianceCenterApi.listAllReportEvaluations(ctx, compareReportsOptions, compareReportsOptions.PreviousReportID)
+	if err != nil {
+		return
+	}
+	current, err := securityAndComplianceCenterApi.listAllReportEvaluations(ctx, compareReportsOptions, compareReportsOptions.CurrentReportID)
+	if err != nil {
+		return
+	}
+
+	result = CompareEvaluations(previous, current)
+	result.PreviousReportID = *compareReportsOptions.PreviousReportID
+	result.CurrentReportID = *compareReportsOptions.CurrentReportID
+	return
+}
+
+// listAllReportEvaluations retrieves all of the evaluations of a report within the scope of the comparison.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) listAllReportEvaluations(ctx context.Context, compareReportsOptions *CompareReportsOptions, reportID *string) (evaluations []Evaluation, err error) {
+	listReportEvaluationsOptions := &ListReportEvaluationsOptions{
+		InstanceID: compareReportsOptions.InstanceID,
+		ReportID:   reportID,
+		ScopeID:    compareReportsOptions.ScopeID,
+		SubscopeID: compareReportsOptions.SubscopeID,
+		Headers:    compareReportsOptions.Headers,
+	}
+	for evaluation, evaluationErr := range securityAndComplianceCenterApi.AllReportEvaluations(ctx, listReportEvaluationsOptions) {
+		if evaluationErr != nil {
+			err = core.RepurposeSDKProblem(evaluationErr, "list-report-evaluations-error")
+			return
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+	return
+}
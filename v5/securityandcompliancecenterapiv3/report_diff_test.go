@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Report comparison`, func() {
+	evaluation := func(assessmentID string, crn string, status string) securityandcompliancecenterapiv3.Evaluation {
+		return securityandcompliancecenterapiv3.Evaluation{
+			Assessment: &securityandcompliancecenterapiv3.Assessment{AssessmentID: core.StringPtr(assessmentID)},
+			Target:     &securityandcompliancecenterapiv3.TargetInfo{ResourceCRN: core.StringPtr(crn)},
+			Status:     core.StringPtr(status),
+		}
+	}
+	keys := func(entries []securityandcompliancecenterapiv3.ReportComparisonEntry) (result []string) {
+		for _, entry := range entries {
+			result = append(result, entry.AssessmentID+"@"+entry.TargetKey)
+		}
+		return
+	}
+
+	It(`Compare the evaluations of two reports`, func() {
+		previous := []securityandcompliancecenterapiv3.Evaluation{
+			evaluation("rule-a", "crn-1", "pass"),
+			evaluation("rule-a", "crn-2", "failure"),
+			evaluation("rule-b", "crn-1", "failure"),
+			evaluation("rule-b", "crn-2", "failure"),
+			evaluation("rule-c", "crn-1", "failure"),
+			evaluation("rule-c", "crn-3", "pass"),
+			evaluation("rule-d", "crn-1", "pass"),
+		}
+		current := []securityandcompliancecenterapiv3.Evaluation{
+			evaluation("rule-a", "crn-1", "failure"),
+			evaluation("rule-a", "crn-2", "pass"),
+			evaluation("rule-b", "crn-1", "failure"),
+			evaluation("rule-b", "crn-2", "error"),
+			evaluation("rule-c", "crn-1", "pass"),
+			evaluation("rule-c", "crn-1", "failure"),
+			evaluation("rule-d", "crn-1", "pass"),
+			evaluation("rule-a", "crn-4", "failure"),
+			evaluation("rule-e", "crn-1", "pass"),
+		}
+
+		comparison := securityandcompliancecenterapiv3.CompareEvaluations(previous, current)
+		Expect(keys(comparison.NewlyFailing)).To(Equal([]string{"rule-a@crn-1"}))
+		Expect(keys(comparison.NewlyPassing)).To(Equal([]string{"rule-a@crn-2"}))
+		Expect(keys(comparison.PersistingFailures)).To(Equal([]string{"rule-b@crn-1", "rule-c@crn-1"}))
+		Expect(keys(comparison.Inconclusive)).To(Equal([]string{"rule-b@crn-2"}))
+		Expect(keys(comparison.Appeared)).To(Equal([]string{"rule-a@crn-4", "rule-e@crn-1"}))
+		Expect(keys(comparison.Disappeared)).To(Equal([]string{"rule-c@crn-3"}))
+		Expect(comparison.AppearedResources).To(HaveLen(1))
+		Expect(*comparison.AppearedResources[0].ResourceCRN).To(Equal("crn-4"))
+		Expect(comparison.DisappearedResources).To(HaveLen(1))
+		Expect(*comparison.DisappearedResources[0].ResourceCRN).To(Equal("crn-3"))
+
+		Expect(comparison.NewlyFailing[0].Previous.Status).To(Equal(core.StringPtr("pass")))
+		Expect(comparison.NewlyFailing[0].Current.Status).To(Equal(core.StringPtr("failure")))
+		Expect(*comparison.Disappeared[0].Target().ResourceCRN).To(Equal("crn-3"))
+		Expect(comparison.Appeared[0].Previous).To(BeNil())
+
+		Expect(comparison.Summary).To(Equal(securityandcompliancecenterapiv3.ReportComparisonSummary{
+			PreviousEvaluations:  7,
+			CurrentEvaluations:   9,
+			NewlyFailing:         1,
+			NewlyPassing:         1,
+			PersistingFailures:   2,
+			Inconclusive:         1,
+			Unchanged:            1,
+			Appeared:             2,
+			AppearedFailing:      1,
+			Disappeared:          1,
+			AppearedResources:    1,
+			DisappearedResources: 1,
+		}))
+	})
+	It(`Compare reports without evaluations`, func() {
+		comparison := securityandcompliancecenterapiv3.CompareEvaluations(nil, nil)
+		Expect(comparison.NewlyFailing).To(BeEmpty())
+		Expect(comparison.Summary).To(Equal(securityandcompliancecenterapiv3.ReportComparisonSummary{}))
+	})
+
+	Describe(`CompareReports`, func() {
+		const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+		var testServer *httptest.Server
+		var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+		var requests []string
+
+		BeforeEach(func() {
+			requests = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.Method).To(Equal("GET"))
+				Expect(req.URL.Query().Get("scope_id")).To(Equal("scope-1"))
+				Expect(req.URL.Query().Get("subscope_id")).To(Equal("subscope-1"))
+				requests = append(requests, req.URL.EscapedPath()+"?start="+req.URL.Query().Get("start"))
+				res.Header().Set("Content-type", "application/json")
+
+				switch req.URL.EscapedPath() + "?start=" + req.URL.Query().Get("start") {
+				case "/instances/" + instanceID + "/v3/reports/previous/evaluations?start=":
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{"limit":1,"total_count":2,"next":{"start":"p2"},"evaluations":[{"assessment":{"assessment_id":"rule-a","parameters":[]},"target":{"resource_crn":"crn-1"},"status":"pass"}]}`)
+				case "/instances/" + instanceID + "/v3/reports/previous/evaluations?start=p2":
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{"limit":1,"total_count":2,"evaluations":[{"assessment":{"assessment_id":"rule-b","parameters":[]},"target":{"resource_crn":"crn-1"},"status":"failure"}]}`)
+				case "/instances/" + instanceID + "/v3/reports/current/evaluations?start=":
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{"limit":2,"total_count":2,"evaluations":[{"assessment":{"assessment_id":"rule-a","parameters":[]},"target":{"resource_crn":"crn-1"},"status":"failure"},{"assessment":{"assessment_id":"rule-b","parameters":[]},"target":{"resource_crn":"crn-1"},"status":"pass"}]}`)
+				default:
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors":[{"message":"not found"}]}`)
+				}
+			}))
+
+			var serviceErr error
+			securityAndComplianceCenterAPIService, serviceErr = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Invoke CompareReports successfully`, func() {
+			options := securityAndComplianceCenterAPIService.NewCompareReportsOptions(instanceID, "previous", "current").
+				SetScopeID("scope-1").
+				SetSubscopeID("subscope-1")
+			comparison, err := securityAndComplianceCenterAPIService.CompareReports(options)
+			Expect(err).To(BeNil())
+			Expect(requests).To(HaveLen(3))
+			Expect(comparison.PreviousReportID).To(Equal("previous"))
+			Expect(comparison.CurrentReportID).To(Equal("current"))
+			Expect(keys(comparison.NewlyFailing)).To(Equal([]string{"rule-a@crn-1"}))
+			Expect(keys(comparison.NewlyPassing)).To(Equal([]string{"rule-b@crn-1"}))
+			Expect(comparison.Summary.PreviousEvaluations).To(Equal(2))
+			Expect(comparison.Summary.CurrentEvaluations).To(Equal(2))
+		})
+		It(`Invoke CompareReports with error: Operation validation and request error`, func() {
+			comparison, err := securityAndComplianceCenterAPIService.CompareReports(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(comparison).To(BeNil())
+
+			options := securityAndComplianceCenterAPIService.NewCompareReportsOptions(instanceID, "previous", "unknown").
+				SetScopeID("scope-1").
+				SetSubscopeID("subscope-1")
+			comparison, err = securityAndComplianceCenterAPIService.CompareReports(options)
+			Expect(err).ToNot(BeNil())
+			Expect(comparison).To(BeNil())
+		})
+	})
+})
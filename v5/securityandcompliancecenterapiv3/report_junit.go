@@ -0,0 +1,467 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// JUnitTestSuites : The root element of a JUnit XML report. Each control of a report is a test suite.
+type JUnitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr,omitempty"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestSuite : A control of a report, as a JUnit test suite.
+type JUnitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	ID         string          `xml:"id,attr,omitempty"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Properties []JUnitProperty `xml:"properties>property,omitempty"`
+	TestCases  []JUnitTestCase `xml:"testcase"`
+}
+
+// JUnitProperty : A name/value property of a JUnit test suite.
+type JUnitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// JUnitTestCase : An assessment, or an assessment of a single resource, as a JUnit test case.
+type JUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *JUnitFailure `xml:"failure,omitempty"`
+	Error     *JUnitFailure `xml:"error,omitempty"`
+	Skipped   *JUnitSkipped `xml:"skipped,omitempty"`
+}
+
+// JUnitFailure : The failure or error of a JUnit test case.
+type JUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnitSkipped : The reason why a JUnit test case was skipped.
+type JUnitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// NewJUnitTestSuites converts the controls of a report into JUnit test suites: one suite per
+// control and one test case per assessment. Non-compliant assessments become failures,
+// assessments that could not be performed become errors, and not applicable assessments or
+// assessments that require a user evaluation are skipped.
+//
+// When "evaluations" is not nil, there is one test case per assessment and evaluated resource
+// instead, and the failure messages contain the expected and found values.
+func NewJUnitTestSuites(reportControls *ReportControls, evaluations []Evaluation) *JUnitTestSuites {
+	suites := &JUnitTestSuites{}
+	if reportControls == nil {
+		return suites
+	}
+	if reportControls.ReportID != nil {
+		suites.Name = *reportControls.ReportID
+	}
+
+	var byAssessment map[string][]*Evaluation
+	if evaluations != nil {
+		byAssessment = make(map[string][]*Evaluation)
+		for i := range evaluations {
+			evaluation := &evaluations[i]
+			if evaluation.Assessment == nil || evaluation.Assessment.AssessmentID == nil {
+				continue
+			}
+			assessmentID := *evaluation.Assessment.AssessmentID
+			byAssessment[assessmentID] = append(byAssessment[assessmentID], evaluation)
+			if evaluation.ComponentID != nil {
+				key := assessmentID + "|" + *evaluation.ComponentID
+				byAssessment[key] = append(byAssessment[key], evaluation)
+			}
+		}
+	}
+
+	for i := range reportControls.Controls {
+		suite := newJUnitTestSuite(&reportControls.Controls[i], byAssessment)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+	return suites
+}
+
+// Write writes the test suites to "w" as indented JUnit XML.
+func (suites *JUnitTestSuites) Write(w io.Writer) error {
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return core.SDKErrorf(err, "", "junit-write-error", common.GetComponentInfo())
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	err = encoder.Encode(suites)
+	if err == nil {
+		_, err = io.WriteString(w, "\n")
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "junit-encode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Passed returns true if none of the test cases failed or ended with an error.
+func (suites *JUnitTestSuites) Passed() bool {
+	return suites.Failures == 0 && suites.Errors == 0
+}
+
+// newJUnitTestSuite converts a control into a test suite.
+func newJUnitTestSuite(control *ControlWithStats, byAssessment map[string][]*Evaluation) JUnitTestSuite {
+	controlName := stringValue(control.ControlName, stringValue(control.ID, "unknown control"))
+	suite := JUnitTestSuite{
+		Name: controlName,
+		ID:   stringValue(control.ID, ""),
+	}
+	for _, property := range []struct {
+		name  string
+		value *string
+	}{
+		{"control_id", control.ID},
+		{"control_library_id", control.ControlLibraryID},
+		{"control_library_version", control.ControlLibraryVersion},
+		{"control_category", control.ControlCategory},
+		{"control_description", control.ControlDescription},
+		{"status", control.Status},
+	} {
+		if property.value != nil {
+			suite.Properties = append(suite.Properties, JUnitProperty{Name: property.name, Value: *property.value})
+		}
+	}
+
+	for i := range control.ControlSpecifications {
+		specification := &control.ControlSpecifications[i]
+		className := controlName + "." + stringValue(specification.ControlSpecificationID, "unknown")
+		for j := range specification.Assessments {
+			assessment := &specification.Assessments[j]
+			if byAssessment == nil {
+				suite.add(newJUnitAssessmentTestCase(control, specification, assessment, className))
+				continue
+			}
+			for _, testCase := range newJUnitResourceTestCases(control, specification, assessment, className, byAssessment) {
+				suite.add(testCase)
+			}
+		}
+	}
+	return suite
+}
+
+// add appends a test case to the suite and updates the counts.
+func (suite *JUnitTestSuite) add(testCase JUnitTestCase) {
+	suite.Tests++
+	switch {
+	case testCase.Failure != nil:
+		suite.Failures++
+	case testCase.Error != nil:
+		suite.Errors++
+	case testCase.Skipped != nil:
+		suite.Skipped++
+	}
+	suite.TestCases = append(suite.TestCases, testCase)
+}
+
+// newJUnitAssessmentTestCase converts an assessment into a single test case based on its statistics.
+func newJUnitAssessmentTestCase(control *ControlWithStats, specification *ControlSpecificationWithStats, assessment *AssessmentWithStats, className string) JUnitTestCase {
+	testCase := JUnitTestCase{
+		Name:      junitAssessmentName(assessment),
+		ClassName: className,
+	}
+	if skipped := junitSkipped(specification); skipped != nil {
+		testCase.Skipped = skipped
+		return testCase
+	}
+
+	total := int64Value(assessment.TotalCount)
+	failures := int64Value(assessment.FailureCount)
+	errors := int64Value(assessment.ErrorCount)
+	switch {
+	case failures > 0:
+		testCase.Failure = &JUnitFailure{
+			Message: fmt.Sprintf("%d of %d evaluations failed", failures, total),
+			Type:    ControlSpecificationWithStatsStatusNotCompliantConst,
+			Text:    stringValue(assessment.AssessmentDescription, ""),
+		}
+	case errors > 0:
+		testCase.Error = &JUnitFailure{
+			Message: fmt.Sprintf("%d of %d evaluations ended with an error", errors, total),
+			Type:    ControlSpecificationWithStatsStatusUnableToPerformConst,
+			Text:    stringValue(assessment.AssessmentDescription, ""),
+		}
+	case total == 0:
+		setJUnitUnevaluatedOutcome(&testCase, control, specification, assessment)
+	}
+	return testCase
+}
+
+// newJUnitResourceTestCases converts an assessment into one test case per evaluated resource.
+func newJUnitResourceTestCases(control *ControlWithStats, specification *ControlSpecificationWithStats, assessment *AssessmentWithStats, className string, byAssessment map[string][]*Evaluation) (testCases []JUnitTestCase) {
+	name := junitAssessmentName(assessment)
+	skipped := junitSkipped(specification)
+
+	var evaluations []*Evaluation
+	if assessment.AssessmentID != nil {
+		if specification.ComponentID != nil {
+			evaluations = byAssessment[*assessment.AssessmentID+"|"+*specification.ComponentID]
+		} else {
+			evaluations = byAssessment[*assessment.AssessmentID]
+		}
+	}
+	if len(evaluations) == 0 || skipped != nil {
+		testCase := newJUnitAssessmentTestCase(control, specification, assessment, className)
+		if len(evaluations) == 0 && testCase.Failure == nil && testCase.Error == nil && testCase.Skipped == nil {
+			setJUnitUnevaluatedOutcome(&testCase, control, specification, assessment)
+		}
+		return []JUnitTestCase{testCase}
+	}
+
+	for _, evaluation := range evaluations {
+		testCase := JUnitTestCase{
+			Name:      fmt.Sprintf("%s [%s]", name, junitResourceName(evaluation.Target)),
+			ClassName: className,
+		}
+		status := stringValue(evaluation.Status, "")
+		switch status {
+		case EvaluationStatusFailureConst:
+			testCase.Failure = &JUnitFailure{
+				Message: fmt.Sprintf("%s failed for %s", name, evaluationTargetName(evaluation.Target)),
+				Type:    ControlSpecificationWithStatsStatusNotCompliantConst,
+				Text:    evaluationMessage(evaluation, name),
+			}
+		case EvaluationStatusErrorConst:
+			testCase.Error = &JUnitFailure{
+				Message: fmt.Sprintf("%s could not be evaluated for %s", name, evaluationTargetName(evaluation.Target)),
+				Type:    ControlSpecificationWithStatsStatusUnableToPerformConst,
+				Text:    evaluationMessage(evaluation, name),
+			}
+		case EvaluationStatusSkippedConst:
+			testCase.Skipped = &JUnitSkipped{Message: stringValue(evaluation.Reason, EvaluationStatusSkippedConst)}
+		}
+		testCases = append(testCases, testCase)
+	}
+	return
+}
+
+// setJUnitUnevaluatedOutcome sets the outcome of the test case of an assessment without evaluations
+// from the status of its control specification, or of its control if the specification has no status.
+func setJUnitUnevaluatedOutcome(testCase *JUnitTestCase, control *ControlWithStats, specification *ControlSpecificationWithStats, assessment *AssessmentWithStats) {
+	status := stringValue(specification.Status, stringValue(control.Status, ""))
+	switch status {
+	case ControlSpecificationWithStatsStatusCompliantConst:
+	case ControlSpecificationWithStatsStatusNotCompliantConst:
+		testCase.Failure = &JUnitFailure{
+			Message: fmt.Sprintf("no evaluations, and the control is %s", status),
+			Type:    status,
+			Text:    stringValue(assessment.AssessmentDescription, ""),
+		}
+	case ControlSpecificationWithStatsStatusUnableToPerformConst:
+		testCase.Error = &JUnitFailure{
+			Message: fmt.Sprintf("no evaluations, and the control is %s", status),
+			Type:    status,
+			Text:    stringValue(assessment.AssessmentDescription, ""),
+		}
+	case "":
+		testCase.Skipped = &JUnitSkipped{Message: "no evaluations"}
+	default:
+		testCase.Skipped = &JUnitSkipped{Message: status}
+	}
+}
+
+// junitSkipped returns the reason why all of the assessments of the specification are skipped, if any.
+func junitSkipped(specification *ControlSpecificationWithStats) *JUnitSkipped {
+	status := stringValue(specification.Status, "")
+	switch status {
+	case ControlSpecificationWithStatsStatusNotApplicableConst, ControlSpecificationWithStatsStatusUserEvaluationRequiredConst:
+		return &JUnitSkipped{Message: status}
+	}
+	return nil
+}
+
+// junitAssessmentName returns the name of the test case of an assessment.
+func junitAssessmentName(assessment *AssessmentWithStats) string {
+	return stringValue(assessment.AssessmentID, stringValue(assessment.AssessmentDescription, "unknown assessment"))
+}
+
+// junitResourceName returns the most readable identifier of the target.
+func junitResourceName(target *TargetInfo) string {
+	if target != nil && target.ResourceName != nil && *target.ResourceName != "" {
+		return *target.ResourceName
+	}
+	return evaluationTargetName(target)
+}
+
+// stringValue dereferences "s", returning "defaultValue" if it is nil.
+func stringValue(s *string, defaultValue string) string {
+	if s == nil {
+		return defaultValue
+	}
+	return *s
+}
+
+// int64Value dereferences "i", returning 0 if it is nil.
+func int64Value(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// GetReportJUnitOptions : The GetReportJUnit options.
+type GetReportJUnitOptions struct {
+	// The ID of the Security and Compliance Center instance.
+	InstanceID *string `json:"instance_id" validate:"required,ne="`
+
+	// The ID of the scan that is associated with a report.
+	ReportID *string `json:"report_id" validate:"required,ne="`
+
+	// The ID of the scope.
+	ScopeID *string `json:"scope_id,omitempty"`
+
+	// The ID of the subscope.
+	SubscopeID *string `json:"subscope_id,omitempty"`
+
+	// When true, the evaluations of the report are retrieved as well to produce one test case per
+	// assessment and resource, instead of one test case per assessment.
+	ExpandResources *bool `json:"expand_resources,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetReportJUnitOptions : Instantiate GetReportJUnitOptions
+func (*SecurityAndComplianceCenterAPIV3) NewGetReportJUnitOptions(instanceID string, reportID string) *GetReportJUnitOptions {
+	return &GetReportJUnitOptions{
+		InstanceID: core.StringPtr(instanceID),
+		ReportID:   core.StringPtr(reportID),
+	}
+}
+
+// SetInstanceID : Allow user to set InstanceID
+func (_options *GetReportJUnitOptions) SetInstanceID(instanceID string) *GetReportJUnitOptions {
+	_options.InstanceID = core.StringPtr(instanceID)
+	return _options
+}
+
+// SetReportID : Allow user to set ReportID
+func (_options *GetReportJUnitOptions) SetReportID(reportID string) *GetReportJUnitOptions {
+	_options.ReportID = core.StringPtr(reportID)
+	return _options
+}
+
+// SetScopeID : Allow user to set ScopeID
+func (_options *GetReportJUnitOptions) SetScopeID(scopeID string) *GetReportJUnitOptions {
+	_options.ScopeID = core.StringPtr(scopeID)
+	return _options
+}
+
+// SetSubscopeID : Allow user to set SubscopeID
+func (_options *GetReportJUnitOptions) SetSubscopeID(subscopeID string) *GetReportJUnitOptions {
+	_options.SubscopeID = core.StringPtr(subscopeID)
+	return _options
+}
+
+// SetExpandResources : Allow user to set ExpandResources
+func (_options *GetReportJUnitOptions) SetExpandResources(expandResources bool) *GetReportJUnitOptions {
+	_options.ExpandResources = core.BoolPtr(expandResources)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetReportJUnitOptions) SetHeaders(param map[string]string) *GetReportJUnitOptions {
+	options.Headers = param
+	return options
+}
+
+// GetReportJUnit : Get report controls as JUnit test suites
+// Retrieve the controls of a report, and optionally its evaluations, and convert them into JUnit
+// test suites that CI pipelines can use to gate a deployment.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetReportJUnit(getReportJUnitOptions *GetReportJUnitOptions) (result *JUnitTestSuites, err error) {
+	result, err = securityAndComplianceCenterApi.GetReportJUnitWithContext(context.Background(), getReportJUnitOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetReportJUnitWithContext is an alternate form of the GetReportJUnit method which supports a Context parameter
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetReportJUnitWithContext(ctx context.Context, getReportJUnitOptions *GetReportJUnitOptions) (result *JUnitTestSuites, err error) {
+	err = core.ValidateNotNil(getReportJUnitOptions, "getReportJUnitOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getReportJUnitOptions, "getReportJUnitOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	getReportControlsOptions := &GetReportControlsOptions{
+		InstanceID: getReportJUnitOptions.InstanceID,
+		ReportID:   getReportJUnitOptions.ReportID,
+		ScopeID:    getReportJUnitOptions.ScopeID,
+		SubscopeID: getReportJUnitOptions.SubscopeID,
+		Headers:    getReportJUnitOptions.Headers,
+	}
+	reportControls, _, err := securityAndComplianceCenterApi.GetReportControlsWithContext(ctx, getReportControlsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-report-controls-error")
+		return
+	}
+
+	var evaluations []Evaluation
+	if getReportJUnitOptions.ExpandResources != nil && *getReportJUnitOptions.ExpandResources {
+		listReportEvaluationsOptions := &ListReportEvaluationsOptions{
+			InstanceID: getReportJUnitOptions.InstanceID,
+			ReportID:   getReportJUnitOptions.ReportID,
+			ScopeID:    getReportJUnitOptions.ScopeID,
+			SubscopeID: getReportJUnitOptions.SubscopeID,
+			Headers:    getReportJUnitOptions.Headers,
+		}
+		evaluations = []Evaluation{}
+		for evaluation, evaluationErr := range securityAndComplianceCenterApi.AllReportEvaluations(ctx, listReportEvaluationsOptions) {
+			if evaluationErr != nil {
+				err = core.RepurposeSDKProblem(evaluationErr, "list-report-evaluations-error")
+				return
+			}
+			evaluations = append(evaluations, evaluation)
+		}
+	}
+
+	result = NewJUnitTestSuites(reportControls, evaluations)
+	return
+}
@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`JUnit exporter`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	const reportID = "30b434b3-cb08-4845-af10-7a8fc682b6a8"
+	reportPath := "/instances/" + instanceID + "/v3/reports/" + reportID
+
+	var testServer *httptest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var requestedPaths []string
+
+	BeforeEach(func() {
+		requestedPaths = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("GET"))
+			requestedPaths = append(requestedPaths, req.URL.EscapedPath())
+			var fixture string
+			switch req.URL.EscapedPath() {
+			case reportPath + "/controls":
+				Expect(req.URL.Query().Get("scope_id")).To(Equal("scope-1"))
+				fixture = "controls.json"
+			case reportPath + "/evaluations":
+				Expect(req.URL.Query().Get("scope_id")).To(Equal("scope-1"))
+				fixture = "evaluations.json"
+			default:
+				res.WriteHeader(404)
+				return
+			}
+			data, err := os.ReadFile(filepath.Join("testdata", "junit", fixture))
+			Expect(err).To(BeNil())
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			res.Write(data)
+		}))
+
+		var serviceErr error
+		securityAndComplianceCenterAPIService, serviceErr = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke GetReportJUnit with one test case per assessment`, func() {
+		options := securityAndComplianceCenterAPIService.NewGetReportJUnitOptions(instanceID, reportID).SetScopeID("scope-1")
+		suites, err := securityAndComplianceCenterAPIService.GetReportJUnit(options)
+		Expect(err).To(BeNil())
+		Expect(requestedPaths).To(Equal([]string{reportPath + "/controls"}))
+
+		Expect(suites.Name).To(Equal(reportID))
+		Expect(suites.Suites).To(HaveLen(3))
+		Expect(suites.Tests).To(Equal(6))
+		Expect(suites.Failures).To(Equal(2))
+		Expect(suites.Errors).To(Equal(1))
+		Expect(suites.Skipped).To(Equal(1))
+		Expect(suites.Passed()).To(BeFalse())
+
+		// The assessments without evaluations have the status of their control specification.
+		sc7 := suites.Suites[0]
+		Expect(sc7.Name).To(Equal("SC-7"))
+		Expect(sc7.TestCases[0].Failure.Message).To(Equal("1 of 2 evaluations failed"))
+		Expect(sc7.TestCases[1].Failure.Message).To(Equal("no evaluations, and the control is not_compliant"))
+		Expect(sc7.TestCases[2].Error.Message).To(Equal("1 of 1 evaluations ended with an error"))
+		Expect(suites.Suites[1].TestCases[1].Skipped).To(BeNil())
+		Expect(suites.Suites[1].TestCases[1].Failure).To(BeNil())
+		Expect(suites.Suites[2].TestCases[0].Skipped.Message).To(Equal("user_evaluation_required"))
+
+		var buffer bytes.Buffer
+		Expect(suites.Write(&buffer)).To(Succeed())
+		expectGoldenFile(filepath.Join("testdata", "junit", "controls.xml"), buffer.Bytes())
+
+		var decoded securityandcompliancecenterapiv3.JUnitTestSuites
+		Expect(xml.Unmarshal(buffer.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded.Tests).To(Equal(6))
+	})
+	It(`Invoke GetReportJUnit with one test case per assessment and resource`, func() {
+		options := securityAndComplianceCenterAPIService.NewGetReportJUnitOptions(instanceID, reportID).
+			SetScopeID("scope-1").
+			SetExpandResources(true)
+		suites, err := securityAndComplianceCenterAPIService.GetReportJUnit(options)
+		Expect(err).To(BeNil())
+		Expect(requestedPaths).To(Equal([]string{reportPath + "/controls", reportPath + "/evaluations"}))
+
+		Expect(suites.Tests).To(Equal(7))
+		Expect(suites.Failures).To(Equal(2))
+		Expect(suites.Errors).To(Equal(1))
+		Expect(suites.Skipped).To(Equal(1))
+
+		sc7 := suites.Suites[0]
+		Expect(sc7.TestCases[0].Name).To(Equal("rule-a637949b-7e51-46c4-afd4-b96619001bf1 [bucket-1]"))
+		Expect(sc7.TestCases[0].Failure.Text).To(ContainSubstring("public_access_enabled is_false: expected false, found true"))
+		Expect(sc7.TestCases[1].Name).To(Equal("rule-a637949b-7e51-46c4-afd4-b96619001bf1 [bucket-2]"))
+		Expect(sc7.TestCases[1].Failure).To(BeNil())
+		Expect(sc7.TestCases[2].Failure.Message).To(Equal("no evaluations, and the control is not_compliant"))
+
+		var buffer bytes.Buffer
+		Expect(suites.Write(&buffer)).To(Succeed())
+		expectGoldenFile(filepath.Join("testdata", "junit", "resources.xml"), buffer.Bytes())
+	})
+	It(`Invoke GetReportJUnit with an assessment shared by several components`, func() {
+		testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case reportPath + "/controls":
+				res.Write([]byte(`{"controls":[{"control_name":"SC-7","control_specifications":[` +
+					`{"component_id":"cloud-object-storage","status":"not_compliant","assessments":[{"assessment_id":"rule-a"}]},` +
+					`{"component_id":"containers-kubernetes","status":"compliant","assessments":[{"assessment_id":"rule-a"}]}]}]}`))
+			case reportPath + "/evaluations":
+				res.Write([]byte(`{"evaluations":[{"component_id":"cloud-object-storage","assessment":{"assessment_id":"rule-a"},` +
+					`"target":{"resource_name":"bucket-1"},"status":"failure"}]}`))
+			}
+		})
+
+		options := securityAndComplianceCenterAPIService.NewGetReportJUnitOptions(instanceID, reportID).SetExpandResources(true)
+		suites, err := securityAndComplianceCenterAPIService.GetReportJUnit(options)
+		Expect(err).To(BeNil())
+		Expect(suites.Tests).To(Equal(2))
+		Expect(suites.Failures).To(Equal(1))
+
+		testCases := suites.Suites[0].TestCases
+		Expect(testCases[0].Name).To(Equal("rule-a [bucket-1]"))
+		Expect(testCases[0].Failure).ToNot(BeNil())
+		Expect(testCases[1].Name).To(Equal("rule-a"))
+		Expect(testCases[1].Failure).To(BeNil())
+	})
+	It(`Invoke GetReportJUnit with error: Operation validation and request error`, func() {
+		suites, err := securityAndComplianceCenterAPIService.GetReportJUnit(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(suites).To(BeNil())
+
+		suites, err = securityAndComplianceCenterAPIService.GetReportJUnit(securityAndComplianceCenterAPIService.NewGetReportJUnitOptions(instanceID, "unknown"))
+		Expect(err).ToNot(BeNil())
+		Expect(suites).To(BeNil())
+	})
+	It(`Convert an empty report`, func() {
+		suites := securityandcompliancecenterapiv3.NewJUnitTestSuites(nil, nil)
+		Expect(suites.Tests).To(Equal(0))
+		Expect(suites.Passed()).To(BeTrue())
+	})
+})
@@ -0,0 +1,331 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// Constants that identify the SARIF version produced by SARIFExporter.
+const (
+	SARIFVersion   = "2.1.0"
+	SARIFSchemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// Constants associated with the SARIFResult.Kind and SARIFResult.Level properties.
+const (
+	SARIFResultKindFailConst   = "fail"
+	SARIFResultKindReviewConst = "review"
+	SARIFResultLevelErrorConst = "error"
+	SARIFResultLevelNoneConst  = "none"
+)
+
+// SARIFFingerprintName is the name of the partial fingerprint that identifies a result across
+// reports: the assessment ID and the resource CRN.
+const SARIFFingerprintName = "sccEvaluation/v1"
+
+// SARIFLog : The top-level object of a SARIF 2.1.0 log file.
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// SARIFRun : A single run of an analysis tool.
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+// SARIFTool : The analysis tool that produced a run.
+type SARIFTool struct {
+	Driver SARIFToolComponent `json:"driver"`
+}
+
+// SARIFToolComponent : The component of the analysis tool that defines the rules.
+type SARIFToolComponent struct {
+	Name           string                     `json:"name"`
+	Version        string                     `json:"version,omitempty"`
+	InformationURI string                     `json:"informationUri,omitempty"`
+	Rules          []SARIFReportingDescriptor `json:"rules,omitempty"`
+}
+
+// SARIFReportingDescriptor : A rule, which is an assessment in Security and Compliance Center terms.
+type SARIFReportingDescriptor struct {
+	ID                   string                       `json:"id"`
+	ShortDescription     *SARIFMessage                `json:"shortDescription,omitempty"`
+	DefaultConfiguration *SARIFReportingConfiguration `json:"defaultConfiguration,omitempty"`
+	Properties           map[string]interface{}       `json:"properties,omitempty"`
+}
+
+// SARIFReportingConfiguration : The default configuration of a rule.
+type SARIFReportingConfiguration struct {
+	Level string `json:"level"`
+}
+
+// SARIFMessage : A plain text message.
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// SARIFResult : A result, which is a failing evaluation in Security and Compliance Center terms.
+type SARIFResult struct {
+	RuleID              string                 `json:"ruleId"`
+	RuleIndex           int                    `json:"ruleIndex"`
+	Kind                string                 `json:"kind"`
+	Level               string                 `json:"level"`
+	Message             SARIFMessage           `json:"message"`
+	Locations           []SARIFLocation        `json:"locations,omitempty"`
+	PartialFingerprints map[string]string      `json:"partialFingerprints,omitempty"`
+	Properties          map[string]interface{} `json:"properties,omitempty"`
+}
+
+// SARIFLocation : The location of a result, which is the evaluated resource.
+type SARIFLocation struct {
+	PhysicalLocation *SARIFPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []SARIFLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+// SARIFPhysicalLocation : The artifact in which a result was found.
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+}
+
+// SARIFArtifactLocation : The URI of an artifact.
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIFLogicalLocation : A named resource in which a result was found.
+type SARIFLogicalLocation struct {
+	Name               string `json:"name,omitempty"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind,omitempty"`
+}
+
+// SARIFExporter converts report evaluations into a SARIF 2.1.0 log. Each assessment becomes a
+// rule and each failing evaluation becomes a result located by the evaluated resource.
+type SARIFExporter struct {
+	// The name of the tool recorded in the log. Defaults to "IBM Cloud Security and Compliance Center".
+	ToolName string
+
+	// The version of the tool recorded in the log. Defaults to the version of this SDK.
+	ToolVersion string
+
+	// The information URI of the tool recorded in the log.
+	InformationURI string
+
+	// When true, evaluations with status "error" are also exported, as results of kind "review".
+	IncludeErrors bool
+
+	rules     []SARIFReportingDescriptor
+	ruleIndex map[string]int
+	results   []SARIFResult
+}
+
+// NewSARIFExporter : Instantiate SARIFExporter
+func NewSARIFExporter() *SARIFExporter {
+	return &SARIFExporter{
+		ToolName:       "IBM Cloud Security and Compliance Center",
+		ToolVersion:    common.Version,
+		InformationURI: "https://cloud.ibm.com/docs/security-compliance",
+	}
+}
+
+// AddEvaluations adds evaluations, such as the Evaluations of an EvaluationPage, to the log.
+func (exporter *SARIFExporter) AddEvaluations(evaluations []Evaluation) {
+	for i := range evaluations {
+		exporter.addEvaluation(&evaluations[i])
+	}
+}
+
+// AddPager adds all of the remaining evaluations of "pager", such as a ReportEvaluationsPager, to the log.
+func (exporter *SARIFExporter) AddPager(ctx context.Context, pager Pager[Evaluation]) error {
+	for evaluation, err := range PagerItems(ctx, pager) {
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "sarif-pager-error")
+		}
+		exporter.addEvaluation(&evaluation)
+	}
+	return nil
+}
+
+// Log returns the SARIF log of the evaluations added so far.
+func (exporter *SARIFExporter) Log() *SARIFLog {
+	results := exporter.results
+	if results == nil {
+		results = []SARIFResult{}
+	}
+	return &SARIFLog{
+		Schema:  SARIFSchemaURI,
+		Version: SARIFVersion,
+		Runs: []SARIFRun{
+			{
+				Tool: SARIFTool{
+					Driver: SARIFToolComponent{
+						Name:           exporter.ToolName,
+						Version:        exporter.ToolVersion,
+						InformationURI: exporter.InformationURI,
+						Rules:          exporter.rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+// Write writes the SARIF log of the evaluations added so far to "w" as indented JSON.
+func (exporter *SARIFExporter) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(exporter.Log())
+	if err != nil {
+		return core.SDKErrorf(err, "", "sarif-encode-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// addEvaluation registers the assessment of the evaluation as a rule and, if the evaluation
+// failed, adds a result for it.
+func (exporter *SARIFExporter) addEvaluation(evaluation *Evaluation) {
+	ruleID := exporter.addRule(evaluation.Assessment)
+
+	status := ""
+	if evaluation.Status != nil {
+		status = *evaluation.Status
+	}
+	result := SARIFResult{
+		RuleID:    ruleID,
+		RuleIndex: exporter.ruleIndex[ruleID],
+	}
+	switch {
+	case status == EvaluationStatusFailureConst:
+		result.Kind = SARIFResultKindFailConst
+		result.Level = SARIFResultLevelErrorConst
+	case status == EvaluationStatusErrorConst && exporter.IncludeErrors:
+		result.Kind = SARIFResultKindReviewConst
+		result.Level = SARIFResultLevelNoneConst
+	default:
+		return
+	}
+
+	result.Message = SARIFMessage{Text: evaluationMessage(evaluation, ruleID)}
+	if location, ok := sarifLocation(evaluation.Target); ok {
+		result.Locations = []SARIFLocation{location}
+	}
+	result.PartialFingerprints = map[string]string{
+		SARIFFingerprintName: ruleID + "|" + evaluationTargetName(evaluation.Target),
+	}
+
+	properties := map[string]interface{}{
+		"status": status,
+	}
+	for name, value := range map[string]*string{
+		"report_id":      evaluation.ReportID,
+		"component_id":   evaluation.ComponentID,
+		"component_name": evaluation.ComponentName,
+		"evaluate_time":  evaluation.EvaluateTime,
+		"evaluated_by":   evaluation.EvaluatedBy,
+	} {
+		if value != nil {
+			properties[name] = *value
+		}
+	}
+	result.Properties = properties
+
+	exporter.results = append(exporter.results, result)
+}
+
+// addRule registers the assessment as a rule, once, and returns the rule ID.
+func (exporter *SARIFExporter) addRule(assessment *Assessment) string {
+	ruleID := "unknown"
+	if assessment != nil && assessment.AssessmentID != nil {
+		ruleID = *assessment.AssessmentID
+	}
+	if exporter.ruleIndex == nil {
+		exporter.ruleIndex = make(map[string]int)
+	}
+	if _, exists := exporter.ruleIndex[ruleID]; exists {
+		return ruleID
+	}
+
+	rule := SARIFReportingDescriptor{
+		ID: ruleID,
+		DefaultConfiguration: &SARIFReportingConfiguration{
+			Level: SARIFResultLevelErrorConst,
+		},
+	}
+	if assessment != nil {
+		if assessment.AssessmentDescription != nil {
+			rule.ShortDescription = &SARIFMessage{Text: *assessment.AssessmentDescription}
+		}
+		properties := make(map[string]interface{})
+		if assessment.AssessmentType != nil {
+			properties["assessment_type"] = *assessment.AssessmentType
+		}
+		if assessment.AssessmentMethod != nil {
+			properties["assessment_method"] = *assessment.AssessmentMethod
+		}
+		if len(properties) > 0 {
+			rule.Properties = properties
+		}
+	}
+
+	exporter.ruleIndex[ruleID] = len(exporter.rules)
+	exporter.rules = append(exporter.rules, rule)
+	return ruleID
+}
+
+// sarifLocation locates a result by the resource CRN (or ID) and name of the target.
+func sarifLocation(target *TargetInfo) (location SARIFLocation, ok bool) {
+	if target == nil {
+		return
+	}
+	uri := ""
+	if target.ResourceCRN != nil && *target.ResourceCRN != "" {
+		uri = *target.ResourceCRN
+	} else if target.ID != nil && *target.ID != "" {
+		uri = *target.ID
+	}
+	name := ""
+	if target.ResourceName != nil {
+		name = *target.ResourceName
+	}
+	if uri == "" && name == "" {
+		return
+	}
+
+	if uri != "" {
+		location.PhysicalLocation = &SARIFPhysicalLocation{
+			ArtifactLocation: SARIFArtifactLocation{URI: uri},
+		}
+	}
+	logicalLocation := SARIFLogicalLocation{
+		Name:               name,
+		FullyQualifiedName: uri,
+		Kind:               "resource",
+	}
+	location.LogicalLocations = []SARIFLogicalLocation{logicalLocation}
+	ok = true
+	return
+}
@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// Run "go test ./securityandcompliancecenterapiv3 -args -update-golden" to regenerate the golden files.
+var updateGoldenFiles = flag.Bool("update-golden", false, "update the golden files in testdata")
+
+// expectGoldenFile compares "actual" with the content of the golden file at "path".
+func expectGoldenFile(path string, actual []byte) {
+	if *updateGoldenFiles {
+		Expect(os.WriteFile(path, actual, 0644)).To(Succeed())
+	}
+	expected, err := os.ReadFile(path)
+	Expect(err).To(BeNil())
+	Expect(string(actual)).To(Equal(string(expected)))
+}
+
+// loadEvaluationPage reads an EvaluationPage from a JSON file in testdata.
+func loadEvaluationPage(path string) *securityandcompliancecenterapiv3.EvaluationPage {
+	data, err := os.ReadFile(path)
+	Expect(err).To(BeNil())
+	var raw map[string]json.RawMessage
+	Expect(json.Unmarshal(data, &raw)).To(Succeed())
+	var page *securityandcompliancecenterapiv3.EvaluationPage
+	Expect(core.UnmarshalModel(raw, "", &page, securityandcompliancecenterapiv3.UnmarshalEvaluationPage)).To(Succeed())
+	return page
+}
+
+var _ = Describe(`SARIFExporter`, func() {
+	newExporter := func() *securityandcompliancecenterapiv3.SARIFExporter {
+		exporter := securityandcompliancecenterapiv3.NewSARIFExporter()
+		exporter.ToolVersion = "0.0.0-test"
+		return exporter
+	}
+
+	table.DescribeTable(`Export evaluations as SARIF`,
+		func(input string, includeErrors bool, golden string) {
+			exporter := newExporter()
+			exporter.IncludeErrors = includeErrors
+			if input != "" {
+				exporter.AddEvaluations(loadEvaluationPage(filepath.Join("testdata", "sarif", input)).Evaluations)
+			}
+
+			var buffer bytes.Buffer
+			Expect(exporter.Write(&buffer)).To(Succeed())
+			expectGoldenFile(filepath.Join("testdata", "sarif", golden), buffer.Bytes())
+
+			var log map[string]interface{}
+			Expect(json.Unmarshal(buffer.Bytes(), &log)).To(Succeed())
+			Expect(log["version"]).To(Equal("2.1.0"))
+			Expect(log["runs"]).To(HaveLen(1))
+		},
+		table.Entry(`failures only`, "evaluations.json", false, "evaluations.sarif"),
+		table.Entry(`failures and errors`, "evaluations.json", true, "evaluations_with_errors.sarif"),
+		table.Entry(`no evaluations`, "", false, "empty.sarif"),
+	)
+
+	It(`Maps assessments to rules and failing evaluations to results`, func() {
+		exporter := newExporter()
+		exporter.AddEvaluations(loadEvaluationPage(filepath.Join("testdata", "sarif", "evaluations.json")).Evaluations)
+		log := exporter.Log()
+
+		rules := log.Runs[0].Tool.Driver.Rules
+		Expect(rules).To(HaveLen(4))
+		Expect(rules[0].ID).To(Equal("rule-a637949b-7e51-46c4-afd4-b96619001bf1"))
+		Expect(rules[3].ID).To(Equal("unknown"))
+
+		results := log.Runs[0].Results
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].RuleIndex).To(Equal(0))
+		Expect(results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI).To(HaveSuffix("bucket:bucket-1"))
+		Expect(results[0].Locations[0].LogicalLocations[0].Name).To(Equal("bucket-1"))
+		Expect(results[0].Message.Text).To(ContainSubstring("public_access_enabled is_false: expected false, found true"))
+		Expect(results[1].RuleIndex).To(Equal(1))
+		Expect(results[1].Message.Text).To(ContainSubstring(`mfa string_equals: expected "TOTP", found "NONE"`))
+		Expect(results[2].Locations).To(BeEmpty())
+	})
+
+	Describe(`AddPager`, func() {
+		var testServer *httptest.Server
+		var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+
+		BeforeEach(func() {
+			data, err := os.ReadFile(filepath.Join("testdata", "sarif", "evaluations.json"))
+			Expect(err).To(BeNil())
+			var page struct {
+				Evaluations []json.RawMessage `json:"evaluations"`
+			}
+			Expect(json.Unmarshal(data, &page)).To(Succeed())
+
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				var body map[string]interface{}
+				switch req.URL.Query().Get("start") {
+				case "":
+					body = map[string]interface{}{"limit": 3, "total_count": 5, "next": map[string]string{"start": "page2"}, "evaluations": page.Evaluations[:3]}
+				case "page2":
+					body = map[string]interface{}{"limit": 3, "total_count": 5, "evaluations": page.Evaluations[3:]}
+				default:
+					Fail(fmt.Sprintf("unexpected start %s", req.URL.Query().Get("start")))
+				}
+				Expect(json.NewEncoder(res).Encode(body)).To(Succeed())
+			}))
+
+			securityAndComplianceCenterAPIService, err = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Export all evaluations of a ReportEvaluationsPager`, func() {
+			pager, err := securityAndComplianceCenterAPIService.NewReportEvaluationsPager(&securityandcompliancecenterapiv3.ListReportEvaluationsOptions{
+				InstanceID: core.StringPtr("acd7032c-15a3-484f-bf5b-67d41534d940"),
+				ReportID:   core.StringPtr("30b434b3-cb08-4845-af10-7a8fc682b6a8"),
+				Limit:      core.Int64Ptr(int64(3)),
+			})
+			Expect(err).To(BeNil())
+
+			exporter := newExporter()
+			Expect(exporter.AddPager(context.Background(), pager)).To(Succeed())
+
+			var buffer bytes.Buffer
+			Expect(exporter.Write(&buffer)).To(Succeed())
+			expected, err := os.ReadFile(filepath.Join("testdata", "sarif", "evaluations.sarif"))
+			Expect(err).To(BeNil())
+			Expect(buffer.String()).To(Equal(string(expected)))
+		})
+	})
+})
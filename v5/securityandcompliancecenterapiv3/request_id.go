@@ -0,0 +1,87 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// requestIDHeader is the header that identifies a request, which IBM support uses to find the
+// request in the logs of the service.
+const requestIDHeader = "X-Request-Id"
+
+// sentRequestIDContextKey is the key of the sentRequestID of a context.
+type sentRequestIDContextKey struct{}
+
+// sentRequestID records the request ID of the last request sent with a context.
+type sentRequestID struct {
+	id atomic.Value
+}
+
+// WithSentRequestID returns a copy of "ctx" that records the request ID that the operations
+// invoked with it send in the x-request-id header: the request ID of the context, set with
+// common.WithRequestID, or a new UUID. Use GetSentRequestID to retrieve it after the operation,
+// for example to quote it in a support ticket. The APIError of an error response carries the same
+// ID.
+func WithSentRequestID(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sentRequestIDContextKey{}, &sentRequestID{})
+}
+
+// GetSentRequestID returns the request ID of the last request sent by an operation invoked with
+// "ctx", or with a context derived from it, once "ctx" was returned by WithSentRequestID. It
+// returns an empty string if no request was sent with it.
+//
+// The x-request-id header that the service returns, if any, is left in the headers of the
+// response: see APIError.ServiceRequestID.
+func GetSentRequestID(ctx context.Context) string {
+	recorder, _ := ctx.Value(sentRequestIDContextKey{}).(*sentRequestID)
+	if recorder == nil {
+		return ""
+	}
+	requestID, _ := recorder.id.Load().(string)
+	return requestID
+}
+
+// request sends a request of an operation with Service.Request, for the page requested by a pager
+// if any (see withPageStart), and records the request ID of the request in its context (see
+// WithSentRequestID).
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) request(req *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	setPageStart(req)
+	if recorder, _ := req.Context().Value(sentRequestIDContextKey{}).(*sentRequestID); recorder != nil {
+		recorder.id.Store(getRequestIDHeader(req.Header))
+	}
+	return securityAndComplianceCenterApi.Service.Request(req, result)
+}
+
+// getRequestIDHeader returns the x-request-id header of a request. The request builder of the core
+// doesn't canonicalize the names of the headers, so the header can't be retrieved with Get.
+func getRequestIDHeader(header http.Header) string {
+	if requestID := header.Get(requestIDHeader); requestID != "" {
+		return requestID
+	}
+	for name, values := range header {
+		if strings.EqualFold(name, requestIDHeader) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Request IDs`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	var testServer *httptest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var status int
+	var received []string
+	var serviceRequestID string
+
+	BeforeEach(func() {
+		status = 200
+		received = nil
+		serviceRequestID = ""
+		// The server doesn't copy the x-request-id header to the response, but may return its own.
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			received = append(received, req.Header.Get("X-Request-Id"))
+			res.Header().Set("Content-type", "application/json")
+			if serviceRequestID != "" {
+				res.Header().Set("X-Request-Id", serviceRequestID)
+			}
+			res.WriteHeader(status)
+			if status >= 300 {
+				fmt.Fprintf(res, `{"status_code":%d,"trace":"trace-1","errors":[{"code":"not_found","message":"not found"}]}`, status)
+				return
+			}
+			fmt.Fprint(res, `{"event_notifications":{},"object_storage":{}}`)
+		}))
+
+		var serviceErr error
+		securityAndComplianceCenterAPIService, serviceErr = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Send the request ID of the context`, func() {
+		ctx := securityandcompliancecenterapiv3.WithSentRequestID(common.WithRequestID(context.Background(), "inbound-request-1"))
+		_, _, err := securityAndComplianceCenterAPIService.GetSettingsWithContext(ctx, securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(received).To(Equal([]string{"inbound-request-1"}))
+		Expect(securityandcompliancecenterapiv3.GetSentRequestID(ctx)).To(Equal("inbound-request-1"))
+	})
+	It(`Send a new request ID without a request ID in the context`, func() {
+		ctx := securityandcompliancecenterapiv3.WithSentRequestID(context.Background())
+		Expect(securityandcompliancecenterapiv3.GetSentRequestID(ctx)).To(BeEmpty())
+		_, _, err := securityAndComplianceCenterAPIService.GetSettingsWithContext(ctx, securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		first := securityandcompliancecenterapiv3.GetSentRequestID(ctx)
+		_, _, err = securityAndComplianceCenterAPIService.GetSettingsWithContext(ctx, securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		second := securityandcompliancecenterapiv3.GetSentRequestID(ctx)
+
+		Expect(received).To(HaveLen(2))
+		Expect(received[0]).ToNot(BeEmpty())
+		Expect(received[1]).ToNot(Equal(received[0]))
+		Expect(first).To(Equal(received[0]))
+		Expect(second).To(Equal(received[1]))
+	})
+	It(`Expose the request ID of an error response`, func() {
+		status = 404
+		ctx := common.WithRequestID(context.Background(), "inbound-request-2")
+		_, _, err := securityAndComplianceCenterAPIService.GetSettingsWithContext(ctx, securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).ToNot(BeNil())
+
+		var apiErr *securityandcompliancecenterapiv3.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.RequestID).To(Equal("inbound-request-2"))
+	})
+	It(`Expose the request ID that was sent apart from the request ID of the service`, func() {
+		serviceRequestID = "service-request-1"
+		ctx := securityandcompliancecenterapiv3.WithSentRequestID(common.WithRequestID(context.Background(), "inbound-request-3"))
+		_, response, err := securityAndComplianceCenterAPIService.GetSettingsWithContext(ctx, securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(securityandcompliancecenterapiv3.GetSentRequestID(ctx)).To(Equal("inbound-request-3"))
+		Expect(response.GetHeaders().Get("X-Request-Id")).To(Equal("service-request-1"))
+		Expect(response.GetHeaders()).ToNot(HaveKey("X-Sent-Request-Id"))
+
+		status = 500
+		_, _, err = securityAndComplianceCenterAPIService.GetSettingsWithContext(ctx, securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).ToNot(BeNil())
+		Expect(securityandcompliancecenterapiv3.GetSentRequestID(ctx)).To(Equal("inbound-request-3"))
+
+		var apiErr *securityandcompliancecenterapiv3.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.RequestID).To(Equal("inbound-request-3"))
+		Expect(apiErr.ServiceRequestID).To(Equal("service-request-1"))
+	})
+	It(`Don't expose a request ID without WithSentRequestID`, func() {
+		_, _, err := securityAndComplianceCenterAPIService.GetSettings(securityAndComplianceCenterAPIService.NewGetSettingsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(securityandcompliancecenterapiv3.GetSentRequestID(context.Background())).To(BeEmpty())
+	})
+})
@@ -0,0 +1,191 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// Constants that identify the kind of a condition within a required config tree.
+const (
+	ConditionKindBaseConst        = "base"
+	ConditionKindAndConst         = "and"
+	ConditionKindOrConst          = "or"
+	ConditionKindAnyConst         = "any"
+	ConditionKindAnyIfexistsConst = "any_ifexists"
+	ConditionKindAllConst         = "all"
+	ConditionKindAllIfexistsConst = "all_ifexists"
+)
+
+// requiredConfigNode is a flattened view of the many concrete RequiredConfigIntf and
+// ConditionItemIntf models, which all share the same set of JSON properties.
+type requiredConfigNode struct {
+	kind        string
+	description *string
+	property    *string
+	operator    *string
+	value       interface{}
+	items       []ConditionItemIntf
+	subRule     *SubRule
+}
+
+// newRequiredConfigNode flattens any RequiredConfigIntf implementation into a requiredConfigNode.
+func newRequiredConfigNode(requiredConfig RequiredConfigIntf) (node *requiredConfigNode, err error) {
+	var fields requiredConfigFields
+	switch rc := requiredConfig.(type) {
+	case *RequiredConfig:
+		fields = requiredConfigFields{rc.Description, rc.Property, rc.Operator, rc.Value, rc.And, rc.Or, rc.Any, rc.AnyIfexists, rc.All, rc.AllIfexists}
+	case *RequiredConfigConditionBase:
+		fields = requiredConfigFields{description: rc.Description, property: rc.Property, operator: rc.Operator, value: rc.Value}
+	case *RequiredConfigConditionList:
+		fields = requiredConfigFields{description: rc.Description, and: rc.And, or: rc.Or}
+	case *RequiredConfigConditionListConditionListConditionAnd:
+		fields = requiredConfigFields{description: rc.Description, and: nonNilItems(rc.And)}
+	case *RequiredConfigConditionListConditionListConditionOr:
+		fields = requiredConfigFields{description: rc.Description, or: nonNilItems(rc.Or)}
+	case *RequiredConfigConditionSubRule:
+		fields = requiredConfigFields{any: rc.Any, anyIfexists: rc.AnyIfexists, all: rc.All, allIfexists: rc.AllIfexists}
+	case *RequiredConfigConditionSubRuleConditionSubRuleConditionAll:
+		fields = requiredConfigFields{all: rc.All}
+	case *RequiredConfigConditionSubRuleConditionSubRuleConditionAllIf:
+		fields = requiredConfigFields{allIfexists: rc.AllIfexists}
+	case *RequiredConfigConditionSubRuleConditionSubRuleConditionAny:
+		fields = requiredConfigFields{any: rc.Any}
+	case *RequiredConfigConditionSubRuleConditionSubRuleConditionAnyIf:
+		fields = requiredConfigFields{anyIfexists: rc.AnyIfexists}
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported required config type %T", requiredConfig), "unsupported-required-config", common.GetComponentInfo())
+		return
+	}
+	return fields.node()
+}
+
+// newConditionItemNode flattens any ConditionItemIntf implementation into a requiredConfigNode.
+func newConditionItemNode(conditionItem ConditionItemIntf) (node *requiredConfigNode, err error) {
+	var fields requiredConfigFields
+	switch ci := conditionItem.(type) {
+	case *ConditionItem:
+		fields = requiredConfigFields{ci.Description, ci.Property, ci.Operator, ci.Value, ci.And, ci.Or, ci.Any, ci.AnyIfexists, ci.All, ci.AllIfexists}
+	case *ConditionItemConditionBase:
+		fields = requiredConfigFields{description: ci.Description, property: ci.Property, operator: ci.Operator, value: ci.Value}
+	case *ConditionItemConditionList:
+		fields = requiredConfigFields{description: ci.Description, and: ci.And, or: ci.Or}
+	case *ConditionItemConditionListConditionListConditionAnd:
+		fields = requiredConfigFields{description: ci.Description, and: nonNilItems(ci.And)}
+	case *ConditionItemConditionListConditionListConditionOr:
+		fields = requiredConfigFields{description: ci.Description, or: nonNilItems(ci.Or)}
+	case *ConditionItemConditionSubRule:
+		fields = requiredConfigFields{any: ci.Any, anyIfexists: ci.AnyIfexists, all: ci.All, allIfexists: ci.AllIfexists}
+	case *ConditionItemConditionSubRuleConditionSubRuleConditionAll:
+		fields = requiredConfigFields{all: ci.All}
+	case *ConditionItemConditionSubRuleConditionSubRuleConditionAllIf:
+		fields = requiredConfigFields{allIfexists: ci.AllIfexists}
+	case *ConditionItemConditionSubRuleConditionSubRuleConditionAny:
+		fields = requiredConfigFields{any: ci.Any}
+	case *ConditionItemConditionSubRuleConditionSubRuleConditionAnyIf:
+		fields = requiredConfigFields{anyIfexists: ci.AnyIfexists}
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported condition item type %T", conditionItem), "unsupported-condition-item", common.GetComponentInfo())
+		return
+	}
+	return fields.node()
+}
+
+// requiredConfigFields holds the union of the properties of the required config models.
+type requiredConfigFields struct {
+	description *string
+	property    *string
+	operator    *string
+	value       interface{}
+	and         []ConditionItemIntf
+	or          []ConditionItemIntf
+	any         *SubRule
+	anyIfexists *SubRule
+	all         *SubRule
+	allIfexists *SubRule
+}
+
+// node determines the kind of condition described by the fields. Exactly one kind must be present.
+func (fields requiredConfigFields) node() (node *requiredConfigNode, err error) {
+	node = &requiredConfigNode{
+		description: fields.description,
+	}
+
+	var kinds []string
+	if fields.operator != nil || fields.property != nil {
+		kinds = append(kinds, ConditionKindBaseConst)
+		node.kind = ConditionKindBaseConst
+		node.property = fields.property
+		node.operator = fields.operator
+		node.value = fields.value
+	}
+	if fields.and != nil {
+		kinds = append(kinds, ConditionKindAndConst)
+		node.kind = ConditionKindAndConst
+		node.items = fields.and
+	}
+	if fields.or != nil {
+		kinds = append(kinds, ConditionKindOrConst)
+		node.kind = ConditionKindOrConst
+		node.items = fields.or
+	}
+	subRules := []struct {
+		kind    string
+		subRule *SubRule
+	}{
+		{ConditionKindAnyConst, fields.any},
+		{ConditionKindAnyIfexistsConst, fields.anyIfexists},
+		{ConditionKindAllConst, fields.all},
+		{ConditionKindAllIfexistsConst, fields.allIfexists},
+	}
+	for _, s := range subRules {
+		if s.subRule != nil {
+			kinds = append(kinds, s.kind)
+			node.kind = s.kind
+			node.subRule = s.subRule
+		}
+	}
+
+	if len(kinds) == 0 {
+		node = nil
+		err = core.SDKErrorf(nil, "the required config does not specify a condition", "empty-required-config", common.GetComponentInfo())
+		return
+	}
+	if len(kinds) > 1 {
+		node = nil
+		err = core.SDKErrorf(nil, fmt.Sprintf("the required config specifies more than one condition: %v", kinds), "ambiguous-required-config", common.GetComponentInfo())
+		return
+	}
+	if node.kind == ConditionKindBaseConst && (node.property == nil || node.operator == nil) {
+		node = nil
+		err = core.SDKErrorf(nil, "a base condition requires both 'property' and 'operator'", "incomplete-required-config", common.GetComponentInfo())
+		return
+	}
+	return
+}
+
+// nonNilItems makes sure that the 'and'/'or' lists of the dedicated list models are never
+// mistaken for an absent list.
+func nonNilItems(items []ConditionItemIntf) []ConditionItemIntf {
+	if items == nil {
+		return []ConditionItemIntf{}
+	}
+	return items
+}
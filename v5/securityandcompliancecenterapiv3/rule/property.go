@@ -0,0 +1,315 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rule
+
+import (
+	"fmt"
+	"net/netip"
+	"reflect"
+	"regexp"
+	"strings"
+
+	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+)
+
+// PropertyCondition is a property of a resource on which a base condition is built.
+type PropertyCondition struct {
+	property string
+}
+
+// Property starts a base condition on a property of the resource, such as "storage_class" or
+// "firewall.allowed_ip".
+func Property(property string) *PropertyCondition {
+	return &PropertyCondition{property: property}
+}
+
+// IsTrue returns a condition that is satisfied when the property is true.
+func (property *PropertyCondition) IsTrue() *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorIsTrueConst, nil)
+}
+
+// IsFalse returns a condition that is satisfied when the property is false.
+func (property *PropertyCondition) IsFalse() *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorIsFalseConst, nil)
+}
+
+// IsEmpty returns a condition that is satisfied when the property is missing or empty.
+func (property *PropertyCondition) IsEmpty() *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorIsEmptyConst, nil)
+}
+
+// IsNotEmpty returns a condition that is satisfied when the property is not empty.
+func (property *PropertyCondition) IsNotEmpty() *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorIsNotEmptyConst, nil)
+}
+
+// StringEquals returns a condition that is satisfied when the property is the value.
+func (property *PropertyCondition) StringEquals(value string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorStringEqualsConst, value)
+}
+
+// StringNotEquals returns a condition that is satisfied when the property is not the value.
+func (property *PropertyCondition) StringNotEquals(value string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorStringNotEqualsConst, value)
+}
+
+// StringContains returns a condition that is satisfied when the property contains the value.
+func (property *PropertyCondition) StringContains(value string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorStringContainsConst, value)
+}
+
+// StringNotContains returns a condition that is satisfied when the property does not contain the value.
+func (property *PropertyCondition) StringNotContains(value string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorStringNotContainsConst, value)
+}
+
+// StringMatch returns a condition that is satisfied when the property matches the regular expression.
+func (property *PropertyCondition) StringMatch(pattern string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorStringMatchConst, pattern)
+}
+
+// StringNotMatch returns a condition that is satisfied when the property does not match the
+// regular expression.
+func (property *PropertyCondition) StringNotMatch(pattern string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorStringNotMatchConst, pattern)
+}
+
+// StringsInList returns a condition that is satisfied when the property is one of the values.
+func (property *PropertyCondition) StringsInList(values ...string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorStringsInListConst, values)
+}
+
+// StringsAllowed returns a condition that is satisfied when all of the strings of the property
+// are among the values.
+func (property *PropertyCondition) StringsAllowed(values ...string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorStringsAllowedConst, values)
+}
+
+// StringsRequired returns a condition that is satisfied when the strings of the property include
+// all of the values.
+func (property *PropertyCondition) StringsRequired(values ...string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorStringsRequiredConst, values)
+}
+
+// NumEquals returns a condition that is satisfied when the property equals the value.
+func (property *PropertyCondition) NumEquals(value float64) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorNumEqualsConst, value)
+}
+
+// NumNotEquals returns a condition that is satisfied when the property does not equal the value.
+func (property *PropertyCondition) NumNotEquals(value float64) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorNumNotEqualsConst, value)
+}
+
+// NumGreaterThan returns a condition that is satisfied when the property is greater than the value.
+func (property *PropertyCondition) NumGreaterThan(value float64) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorNumGreaterThanConst, value)
+}
+
+// NumGreaterThanEquals returns a condition that is satisfied when the property is greater than
+// or equal to the value.
+func (property *PropertyCondition) NumGreaterThanEquals(value float64) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorNumGreaterThanEqualsConst, value)
+}
+
+// NumLessThan returns a condition that is satisfied when the property is less than the value.
+func (property *PropertyCondition) NumLessThan(value float64) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorNumLessThanConst, value)
+}
+
+// NumLessThanEquals returns a condition that is satisfied when the property is less than or
+// equal to the value.
+func (property *PropertyCondition) NumLessThanEquals(value float64) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorNumLessThanEqualsConst, value)
+}
+
+// IPsEquals returns a condition that is satisfied when the IP addresses of the property are the
+// addresses, which can be IP addresses or CIDR ranges.
+func (property *PropertyCondition) IPsEquals(addresses ...string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorIpsEqualsConst, addresses)
+}
+
+// IPsNotEquals returns a condition that is satisfied when the IP addresses of the property are
+// not the addresses.
+func (property *PropertyCondition) IPsNotEquals(addresses ...string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorIpsNotEqualsConst, addresses)
+}
+
+// IPsInRange returns a condition that is satisfied when the IP addresses of the property are
+// within the CIDR ranges.
+func (property *PropertyCondition) IPsInRange(ranges ...string) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorIpsInRangeConst, ranges)
+}
+
+// DaysLessThan returns a condition that is satisfied when the date of the property is less than
+// the number of days ago.
+func (property *PropertyCondition) DaysLessThan(days int) *Condition {
+	return property.Operator(scc.RequiredConfigConditionBaseOperatorDaysLessThanConst, days)
+}
+
+// Operator returns a condition with any operator. The value must suit the operator: no value
+// for is_true, a string for string_equals, a list of strings for strings_in_list, and so on.
+func (property *PropertyCondition) Operator(operator string, value interface{}) *Condition {
+	condition := &Condition{
+		kind:     scc.ConditionKindBaseConst,
+		property: property.property,
+		operator: operator,
+	}
+	if strings.TrimSpace(property.property) == "" {
+		condition.err = fmt.Errorf("the property of the %s condition is empty", operator)
+		return condition
+	}
+	condition.value, condition.err = checkValue(operator, value)
+	return condition
+}
+
+// valueKind is the kind of value expected by an operator.
+type valueKind int
+
+const (
+	valueNone valueKind = iota
+	valueString
+	valuePattern
+	valueStrings
+	valueNumber
+	valueIPs
+	valueDays
+)
+
+var operatorValues = map[string]valueKind{
+	scc.RequiredConfigConditionBaseOperatorIsTrueConst:               valueNone,
+	scc.RequiredConfigConditionBaseOperatorIsFalseConst:              valueNone,
+	scc.RequiredConfigConditionBaseOperatorIsEmptyConst:              valueNone,
+	scc.RequiredConfigConditionBaseOperatorIsNotEmptyConst:           valueNone,
+	scc.RequiredConfigConditionBaseOperatorStringEqualsConst:         valueString,
+	scc.RequiredConfigConditionBaseOperatorStringNotEqualsConst:      valueString,
+	scc.RequiredConfigConditionBaseOperatorStringContainsConst:       valueString,
+	scc.RequiredConfigConditionBaseOperatorStringNotContainsConst:    valueString,
+	scc.RequiredConfigConditionBaseOperatorStringMatchConst:          valuePattern,
+	scc.RequiredConfigConditionBaseOperatorStringNotMatchConst:       valuePattern,
+	scc.RequiredConfigConditionBaseOperatorStringsInListConst:        valueStrings,
+	scc.RequiredConfigConditionBaseOperatorStringsAllowedConst:       valueStrings,
+	scc.RequiredConfigConditionBaseOperatorStringsRequiredConst:      valueStrings,
+	scc.RequiredConfigConditionBaseOperatorNumEqualsConst:            valueNumber,
+	scc.RequiredConfigConditionBaseOperatorNumNotEqualsConst:         valueNumber,
+	scc.RequiredConfigConditionBaseOperatorNumGreaterThanConst:       valueNumber,
+	scc.RequiredConfigConditionBaseOperatorNumGreaterThanEqualsConst: valueNumber,
+	scc.RequiredConfigConditionBaseOperatorNumLessThanConst:          valueNumber,
+	scc.RequiredConfigConditionBaseOperatorNumLessThanEqualsConst:    valueNumber,
+	scc.RequiredConfigConditionBaseOperatorIpsEqualsConst:            valueIPs,
+	scc.RequiredConfigConditionBaseOperatorIpsNotEqualsConst:         valueIPs,
+	scc.RequiredConfigConditionBaseOperatorIpsInRangeConst:           valueIPs,
+	scc.RequiredConfigConditionBaseOperatorDaysLessThanConst:         valueDays,
+}
+
+// checkValue checks that the value suits the operator and returns it as it is sent to the API.
+func checkValue(operator string, value interface{}) (interface{}, error) {
+	kind, ok := operatorValues[operator]
+	if !ok {
+		return nil, fmt.Errorf("unsupported operator %q", operator)
+	}
+
+	switch kind {
+	case valueNone:
+		if value != nil {
+			return nil, fmt.Errorf("the %s operator does not accept a value, got %v", operator, value)
+		}
+		return nil, nil
+
+	case valueString, valuePattern:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("the %s operator requires a string value, got %v", operator, value)
+		}
+		if kind == valuePattern {
+			_, err := regexp.Compile(s)
+			if err != nil {
+				return nil, fmt.Errorf("the %s operator requires a valid regular expression: %s", operator, err.Error())
+			}
+		}
+		return s, nil
+
+	case valueStrings, valueIPs:
+		list, ok := toStrings(value)
+		if !ok {
+			return nil, fmt.Errorf("the %s operator requires a list of strings, got %v", operator, value)
+		}
+		if len(list) == 0 {
+			return nil, fmt.Errorf("the %s operator requires at least one value", operator)
+		}
+		if kind == valueIPs {
+			for _, address := range list {
+				if !isIPAddress(address) {
+					return nil, fmt.Errorf("the %s operator requires IP addresses or CIDR ranges, got %q", operator, address)
+				}
+			}
+		}
+		return list, nil
+
+	default:
+		number, ok := toNumber(value)
+		if !ok {
+			return nil, fmt.Errorf("the %s operator requires a numeric value, got %v", operator, value)
+		}
+		if kind == valueDays && number < 0 {
+			return nil, fmt.Errorf("the %s operator requires a positive number of days, got %v", operator, value)
+		}
+		return value, nil
+	}
+}
+
+// toStrings accepts a list of strings, such as the value of a rule decoded from JSON.
+func toStrings(value interface{}) ([]string, bool) {
+	switch value := value.(type) {
+	case []string:
+		return value, true
+	case []interface{}:
+		list := make([]string, len(value))
+		for i, item := range value {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			list[i] = s
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+// toNumber accepts any integer or floating-point value.
+func toNumber(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// isIPAddress returns true if the address is an IP address or a CIDR range.
+func isIPAddress(address string) bool {
+	if _, err := netip.ParsePrefix(address); err == nil {
+		return true
+	}
+	_, err := netip.ParseAddr(address)
+	return err == nil
+}
@@ -0,0 +1,254 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rule builds the required config trees of Security and Compliance Center rules.
+//
+// A tree is described with the Property, And, Or, Any, AnyIf, All and AllIf functions, and
+// converted to the concrete models of the securityandcompliancecenterapiv3 package by
+// Condition.RequiredConfig or Condition.ConditionItem:
+//
+//	requiredConfig, err := rule.And(
+//		rule.Property("firewall.enabled").IsTrue(),
+//		rule.AnyIf(rule.Target("is", "network-acl"),
+//			rule.Property("rules.source").IPsInRange("10.0.0.0/8"),
+//		),
+//	).Describe("Network access is restricted").RequiredConfig()
+//
+// The typed methods of PropertyCondition choose the operator and the type of its value. Values
+// that the operator cannot use, such as an invalid regular expression or IP address, are reported
+// when the tree is converted, with the path of the invalid condition.
+package rule
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+)
+
+// Condition is a node of a required config tree: a base condition on a property, a list of
+// conditions, or a sub-rule on related resources.
+type Condition struct {
+	kind        string
+	description *string
+
+	// A base condition.
+	property string
+	operator string
+	value    interface{}
+
+	// A list of conditions, or the conditions of a sub-rule.
+	items []*Condition
+
+	// The target of a sub-rule.
+	target *TargetBuilder
+
+	err error
+}
+
+// And returns a condition that is satisfied when all of the conditions are satisfied.
+func And(conditions ...*Condition) *Condition {
+	return &Condition{kind: scc.ConditionKindAndConst, items: conditions}
+}
+
+// Or returns a condition that is satisfied when one of the conditions is satisfied.
+func Or(conditions ...*Condition) *Condition {
+	return &Condition{kind: scc.ConditionKindOrConst, items: conditions}
+}
+
+// Any returns a sub-rule that is satisfied when one of the resources of the target satisfies
+// the conditions.
+func Any(target *TargetBuilder, conditions ...*Condition) *Condition {
+	return &Condition{kind: scc.ConditionKindAnyConst, target: target, items: conditions}
+}
+
+// AnyIf returns a sub-rule that is satisfied when there is no resource of the target, or when one
+// of them satisfies the conditions.
+func AnyIf(target *TargetBuilder, conditions ...*Condition) *Condition {
+	return &Condition{kind: scc.ConditionKindAnyIfexistsConst, target: target, items: conditions}
+}
+
+// All returns a sub-rule that is satisfied when all of the resources of the target satisfy the
+// conditions.
+func All(target *TargetBuilder, conditions ...*Condition) *Condition {
+	return &Condition{kind: scc.ConditionKindAllConst, target: target, items: conditions}
+}
+
+// AllIf returns a sub-rule that is satisfied when there is no resource of the target, or when all
+// of them satisfy the conditions.
+func AllIf(target *TargetBuilder, conditions ...*Condition) *Condition {
+	return &Condition{kind: scc.ConditionKindAllIfexistsConst, target: target, items: conditions}
+}
+
+// Describe sets the description of a base condition or of a list of conditions.
+// Sub-rules have no description.
+func (condition *Condition) Describe(description string) *Condition {
+	condition.description = core.StringPtr(description)
+	return condition
+}
+
+// RequiredConfig converts the condition to the required config of a rule.
+func (condition *Condition) RequiredConfig() (scc.RequiredConfigIntf, error) {
+	requiredConfig, err := condition.requiredConfig("required_config")
+	if err != nil {
+		return nil, core.SDKErrorf(nil, err.Error(), "invalid-required-config", common.GetComponentInfo())
+	}
+	return requiredConfig, nil
+}
+
+// ConditionItem converts the condition to an item of a list of conditions.
+func (condition *Condition) ConditionItem() (scc.ConditionItemIntf, error) {
+	conditionItem, err := condition.conditionItem("condition")
+	if err != nil {
+		return nil, core.SDKErrorf(nil, err.Error(), "invalid-required-config", common.GetComponentInfo())
+	}
+	return conditionItem, nil
+}
+
+func (condition *Condition) requiredConfig(path string) (scc.RequiredConfigIntf, error) {
+	err := condition.check(path)
+	if err != nil {
+		return nil, err
+	}
+	switch condition.kind {
+	case scc.ConditionKindBaseConst:
+		return &scc.RequiredConfigConditionBase{
+			Description: condition.description,
+			Property:    core.StringPtr(condition.property),
+			Operator:    core.StringPtr(condition.operator),
+			Value:       condition.value,
+		}, nil
+	case scc.ConditionKindAndConst:
+		items, err := condition.conditionItems(path + ".and")
+		if err != nil {
+			return nil, err
+		}
+		return &scc.RequiredConfigConditionListConditionListConditionAnd{Description: condition.description, And: items}, nil
+	case scc.ConditionKindOrConst:
+		items, err := condition.conditionItems(path + ".or")
+		if err != nil {
+			return nil, err
+		}
+		return &scc.RequiredConfigConditionListConditionListConditionOr{Description: condition.description, Or: items}, nil
+	}
+
+	subRule, err := condition.subRule(path + "." + condition.kind)
+	if err != nil {
+		return nil, err
+	}
+	switch condition.kind {
+	case scc.ConditionKindAnyConst:
+		return &scc.RequiredConfigConditionSubRuleConditionSubRuleConditionAny{Any: subRule}, nil
+	case scc.ConditionKindAnyIfexistsConst:
+		return &scc.RequiredConfigConditionSubRuleConditionSubRuleConditionAnyIf{AnyIfexists: subRule}, nil
+	case scc.ConditionKindAllConst:
+		return &scc.RequiredConfigConditionSubRuleConditionSubRuleConditionAll{All: subRule}, nil
+	default:
+		return &scc.RequiredConfigConditionSubRuleConditionSubRuleConditionAllIf{AllIfexists: subRule}, nil
+	}
+}
+
+func (condition *Condition) conditionItem(path string) (scc.ConditionItemIntf, error) {
+	err := condition.check(path)
+	if err != nil {
+		return nil, err
+	}
+	switch condition.kind {
+	case scc.ConditionKindBaseConst:
+		return &scc.ConditionItemConditionBase{
+			Description: condition.description,
+			Property:    core.StringPtr(condition.property),
+			Operator:    core.StringPtr(condition.operator),
+			Value:       condition.value,
+		}, nil
+	case scc.ConditionKindAndConst:
+		items, err := condition.conditionItems(path + ".and")
+		if err != nil {
+			return nil, err
+		}
+		return &scc.ConditionItemConditionListConditionListConditionAnd{Description: condition.description, And: items}, nil
+	case scc.ConditionKindOrConst:
+		items, err := condition.conditionItems(path + ".or")
+		if err != nil {
+			return nil, err
+		}
+		return &scc.ConditionItemConditionListConditionListConditionOr{Description: condition.description, Or: items}, nil
+	}
+
+	subRule, err := condition.subRule(path + "." + condition.kind)
+	if err != nil {
+		return nil, err
+	}
+	switch condition.kind {
+	case scc.ConditionKindAnyConst:
+		return &scc.ConditionItemConditionSubRuleConditionSubRuleConditionAny{Any: subRule}, nil
+	case scc.ConditionKindAnyIfexistsConst:
+		return &scc.ConditionItemConditionSubRuleConditionSubRuleConditionAnyIf{AnyIfexists: subRule}, nil
+	case scc.ConditionKindAllConst:
+		return &scc.ConditionItemConditionSubRuleConditionSubRuleConditionAll{All: subRule}, nil
+	default:
+		return &scc.ConditionItemConditionSubRuleConditionSubRuleConditionAllIf{AllIfexists: subRule}, nil
+	}
+}
+
+// check reports the errors of the condition itself, before its children are converted.
+func (condition *Condition) check(path string) error {
+	switch {
+	case condition == nil:
+		return fmt.Errorf("%s: the condition is nil", path)
+	case condition.err != nil:
+		return fmt.Errorf("%s: %s", path, condition.err.Error())
+	case condition.kind == "":
+		return fmt.Errorf("%s: the condition was not built by this package", path)
+	case condition.kind != scc.ConditionKindBaseConst && len(condition.items) == 0:
+		return fmt.Errorf("%s: the %s condition requires at least one condition", path, condition.kind)
+	}
+	isList := condition.kind == scc.ConditionKindAndConst || condition.kind == scc.ConditionKindOrConst
+	if condition.kind != scc.ConditionKindBaseConst && !isList && condition.description != nil {
+		return fmt.Errorf("%s: the %s condition cannot have a description", path, condition.kind)
+	}
+	return nil
+}
+
+func (condition *Condition) conditionItems(path string) ([]scc.ConditionItemIntf, error) {
+	items := make([]scc.ConditionItemIntf, len(condition.items))
+	for i, item := range condition.items {
+		var err error
+		items[i], err = item.conditionItem(fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// subRule converts a sub-rule. Several conditions are combined with And.
+func (condition *Condition) subRule(path string) (*scc.SubRule, error) {
+	target, err := condition.target.ruleTarget(path + ".target")
+	if err != nil {
+		return nil, err
+	}
+	required := condition.items[0]
+	if len(condition.items) > 1 {
+		required = And(condition.items...)
+	}
+	requiredConfig, err := required.requiredConfig(path + ".required_config")
+	if err != nil {
+		return nil, err
+	}
+	return &scc.SubRule{Target: target, RequiredConfig: requiredConfig}, nil
+}
@@ -0,0 +1,209 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rule_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/rule"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Rule builder`, func() {
+	// networkRule requires an enabled firewall and, if the network has ACLs, a private ACL rule.
+	networkRule := func() *rule.Condition {
+		return rule.And(
+			rule.Property("firewall.enabled").IsTrue(),
+			rule.AnyIf(rule.Target("is", "network_acl").Where(rule.Property("direction").StringEquals("inbound")),
+				rule.Property("source").IPsInRange("10.0.0.0/8"),
+				rule.Property("port").NumLessThan(1024),
+			),
+		).Describe("Network access is restricted")
+	}
+
+	It(`Build a required config tree`, func() {
+		requiredConfig, err := networkRule().RequiredConfig()
+		Expect(err).To(BeNil())
+
+		and, ok := requiredConfig.(*securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionAnd)
+		Expect(ok).To(BeTrue())
+		Expect(*and.Description).To(Equal("Network access is restricted"))
+		Expect(and.And).To(HaveLen(2))
+		Expect(and.And[0]).To(BeAssignableToTypeOf(&securityandcompliancecenterapiv3.ConditionItemConditionBase{}))
+		anyIf, ok := and.And[1].(*securityandcompliancecenterapiv3.ConditionItemConditionSubRuleConditionSubRuleConditionAnyIf)
+		Expect(ok).To(BeTrue())
+		Expect(*anyIf.AnyIfexists.Target.ResourceKind).To(Equal("network_acl"))
+		Expect(anyIf.AnyIfexists.RequiredConfig).To(BeAssignableToTypeOf(&securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionAnd{}))
+
+		buffer, err := json.Marshal(requiredConfig)
+		Expect(err).To(BeNil())
+		Expect(buffer).To(MatchJSON(`{
+			"description": "Network access is restricted",
+			"and": [
+				{"property": "firewall.enabled", "operator": "is_true"},
+				{"any_ifexists": {
+					"target": {
+						"service_name": "is",
+						"resource_kind": "network_acl",
+						"additional_target_attributes": [{"name": "direction", "operator": "string_equals", "value": "inbound"}]
+					},
+					"required_config": {
+						"and": [
+							{"property": "source", "operator": "ips_in_range", "value": ["10.0.0.0/8"]},
+							{"property": "port", "operator": "num_less_than", "value": 1024}
+						]
+					}
+				}}
+			]
+		}`))
+	})
+	It(`Evaluate a required config tree`, func() {
+		requiredConfig, err := networkRule().RequiredConfig()
+		Expect(err).To(BeNil())
+
+		evaluator := securityandcompliancecenterapiv3.NewRuleEvaluator()
+		resource, err := securityandcompliancecenterapiv3.ParseResourceConfig([]byte(`{
+			"firewall": {"enabled": true},
+			"network_acl": [
+				{"direction": "inbound", "source": ["10.1.0.0/16"], "port": 443},
+				{"direction": "outbound", "source": ["0.0.0.0/0"], "port": 8080}
+			]
+		}`))
+		Expect(err).To(BeNil())
+		result, err := evaluator.EvaluateRequiredConfig(requiredConfig, resource)
+		Expect(err).To(BeNil())
+		Expect(result.Passed()).To(BeTrue())
+
+		resource["network_acl"].([]interface{})[0].(map[string]interface{})["port"] = 8443.0
+		result, err = evaluator.EvaluateRequiredConfig(requiredConfig, resource)
+		Expect(err).To(BeNil())
+		Expect(result.Passed()).To(BeFalse())
+	})
+	It(`Build condition items and targets`, func() {
+		item, err := rule.Or(
+			rule.Property("storage_class").StringsInList("smart", "cold"),
+			rule.Property("storage_class").IsEmpty(),
+		).ConditionItem()
+		Expect(err).To(BeNil())
+		or, ok := item.(*securityandcompliancecenterapiv3.ConditionItemConditionListConditionListConditionOr)
+		Expect(ok).To(BeTrue())
+		Expect(or.Or).To(HaveLen(2))
+
+		item, err = rule.All(rule.Target("cloud-object-storage", "bucket"), rule.Property("versioning").IsTrue()).ConditionItem()
+		Expect(err).To(BeNil())
+		all, ok := item.(*securityandcompliancecenterapiv3.ConditionItemConditionSubRuleConditionSubRuleConditionAll)
+		Expect(ok).To(BeTrue())
+		Expect(all.All.RequiredConfig).To(BeAssignableToTypeOf(&securityandcompliancecenterapiv3.RequiredConfigConditionBase{}))
+
+		target, err := rule.Target("cloud-object-storage", "bucket").
+			Where(rule.Property("location").StringEquals("us-south")).
+			RuleTargetPrototype()
+		Expect(err).To(BeNil())
+		Expect(*target.ServiceName).To(Equal("cloud-object-storage"))
+		Expect(*target.ResourceKind).To(Equal("bucket"))
+		Expect(target.AdditionalTargetAttributes).To(HaveLen(1))
+		Expect(*target.AdditionalTargetAttributes[0].Operator).To(Equal("string_equals"))
+		Expect(target.AdditionalTargetAttributes[0].Value).To(Equal("us-south"))
+	})
+
+	table.DescribeTable(`Build a base condition`,
+		func(condition *rule.Condition, operator string, value interface{}) {
+			requiredConfig, err := condition.RequiredConfig()
+			Expect(err).To(BeNil())
+			base, ok := requiredConfig.(*securityandcompliancecenterapiv3.RequiredConfigConditionBase)
+			Expect(ok).To(BeTrue())
+			Expect(*base.Property).To(Equal("x"))
+			Expect(*base.Operator).To(Equal(operator))
+			if value == nil {
+				Expect(base.Value).To(BeNil())
+			} else {
+				Expect(base.Value).To(Equal(value))
+			}
+		},
+		table.Entry(`IsTrue`, rule.Property("x").IsTrue(), "is_true", nil),
+		table.Entry(`IsFalse`, rule.Property("x").IsFalse(), "is_false", nil),
+		table.Entry(`IsNotEmpty`, rule.Property("x").IsNotEmpty(), "is_not_empty", nil),
+		table.Entry(`StringEquals`, rule.Property("x").StringEquals("y"), "string_equals", "y"),
+		table.Entry(`StringNotEquals`, rule.Property("x").StringNotEquals("y"), "string_not_equals", "y"),
+		table.Entry(`StringContains`, rule.Property("x").StringContains("y"), "string_contains", "y"),
+		table.Entry(`StringNotContains`, rule.Property("x").StringNotContains("y"), "string_not_contains", "y"),
+		table.Entry(`StringMatch`, rule.Property("x").StringMatch("^y+$"), "string_match", "^y+$"),
+		table.Entry(`StringNotMatch`, rule.Property("x").StringNotMatch("^y+$"), "string_not_match", "^y+$"),
+		table.Entry(`StringsAllowed`, rule.Property("x").StringsAllowed("a", "b"), "strings_allowed", []string{"a", "b"}),
+		table.Entry(`StringsRequired`, rule.Property("x").StringsRequired("a"), "strings_required", []string{"a"}),
+		table.Entry(`NumEquals`, rule.Property("x").NumEquals(1), "num_equals", 1.0),
+		table.Entry(`NumNotEquals`, rule.Property("x").NumNotEquals(1), "num_not_equals", 1.0),
+		table.Entry(`NumGreaterThan`, rule.Property("x").NumGreaterThan(1.5), "num_greater_than", 1.5),
+		table.Entry(`NumGreaterThanEquals`, rule.Property("x").NumGreaterThanEquals(1), "num_greater_than_equals", 1.0),
+		table.Entry(`NumLessThanEquals`, rule.Property("x").NumLessThanEquals(1), "num_less_than_equals", 1.0),
+		table.Entry(`IPsEquals`, rule.Property("x").IPsEquals("10.0.0.1", "2001:db8::/32"), "ips_equals", []string{"10.0.0.1", "2001:db8::/32"}),
+		table.Entry(`IPsNotEquals`, rule.Property("x").IPsNotEquals("10.0.0.1"), "ips_not_equals", []string{"10.0.0.1"}),
+		table.Entry(`DaysLessThan`, rule.Property("x").DaysLessThan(90), "days_less_than", 90),
+		table.Entry(`Operator with a decoded list`, rule.Property("x").Operator("strings_in_list", []interface{}{"a"}), "strings_in_list", []string{"a"}),
+	)
+
+	table.DescribeTable(`Reject an invalid condition`,
+		func(condition *rule.Condition, message string) {
+			requiredConfig, err := condition.RequiredConfig()
+			Expect(requiredConfig).To(BeNil())
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal(message))
+		},
+		table.Entry(`Unexpected value`, rule.Property("x").Operator("is_true", "yes"),
+			"required_config: the is_true operator does not accept a value, got yes"),
+		table.Entry(`String number`, rule.Property("x").Operator("num_equals", "5"),
+			"required_config: the num_equals operator requires a numeric value, got 5"),
+		table.Entry(`Number string`, rule.Property("x").Operator("string_equals", 5),
+			"required_config: the string_equals operator requires a string value, got 5"),
+		table.Entry(`Unknown operator`, rule.Property("x").Operator("string_like", "y"),
+			`required_config: unsupported operator "string_like"`),
+		table.Entry(`Empty property`, rule.Property(" ").IsTrue(),
+			"required_config: the property of the is_true condition is empty"),
+		table.Entry(`Invalid regular expression`, rule.And(rule.Property("x").StringMatch("(")),
+			"required_config.and[0]: the string_match operator requires a valid regular expression: error parsing regexp: missing closing ): `(`"),
+		table.Entry(`Empty list`, rule.Or(rule.Property("x").IsTrue(), rule.Property("x").StringsInList()),
+			"required_config.or[1]: the strings_in_list operator requires at least one value"),
+		table.Entry(`Invalid IP address`, rule.Property("x").IPsInRange("10.0.0.0/8", "10.0.0.256"),
+			`required_config: the ips_in_range operator requires IP addresses or CIDR ranges, got "10.0.0.256"`),
+		table.Entry(`Negative days`, rule.Property("x").DaysLessThan(-1),
+			"required_config: the days_less_than operator requires a positive number of days, got -1"),
+		table.Entry(`Empty list of conditions`, rule.And(),
+			"required_config: the and condition requires at least one condition"),
+		table.Entry(`Nil condition`, rule.And(rule.Property("x").IsTrue(), nil),
+			"required_config.and[1]: the condition is nil"),
+		table.Entry(`Zero condition`, rule.Or(&rule.Condition{}),
+			"required_config.or[0]: the condition was not built by this package"),
+		table.Entry(`Sub-rule description`, rule.Any(rule.Target("is", "vpc"), rule.Property("x").IsTrue()).Describe("VPC"),
+			"required_config: the any condition cannot have a description"),
+		table.Entry(`Nil target`, rule.And(rule.AllIf(nil, rule.Property("x").IsTrue())),
+			"required_config.and[0].all_ifexists.target: the target is nil"),
+		table.Entry(`Non-base target attribute`, rule.All(rule.Target("is", "vpc").Where(rule.Or(rule.Property("x").IsTrue())), rule.Property("y").IsTrue()),
+			"required_config.all.target.additional_target_attributes[0]: a target attribute must be a base condition without a description"),
+		table.Entry(`Invalid sub-rule condition`, rule.AnyIf(rule.Target("is", "vpc"), rule.Property("x").StringsRequired()),
+			"required_config.any_ifexists.required_config: the strings_required operator requires at least one value"),
+	)
+
+	It(`Invoke RuleTargetPrototype with error: Incomplete target`, func() {
+		target, err := rule.Target("is", "").RuleTargetPrototype()
+		Expect(target).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("target: the target requires a service name and a resource kind"))
+	})
+})
@@ -0,0 +1,98 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rule
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+)
+
+// TargetBuilder is the target of a rule or of a sub-rule: a kind of resource of a service,
+// optionally narrowed by conditions on the attributes of the resources.
+type TargetBuilder struct {
+	serviceName  string
+	resourceKind string
+	attributes   []*Condition
+}
+
+// Target starts the target of the resources of a kind, such as Target("cloud-object-storage", "bucket").
+func Target(serviceName string, resourceKind string) *TargetBuilder {
+	return &TargetBuilder{serviceName: serviceName, resourceKind: resourceKind}
+}
+
+// Where narrows the target to the resources whose attributes satisfy the base conditions, such
+// as Property("location").StringEquals("us-south").
+func (target *TargetBuilder) Where(attributes ...*Condition) *TargetBuilder {
+	target.attributes = append(target.attributes, attributes...)
+	return target
+}
+
+// RuleTarget converts the target to the target of a sub-rule.
+func (target *TargetBuilder) RuleTarget() (*scc.RuleTarget, error) {
+	ruleTarget, err := target.ruleTarget("target")
+	if err != nil {
+		return nil, core.SDKErrorf(nil, err.Error(), "invalid-rule-target", common.GetComponentInfo())
+	}
+	return ruleTarget, nil
+}
+
+// RuleTargetPrototype converts the target to the target of a rule, as passed to CreateRule or
+// ReplaceRule.
+func (target *TargetBuilder) RuleTargetPrototype() (*scc.RuleTargetPrototype, error) {
+	ruleTarget, err := target.ruleTarget("target")
+	if err != nil {
+		return nil, core.SDKErrorf(nil, err.Error(), "invalid-rule-target", common.GetComponentInfo())
+	}
+	return &scc.RuleTargetPrototype{
+		ServiceName:                ruleTarget.ServiceName,
+		ResourceKind:               ruleTarget.ResourceKind,
+		AdditionalTargetAttributes: ruleTarget.AdditionalTargetAttributes,
+	}, nil
+}
+
+func (target *TargetBuilder) ruleTarget(path string) (*scc.RuleTarget, error) {
+	switch {
+	case target == nil:
+		return nil, fmt.Errorf("%s: the target is nil", path)
+	case target.serviceName == "" || target.resourceKind == "":
+		return nil, fmt.Errorf("%s: the target requires a service name and a resource kind", path)
+	}
+
+	ruleTarget := &scc.RuleTarget{
+		ServiceName:  core.StringPtr(target.serviceName),
+		ResourceKind: core.StringPtr(target.resourceKind),
+	}
+	for i, attribute := range target.attributes {
+		attributePath := fmt.Sprintf("%s.additional_target_attributes[%d]", path, i)
+		err := attribute.check(attributePath)
+		if err != nil {
+			return nil, err
+		}
+		if attribute.kind != scc.ConditionKindBaseConst || attribute.description != nil {
+			return nil, fmt.Errorf("%s: a target attribute must be a base condition without a description", attributePath)
+		}
+		ruleTarget.AdditionalTargetAttributes = append(ruleTarget.AdditionalTargetAttributes, scc.AdditionalTargetAttribute{
+			Name:     core.StringPtr(attribute.property),
+			Operator: core.StringPtr(attribute.operator),
+			Value:    attribute.value,
+		})
+	}
+	return ruleTarget, nil
+}
@@ -0,0 +1,768 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// RuleEvaluator evaluates the required configuration of a rule against a resource configuration
+// document locally, without a Security and Compliance Center instance.
+type RuleEvaluator struct {
+	// Now returns the reference time used by the 'days_less_than' operator. Defaults to time.Now.
+	Now func() time.Time
+
+	// RelatedResources returns the resources that the target of a subrule ('any', 'all',
+	// 'any_ifexists', 'all_ifexists') refers to. By default, the target's 'ref' (or, when no 'ref'
+	// is set, its 'resource_kind') is looked up as a property of the resource being evaluated, and
+	// the object or list of objects found there is used.
+	RelatedResources func(target *RuleTarget, resource map[string]interface{}) ([]map[string]interface{}, error)
+}
+
+// RuleEvaluationResult : The outcome of evaluating a rule against a resource configuration.
+type RuleEvaluationResult struct {
+	// The ID of the evaluated rule, if known.
+	RuleID *string `json:"rule_id,omitempty"`
+
+	// The evaluation status: 'pass', 'failure', or 'skipped' when the resource does not match the
+	// additional target attributes of the rule.
+	Status string `json:"status"`
+
+	// The trace of the target attribute checks.
+	TargetTrace []ConditionTrace `json:"target_trace,omitempty"`
+
+	// The trace of the required config evaluation.
+	Trace *ConditionTrace `json:"trace,omitempty"`
+}
+
+// Passed returns true if the resource satisfied the rule.
+func (result *RuleEvaluationResult) Passed() bool {
+	return result.Status == EvaluationStatusPassConst
+}
+
+// ConditionTrace : The outcome of evaluating a single condition of a required config tree.
+type ConditionTrace struct {
+	// The location of the condition within the rule, such as "required_config.and[1]".
+	Path string `json:"path"`
+
+	// The condition kind (one of the ConditionKind*Const values).
+	Kind string `json:"kind"`
+
+	// The description of the condition.
+	Description string `json:"description,omitempty"`
+
+	// The evaluated property, for base conditions.
+	Property string `json:"property,omitempty"`
+
+	// The operator, for base conditions.
+	Operator string `json:"operator,omitempty"`
+
+	// The value that the rule expects, for base conditions.
+	ExpectedValue interface{} `json:"expected_value,omitempty"`
+
+	// The value that was found in the resource, for base conditions.
+	FoundValue interface{} `json:"found_value,omitempty"`
+
+	// Indicates whether the condition was satisfied.
+	Passed bool `json:"passed"`
+
+	// An explanation of the outcome.
+	Reason string `json:"reason,omitempty"`
+
+	// The traces of the nested conditions.
+	Children []ConditionTrace `json:"children,omitempty"`
+}
+
+// NewRuleEvaluator returns a new RuleEvaluator instance that uses the default settings.
+func NewRuleEvaluator() *RuleEvaluator {
+	return &RuleEvaluator{}
+}
+
+// ParseResourceConfig decodes a JSON resource configuration document so it can be passed to a RuleEvaluator.
+func ParseResourceConfig(data []byte) (resource map[string]interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err = decoder.Decode(&resource)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "resource-config-decode-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// EvaluateRule evaluates the target attributes and required config of "rule" against "resource".
+func (evaluator *RuleEvaluator) EvaluateRule(rule *Rule, resource map[string]interface{}) (result *RuleEvaluationResult, err error) {
+	err = core.ValidateNotNil(rule, "rule cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	result = &RuleEvaluationResult{
+		RuleID: rule.ID,
+	}
+
+	if rule.Target != nil {
+		var matched bool
+		matched, result.TargetTrace, err = evaluator.matchTarget(rule.Target, resource, "target")
+		if err != nil {
+			result = nil
+			return
+		}
+		if !matched {
+			result.Status = EvaluationStatusSkippedConst
+			return
+		}
+	}
+
+	trace, err := evaluator.evaluateRequiredConfig(rule.RequiredConfig, resource, "required_config")
+	if err != nil {
+		result = nil
+		return
+	}
+	result.Trace = trace
+	result.Status = statusOf(trace.Passed)
+	return
+}
+
+// EvaluateRequiredConfig evaluates a required config tree against "resource".
+func (evaluator *RuleEvaluator) EvaluateRequiredConfig(requiredConfig RequiredConfigIntf, resource map[string]interface{}) (result *RuleEvaluationResult, err error) {
+	trace, err := evaluator.evaluateRequiredConfig(requiredConfig, resource, "required_config")
+	if err != nil {
+		return
+	}
+	result = &RuleEvaluationResult{
+		Status: statusOf(trace.Passed),
+		Trace:  trace,
+	}
+	return
+}
+
+func (evaluator *RuleEvaluator) evaluateRequiredConfig(requiredConfig RequiredConfigIntf, resource map[string]interface{}, path string) (trace *ConditionTrace, err error) {
+	if core.IsNil(requiredConfig) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%s: required config cannot be nil", path), "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	node, err := newRequiredConfigNode(requiredConfig)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("%s: %s", path, err.Error()), "invalid-required-config", common.GetComponentInfo())
+		return
+	}
+	return evaluator.evaluateNode(node, resource, path)
+}
+
+func (evaluator *RuleEvaluator) evaluateConditionItem(conditionItem ConditionItemIntf, resource map[string]interface{}, path string) (trace *ConditionTrace, err error) {
+	if core.IsNil(conditionItem) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%s: condition cannot be nil", path), "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	node, err := newConditionItemNode(conditionItem)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("%s: %s", path, err.Error()), "invalid-required-config", common.GetComponentInfo())
+		return
+	}
+	return evaluator.evaluateNode(node, resource, path)
+}
+
+func (evaluator *RuleEvaluator) evaluateNode(node *requiredConfigNode, resource map[string]interface{}, path string) (trace *ConditionTrace, err error) {
+	trace = &ConditionTrace{
+		Path: path,
+		Kind: node.kind,
+	}
+	if node.description != nil {
+		trace.Description = *node.description
+	}
+
+	switch node.kind {
+	case ConditionKindBaseConst:
+		err = evaluator.evaluateBase(trace, *node.property, *node.operator, node.value, resource)
+	case ConditionKindAndConst, ConditionKindOrConst:
+		err = evaluator.evaluateList(trace, node, resource)
+	default:
+		err = evaluator.evaluateSubRule(trace, node, resource)
+	}
+	if err != nil {
+		trace = nil
+	}
+	return
+}
+
+func (evaluator *RuleEvaluator) evaluateBase(trace *ConditionTrace, property string, operator string, expected interface{}, resource map[string]interface{}) (err error) {
+	found, exists := lookupProperty(resource, property)
+	trace.Property = property
+	trace.Operator = operator
+	trace.ExpectedValue = expected
+	trace.FoundValue = found
+
+	trace.Passed, trace.Reason, err = evaluateOperator(operator, found, exists, expected, evaluator.now())
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("%s: %s", trace.Path, err.Error()), "invalid-condition", common.GetComponentInfo())
+	}
+	return
+}
+
+func (evaluator *RuleEvaluator) evaluateList(trace *ConditionTrace, node *requiredConfigNode, resource map[string]interface{}) (err error) {
+	passed := 0
+	for i, item := range node.items {
+		var child *ConditionTrace
+		child, err = evaluator.evaluateConditionItem(item, resource, fmt.Sprintf("%s.%s[%d]", trace.Path, node.kind, i))
+		if err != nil {
+			return
+		}
+		if child.Passed {
+			passed++
+		}
+		trace.Children = append(trace.Children, *child)
+	}
+
+	if node.kind == ConditionKindAndConst {
+		trace.Passed = passed == len(node.items)
+		trace.Reason = fmt.Sprintf("%d of %d conditions passed, all required", passed, len(node.items))
+	} else {
+		trace.Passed = passed > 0
+		trace.Reason = fmt.Sprintf("%d of %d conditions passed, at least one required", passed, len(node.items))
+	}
+	return
+}
+
+func (evaluator *RuleEvaluator) evaluateSubRule(trace *ConditionTrace, node *requiredConfigNode, resource map[string]interface{}) (err error) {
+	related, err := evaluator.relatedResources(node.subRule.Target, resource)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("%s: %s", trace.Path, err.Error()), "related-resources-error", common.GetComponentInfo())
+		return
+	}
+
+	var candidates []map[string]interface{}
+	for i, relatedResource := range related {
+		matched := true
+		if node.subRule.Target != nil {
+			matched, _, err = evaluator.matchTarget(node.subRule.Target, relatedResource, fmt.Sprintf("%s.%s[%d].target", trace.Path, node.kind, i))
+			if err != nil {
+				return
+			}
+		}
+		if matched {
+			candidates = append(candidates, relatedResource)
+		}
+	}
+
+	passed := 0
+	for i, candidate := range candidates {
+		var child *ConditionTrace
+		child, err = evaluator.evaluateRequiredConfig(node.subRule.RequiredConfig, candidate, fmt.Sprintf("%s.%s[%d].required_config", trace.Path, node.kind, i))
+		if err != nil {
+			return
+		}
+		if child.Passed {
+			passed++
+		}
+		trace.Children = append(trace.Children, *child)
+	}
+
+	total := len(candidates)
+	switch node.kind {
+	case ConditionKindAllConst:
+		trace.Passed = total > 0 && passed == total
+	case ConditionKindAllIfexistsConst:
+		trace.Passed = passed == total
+	case ConditionKindAnyConst:
+		trace.Passed = passed > 0
+	case ConditionKindAnyIfexistsConst:
+		trace.Passed = total == 0 || passed > 0
+	}
+	if total == 0 {
+		trace.Reason = "no related resources found"
+	} else {
+		trace.Reason = fmt.Sprintf("%d of %d related resources passed", passed, total)
+	}
+	return
+}
+
+// matchTarget checks the additional target attributes of "target" against "resource".
+func (evaluator *RuleEvaluator) matchTarget(target *RuleTarget, resource map[string]interface{}, path string) (matched bool, traces []ConditionTrace, err error) {
+	matched = true
+	for i, attribute := range target.AdditionalTargetAttributes {
+		if attribute.Name == nil || attribute.Operator == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("%s.additional_target_attributes[%d]: 'name' and 'operator' are required", path, i), "invalid-target-attribute", common.GetComponentInfo())
+			return
+		}
+		trace := &ConditionTrace{
+			Path: fmt.Sprintf("%s.additional_target_attributes[%d]", path, i),
+			Kind: ConditionKindBaseConst,
+		}
+		err = evaluator.evaluateBase(trace, *attribute.Name, *attribute.Operator, attribute.Value, resource)
+		if err != nil {
+			return
+		}
+		matched = matched && trace.Passed
+		traces = append(traces, *trace)
+	}
+	return
+}
+
+func (evaluator *RuleEvaluator) relatedResources(target *RuleTarget, resource map[string]interface{}) (related []map[string]interface{}, err error) {
+	if evaluator.RelatedResources != nil {
+		return evaluator.RelatedResources(target, resource)
+	}
+	if target == nil {
+		err = fmt.Errorf("the subrule does not specify a target")
+		return
+	}
+
+	var property string
+	if target.Ref != nil && *target.Ref != "" {
+		property = *target.Ref
+	} else if target.ResourceKind != nil {
+		property = *target.ResourceKind
+	}
+	value, _ := lookupProperty(resource, property)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		related = append(related, v)
+	case []interface{}:
+		for _, item := range v {
+			if m, ok := item.(map[string]interface{}); ok {
+				related = append(related, m)
+			}
+		}
+	}
+	return
+}
+
+func (evaluator *RuleEvaluator) now() time.Time {
+	if evaluator.Now != nil {
+		return evaluator.Now()
+	}
+	return time.Now()
+}
+
+func statusOf(passed bool) string {
+	if passed {
+		return EvaluationStatusPassConst
+	}
+	return EvaluationStatusFailureConst
+}
+
+// lookupProperty resolves a dot-separated property path, such as "metadata.labels.0", within a resource.
+// A key that literally contains dots takes precedence over the nested lookup.
+func lookupProperty(resource interface{}, property string) (value interface{}, found bool) {
+	if property == "" {
+		return
+	}
+	if m, ok := resource.(map[string]interface{}); ok {
+		if value, found = m[property]; found {
+			return
+		}
+	}
+
+	head, rest, nested := strings.Cut(property, ".")
+	var child interface{}
+	switch r := resource.(type) {
+	case map[string]interface{}:
+		child, found = r[head]
+	case []interface{}:
+		index, convErr := strconv.Atoi(head)
+		if convErr == nil && index >= 0 && index < len(r) {
+			child, found = r[index], true
+		}
+	}
+	if !found || !nested {
+		return child, found
+	}
+	return lookupProperty(child, rest)
+}
+
+// evaluateOperator applies "operator" to the value found in the resource. A returned error indicates a
+// problem with the rule itself (an unknown operator or an unusable expected value), while a resource that
+// does not satisfy the condition is reported through "passed" and "reason".
+func evaluateOperator(operator string, found interface{}, exists bool, expected interface{}, now time.Time) (passed bool, reason string, err error) {
+	switch operator {
+	case RequiredConfigOperatorIsEmptyConst:
+		passed = isEmptyValue(found)
+		return passed, describe(passed, "the property is empty", "the property is not empty"), nil
+	case RequiredConfigOperatorIsNotEmptyConst:
+		passed = exists && !isEmptyValue(found)
+		return passed, describe(passed, "the property is not empty", "the property is empty"), nil
+	}
+
+	if !exists {
+		return false, "the property was not found", nil
+	}
+
+	switch operator {
+	case RequiredConfigOperatorIsTrueConst, RequiredConfigOperatorIsFalseConst:
+		b, ok := toBool(found)
+		if !ok {
+			return false, "the property is not a boolean", nil
+		}
+		passed = b == (operator == RequiredConfigOperatorIsTrueConst)
+		return passed, fmt.Sprintf("the property is %t", b), nil
+
+	case RequiredConfigOperatorNumEqualsConst, RequiredConfigOperatorNumNotEqualsConst,
+		RequiredConfigOperatorNumGreaterThanConst, RequiredConfigOperatorNumGreaterThanEqualsConst,
+		RequiredConfigOperatorNumLessThanConst, RequiredConfigOperatorNumLessThanEqualsConst:
+		want, ok := toFloat(expected)
+		if !ok {
+			return false, "", fmt.Errorf("operator '%s' requires a numeric value, got %v", operator, expected)
+		}
+		got, ok := toFloat(found)
+		if !ok {
+			return false, "the property is not a number", nil
+		}
+		passed = compareNumbers(operator, got, want)
+		return passed, describe(passed, "the number satisfies the comparison", "the number does not satisfy the comparison"), nil
+
+	case RequiredConfigOperatorStringEqualsConst, RequiredConfigOperatorStringNotEqualsConst,
+		RequiredConfigOperatorStringContainsConst, RequiredConfigOperatorStringNotContainsConst:
+		want, ok := toScalarString(expected)
+		if !ok {
+			return false, "", fmt.Errorf("operator '%s' requires a string value, got %v", operator, expected)
+		}
+		got, ok := toScalarString(found)
+		if !ok {
+			return false, "the property is not a string", nil
+		}
+		switch operator {
+		case RequiredConfigOperatorStringEqualsConst:
+			passed = got == want
+		case RequiredConfigOperatorStringNotEqualsConst:
+			passed = got != want
+		case RequiredConfigOperatorStringContainsConst:
+			passed = strings.Contains(got, want)
+		case RequiredConfigOperatorStringNotContainsConst:
+			passed = !strings.Contains(got, want)
+		}
+		return passed, describe(passed, "the string satisfies the comparison", "the string does not satisfy the comparison"), nil
+
+	case RequiredConfigOperatorStringMatchConst, RequiredConfigOperatorStringNotMatchConst:
+		pattern, ok := toScalarString(expected)
+		if !ok {
+			return false, "", fmt.Errorf("operator '%s' requires a regular expression value, got %v", operator, expected)
+		}
+		re, reErr := regexp.Compile(pattern)
+		if reErr != nil {
+			return false, "", fmt.Errorf("operator '%s' has an invalid regular expression: %s", operator, reErr.Error())
+		}
+		got, ok := toScalarString(found)
+		if !ok {
+			return false, "the property is not a string", nil
+		}
+		passed = re.MatchString(got) == (operator == RequiredConfigOperatorStringMatchConst)
+		return passed, describe(passed, "the string satisfies the pattern", "the string does not satisfy the pattern"), nil
+
+	case RequiredConfigOperatorStringsInListConst:
+		list, ok := toStringList(expected)
+		if !ok {
+			return false, "", fmt.Errorf("operator '%s' requires a list of strings, got %v", operator, expected)
+		}
+		got, ok := toScalarString(found)
+		if !ok {
+			return false, "the property is not a string", nil
+		}
+		passed = containsString(list, got)
+		return passed, describe(passed, "the string is in the list", fmt.Sprintf("'%s' is not in the list", got)), nil
+
+	case RequiredConfigOperatorStringsAllowedConst, RequiredConfigOperatorStringsRequiredConst:
+		list, ok := toStringList(expected)
+		if !ok {
+			return false, "", fmt.Errorf("operator '%s' requires a list of strings, got %v", operator, expected)
+		}
+		got, ok := toStringList(found)
+		if !ok {
+			return false, "the property is not a list of strings", nil
+		}
+		var offending []string
+		if operator == RequiredConfigOperatorStringsAllowedConst {
+			offending = missingStrings(got, list)
+			passed = len(offending) == 0
+			return passed, describe(passed, "all strings are allowed", fmt.Sprintf("strings not allowed: %v", offending)), nil
+		}
+		offending = missingStrings(list, got)
+		passed = len(offending) == 0
+		return passed, describe(passed, "all required strings are present", fmt.Sprintf("missing required strings: %v", offending)), nil
+
+	case RequiredConfigOperatorIpsEqualsConst, RequiredConfigOperatorIpsNotEqualsConst, RequiredConfigOperatorIpsInRangeConst:
+		want, parseErr := toPrefixes(expected)
+		if parseErr != nil {
+			return false, "", fmt.Errorf("operator '%s' requires a list of IP addresses or CIDR ranges: %s", operator, parseErr.Error())
+		}
+		got, parseErr := toPrefixes(found)
+		if parseErr != nil {
+			return false, "the property is not a list of IP addresses: " + parseErr.Error(), nil
+		}
+		switch operator {
+		case RequiredConfigOperatorIpsEqualsConst:
+			passed = samePrefixes(got, want)
+			return passed, describe(passed, "the IP addresses are equal", "the IP addresses are not equal"), nil
+		case RequiredConfigOperatorIpsNotEqualsConst:
+			passed = !samePrefixes(got, want)
+			return passed, describe(passed, "the IP addresses are not equal", "the IP addresses are equal"), nil
+		}
+		var outside []string
+		for _, p := range got {
+			if !prefixWithin(p, want) {
+				outside = append(outside, p.String())
+			}
+		}
+		passed = len(outside) == 0
+		return passed, describe(passed, "all IP addresses are in range", fmt.Sprintf("IP addresses out of range: %v", outside)), nil
+
+	case RequiredConfigOperatorDaysLessThanConst:
+		days, ok := toFloat(expected)
+		if !ok {
+			return false, "", fmt.Errorf("operator '%s' requires a numeric value, got %v", operator, expected)
+		}
+		t, ok := toTime(found)
+		if !ok {
+			return false, "the property is not a timestamp", nil
+		}
+		elapsed := now.Sub(t).Hours() / 24
+		passed = elapsed < days
+		return passed, fmt.Sprintf("%.1f days have elapsed", elapsed), nil
+	}
+
+	return false, "", fmt.Errorf("unsupported operator '%s'", operator)
+}
+
+func describe(passed bool, passReason string, failReason string) string {
+	if passed {
+		return passReason
+	}
+	return failReason
+}
+
+func compareNumbers(operator string, got float64, want float64) bool {
+	switch operator {
+	case RequiredConfigOperatorNumEqualsConst:
+		return got == want
+	case RequiredConfigOperatorNumNotEqualsConst:
+		return got != want
+	case RequiredConfigOperatorNumGreaterThanConst:
+		return got > want
+	case RequiredConfigOperatorNumGreaterThanEqualsConst:
+		return got >= want
+	case RequiredConfigOperatorNumLessThanConst:
+		return got < want
+	case RequiredConfigOperatorNumLessThanEqualsConst:
+		return got <= want
+	}
+	return false
+}
+
+func isEmptyValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+func toBool(value interface{}) (b bool, ok bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case *bool:
+		if v != nil {
+			return *v, true
+		}
+	case string:
+		parsed, err := strconv.ParseBool(v)
+		return parsed, err == nil
+	}
+	return
+}
+
+func toFloat(value interface{}) (f float64, ok bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case *int64:
+		if v != nil {
+			return float64(*v), true
+		}
+	case *float64:
+		if v != nil {
+			return *v, true
+		}
+	case json.Number:
+		parsed, err := v.Float64()
+		return parsed, err == nil
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return parsed, err == nil
+	}
+	return
+}
+
+func toScalarString(value interface{}) (s string, ok bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case *string:
+		if v != nil {
+			return *v, true
+		}
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int, int32, int64:
+		return fmt.Sprint(v), true
+	}
+	return
+}
+
+// toStringList accepts a list of scalars or a single scalar, which is treated as a list of one.
+func toStringList(value interface{}) (list []string, ok bool) {
+	switch v := value.(type) {
+	case []string:
+		return v, true
+	case []interface{}:
+		list = make([]string, 0, len(v))
+		for _, item := range v {
+			s, isScalar := toScalarString(item)
+			if !isScalar {
+				return nil, false
+			}
+			list = append(list, s)
+		}
+		return list, true
+	}
+	if s, isScalar := toScalarString(value); isScalar {
+		return []string{s}, true
+	}
+	return
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// missingStrings returns the members of "values" that are not in "list".
+func missingStrings(values []string, list []string) (missing []string) {
+	for _, value := range values {
+		if !containsString(list, value) {
+			missing = append(missing, value)
+		}
+	}
+	return
+}
+
+// toPrefixes parses an IP address, a CIDR range, or a list of either. Single addresses are
+// represented as a full-length prefix.
+func toPrefixes(value interface{}) (prefixes []netip.Prefix, err error) {
+	list, ok := toStringList(value)
+	if !ok {
+		return nil, fmt.Errorf("unsupported value %v", value)
+	}
+	for _, item := range list {
+		item = strings.TrimSpace(item)
+		if strings.Contains(item, "/") {
+			var prefix netip.Prefix
+			prefix, err = netip.ParsePrefix(item)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		var addr netip.Addr
+		addr, err = netip.ParseAddr(item)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return
+}
+
+func samePrefixes(a []netip.Prefix, b []netip.Prefix) bool {
+	contains := func(list []netip.Prefix, p netip.Prefix) bool {
+		for _, item := range list {
+			if item == p {
+				return true
+			}
+		}
+		return false
+	}
+	for _, p := range a {
+		if !contains(b, p) {
+			return false
+		}
+	}
+	for _, p := range b {
+		if !contains(a, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// prefixWithin returns true if "p" is entirely contained by one of "ranges".
+func prefixWithin(p netip.Prefix, ranges []netip.Prefix) bool {
+	for _, r := range ranges {
+		if r.Addr().Is4() == p.Addr().Is4() && r.Bits() <= p.Bits() && r.Contains(p.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+func toTime(value interface{}) (t time.Time, ok bool) {
+	s, isString := value.(string)
+	if !isString {
+		return
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.000Z0700", "2006-01-02"} {
+		parsed, err := time.Parse(layout, s)
+		if err == nil {
+			return parsed, true
+		}
+	}
+	return
+}
@@ -0,0 +1,268 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RuleEvaluator`, func() {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	evaluator := &securityandcompliancecenterapiv3.RuleEvaluator{
+		Now: func() time.Time { return now },
+	}
+	resource := map[string]interface{}{
+		"name":       "my-bucket",
+		"public":     false,
+		"encrypted":  "true",
+		"size":       json.Number("42"),
+		"tags":       []interface{}{"env:prod", "team:sec"},
+		"empty_list": []interface{}{},
+		"allowed_ip": []interface{}{"10.0.0.5", "10.0.1.0/24"},
+		"rotated_on": "2025-05-25T00:00:00Z",
+		"metadata": map[string]interface{}{
+			"labels": []interface{}{"a", "b"},
+		},
+		"firewall.rule": "literal-dotted-key",
+	}
+	base := func(property string, operator string, value interface{}) *securityandcompliancecenterapiv3.RequiredConfigConditionBase {
+		return &securityandcompliancecenterapiv3.RequiredConfigConditionBase{
+			Property: core.StringPtr(property),
+			Operator: core.StringPtr(operator),
+			Value:    value,
+		}
+	}
+	item := func(property string, operator string, value interface{}) *securityandcompliancecenterapiv3.ConditionItemConditionBase {
+		return &securityandcompliancecenterapiv3.ConditionItemConditionBase{
+			Property: core.StringPtr(property),
+			Operator: core.StringPtr(operator),
+			Value:    value,
+		}
+	}
+
+	DescribeTable(`Operators`,
+		func(property string, operator string, value interface{}, expected bool) {
+			result, err := evaluator.EvaluateRequiredConfig(base(property, operator, value), resource)
+			Expect(err).To(BeNil())
+			Expect(result.Passed()).To(Equal(expected), result.Trace.Reason)
+			Expect(result.Trace.Kind).To(Equal(securityandcompliancecenterapiv3.ConditionKindBaseConst))
+			Expect(result.Trace.Path).To(Equal("required_config"))
+		},
+		Entry(`is_true passes on a boolean string`, "encrypted", "is_true", nil, true),
+		Entry(`is_false`, "public", "is_false", nil, true),
+		Entry(`is_true on missing property`, "missing", "is_true", nil, false),
+		Entry(`is_empty on missing property`, "missing", "is_empty", nil, true),
+		Entry(`is_empty on empty list`, "empty_list", "is_empty", nil, true),
+		Entry(`is_not_empty`, "tags", "is_not_empty", nil, true),
+		Entry(`is_not_empty on missing property`, "missing", "is_not_empty", nil, false),
+		Entry(`num_equals`, "size", "num_equals", 42, true),
+		Entry(`num_not_equals`, "size", "num_not_equals", "42", false),
+		Entry(`num_greater_than`, "size", "num_greater_than", 41, true),
+		Entry(`num_greater_than_equals`, "size", "num_greater_than_equals", 43, false),
+		Entry(`num_less_than`, "size", "num_less_than", 50, true),
+		Entry(`num_less_than_equals`, "size", "num_less_than_equals", 42, true),
+		Entry(`num_equals on a string property`, "name", "num_equals", 1, false),
+		Entry(`string_equals`, "name", "string_equals", "my-bucket", true),
+		Entry(`string_not_equals`, "name", "string_not_equals", "my-bucket", false),
+		Entry(`string_contains`, "name", "string_contains", "buck", true),
+		Entry(`string_not_contains`, "name", "string_not_contains", "buck", false),
+		Entry(`string_match`, "name", "string_match", "^my-[a-z]+$", true),
+		Entry(`string_not_match`, "name", "string_not_match", "^prod-", true),
+		Entry(`strings_in_list`, "name", "strings_in_list", []interface{}{"other", "my-bucket"}, true),
+		Entry(`strings_in_list not in list`, "name", "strings_in_list", []interface{}{"other"}, false),
+		Entry(`strings_allowed`, "tags", "strings_allowed", []interface{}{"env:prod", "team:sec", "x"}, true),
+		Entry(`strings_allowed with extra value`, "tags", "strings_allowed", []interface{}{"env:prod"}, false),
+		Entry(`strings_required`, "tags", "strings_required", []interface{}{"env:prod"}, true),
+		Entry(`strings_required with missing value`, "tags", "strings_required", []interface{}{"env:dev"}, false),
+		Entry(`ips_in_range`, "allowed_ip", "ips_in_range", []interface{}{"10.0.0.0/16"}, true),
+		Entry(`ips_in_range out of range`, "allowed_ip", "ips_in_range", []interface{}{"10.0.0.0/24"}, false),
+		Entry(`ips_equals`, "allowed_ip", "ips_equals", []interface{}{"10.0.1.0/24", "10.0.0.5"}, true),
+		Entry(`ips_not_equals`, "allowed_ip", "ips_not_equals", []interface{}{"10.0.0.5"}, true),
+		Entry(`days_less_than`, "rotated_on", "days_less_than", 10, true),
+		Entry(`days_less_than expired`, "rotated_on", "days_less_than", 5, false),
+		Entry(`nested property`, "metadata.labels.1", "string_equals", "b", true),
+		Entry(`literal dotted key`, "firewall.rule", "string_equals", "literal-dotted-key", true),
+	)
+
+	DescribeTable(`Invalid rules`,
+		func(operator string, value interface{}) {
+			result, err := evaluator.EvaluateRequiredConfig(base("name", operator, value), resource)
+			Expect(err).ToNot(BeNil())
+			Expect(result).To(BeNil())
+		},
+		Entry(`unknown operator`, "string_sounds_like", "x"),
+		Entry(`invalid regular expression`, "string_match", "("),
+		Entry(`non-numeric value`, "num_equals", "abc"),
+		Entry(`invalid CIDR value`, "ips_in_range", "10.0.0.0/99"),
+	)
+
+	It(`Evaluate and/or lists with a trace`, func() {
+		requiredConfig := &securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionAnd{
+			Description: core.StringPtr("bucket hardening"),
+			And: []securityandcompliancecenterapiv3.ConditionItemIntf{
+				item("public", "is_false", nil),
+				&securityandcompliancecenterapiv3.ConditionItemConditionListConditionListConditionOr{
+					Or: []securityandcompliancecenterapiv3.ConditionItemIntf{
+						item("size", "num_greater_than", 100),
+						item("name", "string_equals", "my-bucket"),
+					},
+				},
+			},
+		}
+		result, err := evaluator.EvaluateRequiredConfig(requiredConfig, resource)
+		Expect(err).To(BeNil())
+		Expect(result.Status).To(Equal(securityandcompliancecenterapiv3.EvaluationStatusPassConst))
+		Expect(result.Trace.Description).To(Equal("bucket hardening"))
+		Expect(result.Trace.Children).To(HaveLen(2))
+		orTrace := result.Trace.Children[1]
+		Expect(orTrace.Path).To(Equal("required_config.and[1]"))
+		Expect(orTrace.Children[0].Path).To(Equal("required_config.and[1].or[0]"))
+		Expect(orTrace.Children[0].Passed).To(BeFalse())
+		Expect(orTrace.Children[1].Passed).To(BeTrue())
+	})
+
+	It(`Evaluate a rule unmarshalled from JSON`, func() {
+		ruleJSON := `{
+			"id": "rule-1",
+			"target": {
+				"service_name": "cloud-object-storage",
+				"resource_kind": "bucket",
+				"additional_target_attributes": [{"name": "location", "operator": "string_equals", "value": "us-south"}]
+			},
+			"required_config": {
+				"and": [
+					{"property": "encryption.enabled", "operator": "is_true"},
+					{"all_ifexists": {
+						"target": {"service_name": "cloud-object-storage", "resource_kind": "rule", "ref": "lifecycle.rules"},
+						"required_config": {"property": "expiration_days", "operator": "num_less_than_equals", "value": 30}
+					}}
+				]
+			}
+		}`
+		var raw map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(ruleJSON), &raw)).To(Succeed())
+		var rule *securityandcompliancecenterapiv3.Rule
+		Expect(core.UnmarshalModel(raw, "", &rule, securityandcompliancecenterapiv3.UnmarshalRule)).To(Succeed())
+
+		compliant, err := securityandcompliancecenterapiv3.ParseResourceConfig([]byte(`{
+			"location": "us-south",
+			"encryption": {"enabled": true},
+			"lifecycle": {"rules": [{"expiration_days": 7}, {"expiration_days": 30}]}
+		}`))
+		Expect(err).To(BeNil())
+		result, err := evaluator.EvaluateRule(rule, compliant)
+		Expect(err).To(BeNil())
+		Expect(*result.RuleID).To(Equal("rule-1"))
+		Expect(result.Status).To(Equal(securityandcompliancecenterapiv3.EvaluationStatusPassConst))
+		Expect(result.TargetTrace).To(HaveLen(1))
+		Expect(result.Trace.Children[1].Children).To(HaveLen(2))
+
+		nonCompliant, err := securityandcompliancecenterapiv3.ParseResourceConfig([]byte(`{
+			"location": "us-south",
+			"encryption": {"enabled": true},
+			"lifecycle": {"rules": [{"expiration_days": 90}]}
+		}`))
+		Expect(err).To(BeNil())
+		result, err = evaluator.EvaluateRule(rule, nonCompliant)
+		Expect(err).To(BeNil())
+		Expect(result.Status).To(Equal(securityandcompliancecenterapiv3.EvaluationStatusFailureConst))
+		Expect(result.Trace.Children[1].Children[0].FoundValue).To(Equal(json.Number("90")))
+
+		noLifecycle, err := securityandcompliancecenterapiv3.ParseResourceConfig([]byte(`{"location": "us-south", "encryption": {"enabled": true}}`))
+		Expect(err).To(BeNil())
+		result, err = evaluator.EvaluateRule(rule, noLifecycle)
+		Expect(err).To(BeNil())
+		Expect(result.Passed()).To(BeTrue())
+
+		otherRegion, err := securityandcompliancecenterapiv3.ParseResourceConfig([]byte(`{"location": "eu-de"}`))
+		Expect(err).To(BeNil())
+		result, err = evaluator.EvaluateRule(rule, otherRegion)
+		Expect(err).To(BeNil())
+		Expect(result.Status).To(Equal(securityandcompliancecenterapiv3.EvaluationStatusSkippedConst))
+		Expect(result.Trace).To(BeNil())
+	})
+
+	DescribeTable(`Subrule quantifiers`,
+		func(quantifier string, related []interface{}, expected bool) {
+			subRule := &securityandcompliancecenterapiv3.SubRule{
+				Target:         &securityandcompliancecenterapiv3.RuleTarget{ServiceName: core.StringPtr("is"), ResourceKind: core.StringPtr("nics")},
+				RequiredConfig: base("secure", "is_true", nil),
+			}
+			var requiredConfig securityandcompliancecenterapiv3.RequiredConfigIntf
+			switch quantifier {
+			case "all":
+				requiredConfig = &securityandcompliancecenterapiv3.RequiredConfigConditionSubRuleConditionSubRuleConditionAll{All: subRule}
+			case "all_ifexists":
+				requiredConfig = &securityandcompliancecenterapiv3.RequiredConfigConditionSubRuleConditionSubRuleConditionAllIf{AllIfexists: subRule}
+			case "any":
+				requiredConfig = &securityandcompliancecenterapiv3.RequiredConfigConditionSubRuleConditionSubRuleConditionAny{Any: subRule}
+			case "any_ifexists":
+				requiredConfig = &securityandcompliancecenterapiv3.RequiredConfigConditionSubRuleConditionSubRuleConditionAnyIf{AnyIfexists: subRule}
+			}
+			result, err := evaluator.EvaluateRequiredConfig(requiredConfig, map[string]interface{}{"nics": related})
+			Expect(err).To(BeNil())
+			Expect(result.Passed()).To(Equal(expected))
+			Expect(result.Trace.Kind).To(Equal(quantifier))
+		},
+		Entry(`all with none`, "all", []interface{}{}, false),
+		Entry(`all with mixed`, "all", []interface{}{map[string]interface{}{"secure": true}, map[string]interface{}{"secure": false}}, false),
+		Entry(`all_ifexists with none`, "all_ifexists", []interface{}{}, true),
+		Entry(`any with mixed`, "any", []interface{}{map[string]interface{}{"secure": false}, map[string]interface{}{"secure": true}}, true),
+		Entry(`any with none`, "any", []interface{}{}, false),
+		Entry(`any_ifexists with none`, "any_ifexists", []interface{}{}, true),
+		Entry(`any_ifexists with failures`, "any_ifexists", []interface{}{map[string]interface{}{"secure": false}}, false),
+	)
+
+	It(`Use a custom related resources resolver`, func() {
+		custom := &securityandcompliancecenterapiv3.RuleEvaluator{
+			RelatedResources: func(target *securityandcompliancecenterapiv3.RuleTarget, resource map[string]interface{}) ([]map[string]interface{}, error) {
+				return []map[string]interface{}{{"secure": true}}, nil
+			},
+		}
+		requiredConfig := &securityandcompliancecenterapiv3.RequiredConfigConditionSubRuleConditionSubRuleConditionAll{
+			All: &securityandcompliancecenterapiv3.SubRule{
+				Target:         &securityandcompliancecenterapiv3.RuleTarget{ServiceName: core.StringPtr("is"), ResourceKind: core.StringPtr("nics")},
+				RequiredConfig: base("secure", "is_true", nil),
+			},
+		}
+		result, err := custom.EvaluateRequiredConfig(requiredConfig, map[string]interface{}{})
+		Expect(err).To(BeNil())
+		Expect(result.Passed()).To(BeTrue())
+	})
+
+	It(`Reject ambiguous and empty required configs`, func() {
+		_, err := evaluator.EvaluateRequiredConfig(&securityandcompliancecenterapiv3.RequiredConfig{
+			Property: core.StringPtr("name"),
+			Operator: core.StringPtr("is_empty"),
+			And:      []securityandcompliancecenterapiv3.ConditionItemIntf{item("name", "is_empty", nil)},
+		}, resource)
+		Expect(err).ToNot(BeNil())
+
+		_, err = evaluator.EvaluateRequiredConfig(&securityandcompliancecenterapiv3.RequiredConfig{}, resource)
+		Expect(err).ToNot(BeNil())
+
+		_, err = evaluator.EvaluateRule(nil, resource)
+		Expect(err).ToNot(BeNil())
+	})
+})
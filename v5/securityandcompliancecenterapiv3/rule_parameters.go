@@ -0,0 +1,508 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// Constants associated with the RuleDiagnostic.Code property, for the problems found while
+// resolving the parameters of a rule.
+const (
+	RuleDiagnosticCodeUnresolvedParameterConst   = "unresolved-parameter"
+	RuleDiagnosticCodeUndeclaredParameterConst   = "undeclared-parameter"
+	RuleDiagnosticCodeUnusedParameterConst       = "unused-parameter"
+	RuleDiagnosticCodeUnknownParameterConst      = "unknown-parameter"
+	RuleDiagnosticCodeInvalidParameterValueConst = "invalid-parameter-value"
+)
+
+// Constants associated with the ResolvedRuleParameter.Source property.
+const (
+	ResolvedRuleParameterSourceProfileConst    = "profile"
+	ResolvedRuleParameterSourceAttachmentConst = "attachment"
+)
+
+// ResolvedRuleParameter : The effective value of a parameter of a rule.
+type ResolvedRuleParameter struct {
+	// The name of the parameter.
+	Name string `json:"name"`
+
+	// The type of the parameter, as declared by the rule.
+	Type string `json:"type"`
+
+	// The value of the parameter, converted to its type.
+	Value interface{} `json:"value"`
+
+	// Where the value comes from: 'profile' for the default parameters of the profile, or
+	// 'attachment' for the parameters of the attachment.
+	Source string `json:"source"`
+
+	// The location of the value within its source, such as "attachment_parameters[2]".
+	Path string `json:"path"`
+}
+
+// RuleParameterResolution : The parameters of a rule resolved for a profile and an attachment.
+type RuleParameterResolution struct {
+	// The effective value of each parameter of the rule that has one, in the order of the
+	// declaration of the parameters.
+	Parameters []ResolvedRuleParameter `json:"parameters"`
+
+	// The required config of the rule with the parameter placeholders replaced by their values.
+	// Placeholders without a valid value are left as they are.
+	RequiredConfig RequiredConfigIntf `json:"required_config"`
+
+	// The unresolved, undeclared, unused, unknown and invalid parameters.
+	Diagnostics []RuleDiagnostic `json:"diagnostics,omitempty"`
+}
+
+// Resolved returns true if every placeholder of the required config was replaced by a value
+// and every value supplied for a parameter of the rule is valid.
+func (resolution *RuleParameterResolution) Resolved() bool {
+	for _, diagnostic := range resolution.Diagnostics {
+		switch diagnostic.Code {
+		case RuleDiagnosticCodeUnresolvedParameterConst, RuleDiagnosticCodeUndeclaredParameterConst, RuleDiagnosticCodeInvalidParameterValueConst:
+			return false
+		}
+	}
+	return true
+}
+
+// ruleParameterPattern matches a parameter placeholder, such as "${minimum_days}".
+var ruleParameterPattern = regexp.MustCompile(`\$\{\s*([A-Za-z0-9_.-]+)\s*\}`)
+
+// ResolveRuleParameters resolves the parameters that the required config of "rule" refers to
+// with "${name}" placeholders. The parameters are declared by the Import of the rule. Their
+// values come from the DefaultParameters of "profile", which are overridden by
+// "attachmentParameters", such as the AttachmentParameters of a ProfileAttachment. Either may be
+// nil. Only the values whose AssessmentID is the ID of the rule, or that have no AssessmentID,
+// apply to the rule.
+//
+// The values are converted to the type of the parameter: the values of the default parameters of
+// a profile are strings, for example, and a "numeric" parameter with the default value "30"
+// resolves to the number 30. A placeholder that makes up a whole value is replaced by the typed
+// value, and a list parameter that makes up an item of a list is spliced into the list. A
+// placeholder within a longer string is replaced by the text of the value.
+//
+// The rule and the profile are not modified.
+func ResolveRuleParameters(rule *Rule, profile *Profile, attachmentParameters []Parameter) (resolution *RuleParameterResolution, err error) {
+	err = core.ValidateNotNil(rule, "rule cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	resolver := &ruleParameterResolver{
+		declared:   make(map[string]*RuleParameter),
+		values:     make(map[string]*ResolvedRuleParameter),
+		referenced: make(map[string]bool),
+	}
+	var names []string
+	if rule.Import != nil {
+		for i := range rule.Import.Parameters {
+			parameter := &rule.Import.Parameters[i]
+			name := core.StringNilMapper(parameter.Name)
+			resolver.declared[name] = parameter
+			names = append(names, name)
+		}
+	}
+
+	ruleID := core.StringNilMapper(rule.ID)
+	appliesToRule := func(assessmentID *string) bool {
+		return assessmentID == nil || *assessmentID == "" || ruleID == "" || *assessmentID == ruleID
+	}
+	if profile != nil {
+		for i, parameter := range profile.DefaultParameters {
+			if !appliesToRule(parameter.AssessmentID) || parameter.ParameterDefaultValue == nil {
+				continue
+			}
+			resolver.supply(core.StringNilMapper(parameter.ParameterName), *parameter.ParameterDefaultValue,
+				ResolvedRuleParameterSourceProfileConst, fmt.Sprintf("default_parameters[%d]", i), "parameter_default_value")
+		}
+	}
+	for i, parameter := range attachmentParameters {
+		if !appliesToRule(parameter.AssessmentID) || parameter.ParameterValue == nil {
+			continue
+		}
+		resolver.supply(core.StringNilMapper(parameter.ParameterName), parameter.ParameterValue,
+			ResolvedRuleParameterSourceAttachmentConst, fmt.Sprintf("attachment_parameters[%d]", i), "parameter_value")
+	}
+
+	resolution = &RuleParameterResolution{}
+	if !core.IsNil(rule.RequiredConfig) {
+		resolution.RequiredConfig, err = resolver.requiredConfig(rule.RequiredConfig, "required_config")
+		if err != nil {
+			resolution = nil
+			err = core.SDKErrorf(err, "", "invalid-required-config", common.GetComponentInfo())
+			return
+		}
+	}
+
+	for i, name := range names {
+		if value, ok := resolver.values[name]; ok {
+			resolution.Parameters = append(resolution.Parameters, *value)
+		}
+		if !resolver.referenced[name] {
+			resolver.report(fmt.Sprintf("import.parameters[%d]", i), RuleDiagnosticCodeUnusedParameterConst,
+				"the parameter %q is not used by the required config", name)
+		}
+	}
+	resolution.Diagnostics = append(resolver.diagnostics, resolver.unknown...)
+	return
+}
+
+// ruleParameterResolver substitutes the values of the parameters into a required config.
+type ruleParameterResolver struct {
+	declared    map[string]*RuleParameter
+	values      map[string]*ResolvedRuleParameter
+	referenced  map[string]bool
+	diagnostics []RuleDiagnostic
+
+	// unknown holds the diagnostics of the values supplied for undeclared parameters, which are
+	// reported after the problems of the rule itself.
+	unknown []RuleDiagnostic
+}
+
+func (resolver *ruleParameterResolver) report(path string, code string, format string, args ...interface{}) {
+	resolver.diagnostics = append(resolver.diagnostics, RuleDiagnostic{
+		Path:    path,
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// supply records the value of a parameter, which overrides the values supplied before it. An
+// invalid value overrides them too, so that the parameter is left without a value rather than
+// silently resolved to a value that was meant to be replaced.
+func (resolver *ruleParameterResolver) supply(name string, value interface{}, source string, path string, valueProperty string) {
+	declared, ok := resolver.declared[name]
+	if !ok {
+		resolver.unknown = append(resolver.unknown, RuleDiagnostic{
+			Path:    path,
+			Code:    RuleDiagnosticCodeUnknownParameterConst,
+			Message: fmt.Sprintf("the rule does not declare the parameter %q", name),
+		})
+		return
+	}
+	parameterType := core.StringNilMapper(declared.Type)
+	converted, err := convertRuleParameter(parameterType, value)
+	if err != nil {
+		resolver.report(path+"."+valueProperty, RuleDiagnosticCodeInvalidParameterValueConst,
+			"the value of the %s parameter %q is not valid: %s", parameterType, name, err.Error())
+		delete(resolver.values, name)
+		return
+	}
+	resolver.values[name] = &ResolvedRuleParameter{
+		Name:   name,
+		Type:   parameterType,
+		Value:  converted,
+		Source: source,
+		Path:   path,
+	}
+}
+
+// requiredConfig returns a copy of a required config with the placeholders replaced.
+func (resolver *ruleParameterResolver) requiredConfig(requiredConfig RequiredConfigIntf, path string) (result *RequiredConfig, err error) {
+	node, err := newRequiredConfigNode(requiredConfig)
+	if err != nil {
+		err = fmt.Errorf("%s: %s", path, problemMessage(err))
+		return
+	}
+	result = &RequiredConfig{Description: node.description}
+	switch node.kind {
+	case ConditionKindBaseConst:
+		result.Property = node.property
+		result.Operator = node.operator
+		result.Value = resolver.value(node.value, path+".value")
+	case ConditionKindAndConst:
+		result.And, err = resolver.conditionItems(node.items, path+".and")
+	case ConditionKindOrConst:
+		result.Or, err = resolver.conditionItems(node.items, path+".or")
+	default:
+		var subRule *SubRule
+		subRule, err = resolver.subRule(node.subRule, path+"."+node.kind)
+		result.Any, result.AnyIfexists, result.All, result.AllIfexists = subRuleFields(node.kind, subRule)
+	}
+	if err != nil {
+		result = nil
+	}
+	return
+}
+
+func (resolver *ruleParameterResolver) conditionItems(items []ConditionItemIntf, path string) (result []ConditionItemIntf, err error) {
+	result = make([]ConditionItemIntf, 0, len(items))
+	for i, item := range items {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		var node *requiredConfigNode
+		node, err = newConditionItemNode(item)
+		if err != nil {
+			err = fmt.Errorf("%s: %s", itemPath, problemMessage(err))
+			return nil, err
+		}
+		resolved := &ConditionItem{Description: node.description}
+		switch node.kind {
+		case ConditionKindBaseConst:
+			resolved.Property = node.property
+			resolved.Operator = node.operator
+			resolved.Value = resolver.value(node.value, itemPath+".value")
+		case ConditionKindAndConst:
+			resolved.And, err = resolver.conditionItems(node.items, itemPath+".and")
+		case ConditionKindOrConst:
+			resolved.Or, err = resolver.conditionItems(node.items, itemPath+".or")
+		default:
+			var subRule *SubRule
+			subRule, err = resolver.subRule(node.subRule, itemPath+"."+node.kind)
+			resolved.Any, resolved.AnyIfexists, resolved.All, resolved.AllIfexists = subRuleFields(node.kind, subRule)
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resolved)
+	}
+	return
+}
+
+func (resolver *ruleParameterResolver) subRule(subRule *SubRule, path string) (result *SubRule, err error) {
+	result = &SubRule{Target: subRule.Target}
+	if !core.IsNil(subRule.RequiredConfig) {
+		result.RequiredConfig, err = resolver.requiredConfig(subRule.RequiredConfig, path+".required_config")
+	}
+	return
+}
+
+// subRuleFields returns the sub-rule in the field of its kind.
+func subRuleFields(kind string, subRule *SubRule) (anySubRule, anyIfexists, all, allIfexists *SubRule) {
+	switch kind {
+	case ConditionKindAnyConst:
+		anySubRule = subRule
+	case ConditionKindAnyIfexistsConst:
+		anyIfexists = subRule
+	case ConditionKindAllConst:
+		all = subRule
+	case ConditionKindAllIfexistsConst:
+		allIfexists = subRule
+	}
+	return
+}
+
+// value returns a copy of the value of a condition with the placeholders replaced.
+func (resolver *ruleParameterResolver) value(value interface{}, path string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return resolver.text(v, path)
+	case *string:
+		if v == nil {
+			return v
+		}
+		return resolver.text(*v, path)
+	case []string:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return resolver.list(items, path)
+	case []interface{}:
+		return resolver.list(v, path)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			result[key] = resolver.value(v[key], path+"."+key)
+		}
+		return result
+	}
+	return value
+}
+
+// list replaces the placeholders of the items of a list. The items of a list value are spliced
+// into the list.
+func (resolver *ruleParameterResolver) list(items []interface{}, path string) interface{} {
+	result := make([]interface{}, 0, len(items))
+	for i, item := range items {
+		resolved := resolver.value(item, fmt.Sprintf("%s[%d]", path, i))
+		if _, isPlaceholder := ruleParameterName(item); isPlaceholder {
+			if values, isList := resolved.([]string); isList {
+				for _, value := range values {
+					result = append(result, value)
+				}
+				continue
+			}
+		}
+		result = append(result, resolved)
+	}
+	return result
+}
+
+// text replaces the placeholders of a string. A placeholder that makes up the whole string is
+// replaced by the typed value.
+func (resolver *ruleParameterResolver) text(text string, path string) interface{} {
+	if name, ok := ruleParameterName(text); ok {
+		if value, found := resolver.lookup(name, path); found {
+			return value
+		}
+		return text
+	}
+	return ruleParameterPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := ruleParameterPattern.FindStringSubmatch(placeholder)[1]
+		value, found := resolver.lookup(name, path)
+		if !found {
+			return placeholder
+		}
+		return ruleParameterText(value)
+	})
+}
+
+// lookup returns the value of a parameter referred to at "path", reporting its absence.
+func (resolver *ruleParameterResolver) lookup(name string, path string) (value interface{}, found bool) {
+	resolver.referenced[name] = true
+	if _, declared := resolver.declared[name]; !declared {
+		resolver.report(path, RuleDiagnosticCodeUndeclaredParameterConst, "the rule does not declare the parameter %q", name)
+		return
+	}
+	resolved, ok := resolver.values[name]
+	if !ok {
+		resolver.report(path, RuleDiagnosticCodeUnresolvedParameterConst, "the parameter %q has no value", name)
+		return
+	}
+	return resolved.Value, true
+}
+
+// ruleParameterName returns the name of the parameter if "value" is made up of a placeholder.
+func ruleParameterName(value interface{}) (name string, ok bool) {
+	text, isString := value.(string)
+	if !isString {
+		return
+	}
+	match := ruleParameterPattern.FindStringSubmatchIndex(text)
+	if match == nil || match[0] != 0 || match[1] != len(text) {
+		return
+	}
+	return text[match[2]:match[3]], true
+}
+
+// ruleParameterText formats the value of a parameter for a placeholder within a string.
+func ruleParameterText(value interface{}) string {
+	if list, ok := value.([]string); ok {
+		return strings.Join(list, ",")
+	}
+	if s, ok := toScalarString(value); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// convertRuleParameter converts the value of a parameter to its type. String values, such as the
+// default values of a profile, are parsed: lists may be written as JSON arrays or as
+// comma-separated values.
+func convertRuleParameter(parameterType string, value interface{}) (converted interface{}, err error) {
+	switch parameterType {
+	case RuleParameterTypeBooleanConst:
+		b, ok := toBool(value)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a boolean", ruleParameterText(value))
+		}
+		return b, nil
+
+	case RuleParameterTypeNumericConst:
+		f, ok := toFloat(value)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a number", ruleParameterText(value))
+		}
+		if s, isString := value.(string); isString {
+			if i, intErr := strconv.ParseInt(strings.TrimSpace(s), 10, 64); intErr == nil {
+				return i, nil
+			}
+		}
+		if _, isNumber := value.(json.Number); isNumber {
+			return value, nil
+		}
+		return f, nil
+
+	case RuleParameterTypeStringConst:
+		s, ok := toScalarString(value)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a string", ruleParameterText(value))
+		}
+		return s, nil
+
+	case RuleParameterTypeStringListConst, RuleParameterTypeIPListConst:
+		list, ok := ruleParameterList(value)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a list of strings", ruleParameterText(value))
+		}
+		if parameterType == RuleParameterTypeIPListConst {
+			if _, err = toPrefixes(list); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+
+	case RuleParameterTypeTimestampConst:
+		if _, ok := toTime(value); ok {
+			return value, nil
+		}
+		f, ok := toFloat(value)
+		if !ok {
+			return nil, fmt.Errorf("%v is neither a timestamp nor a number of days", ruleParameterText(value))
+		}
+		return f, nil
+	}
+	return value, nil
+}
+
+// ruleParameterList converts a list value, a JSON array or comma-separated values to a list of
+// strings.
+func ruleParameterList(value interface{}) (list []string, ok bool) {
+	s, isString := value.(string)
+	if !isString {
+		switch value.(type) {
+		case []string, []interface{}:
+			return toStringList(value)
+		}
+		return
+	}
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") {
+		var items []interface{}
+		if json.Unmarshal([]byte(s), &items) != nil {
+			return
+		}
+		return toStringList(items)
+	}
+	list = []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list, true
+}
@@ -0,0 +1,281 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResolveRuleParameters`, func() {
+	const ruleID = "rule-7b0560a4-df94-4629-bb76-680f3155ddda"
+
+	newRule := func() *securityandcompliancecenterapiv3.Rule {
+		return &securityandcompliancecenterapiv3.Rule{
+			ID: core.StringPtr(ruleID),
+			Target: &securityandcompliancecenterapiv3.RuleTarget{
+				ServiceName:  core.StringPtr("cloud-object-storage"),
+				ResourceKind: core.StringPtr("bucket"),
+			},
+			RequiredConfig: &securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionAnd{
+				Description: core.StringPtr("The bucket is retained and restricted"),
+				And: []securityandcompliancecenterapiv3.ConditionItemIntf{
+					&securityandcompliancecenterapiv3.ConditionItemConditionBase{
+						Property: core.StringPtr("retention.days"),
+						Operator: core.StringPtr("num_greater_than_equals"),
+						Value:    "${minimum_days}",
+					},
+					&securityandcompliancecenterapiv3.ConditionItemConditionBase{
+						Property: core.StringPtr("storage_class"),
+						Operator: core.StringPtr("strings_in_list"),
+						Value:    []interface{}{"smart", "${storage_classes}"},
+					},
+					&securityandcompliancecenterapiv3.ConditionItemConditionBase{
+						Property: core.StringPtr("name"),
+						Operator: core.StringPtr("string_match"),
+						Value:    "^${prefix}-[a-z]+$",
+					},
+				},
+			},
+			Import: &securityandcompliancecenterapiv3.Import{
+				Parameters: []securityandcompliancecenterapiv3.RuleParameter{
+					{Name: core.StringPtr("minimum_days"), Type: core.StringPtr("numeric")},
+					{Name: core.StringPtr("storage_classes"), Type: core.StringPtr("string_list")},
+					{Name: core.StringPtr("prefix"), Type: core.StringPtr("string")},
+				},
+			},
+		}
+	}
+	newProfile := func() *securityandcompliancecenterapiv3.Profile {
+		return &securityandcompliancecenterapiv3.Profile{
+			DefaultParameters: []securityandcompliancecenterapiv3.DefaultParameters{
+				{AssessmentID: core.StringPtr(ruleID), ParameterName: core.StringPtr("minimum_days"), ParameterDefaultValue: core.StringPtr("30")},
+				{AssessmentID: core.StringPtr(ruleID), ParameterName: core.StringPtr("storage_classes"), ParameterDefaultValue: core.StringPtr("standard, vault")},
+				{AssessmentID: core.StringPtr(ruleID), ParameterName: core.StringPtr("prefix"), ParameterDefaultValue: core.StringPtr("scc")},
+				{AssessmentID: core.StringPtr("rule-other"), ParameterName: core.StringPtr("minimum_days"), ParameterDefaultValue: core.StringPtr("1")},
+			},
+		}
+	}
+
+	It(`Invoke ResolveRuleParameters with the default parameters of the profile`, func() {
+		rule := newRule()
+		resolution, err := securityandcompliancecenterapiv3.ResolveRuleParameters(rule, newProfile(), nil)
+		Expect(err).To(BeNil())
+		Expect(resolution.Diagnostics).To(BeEmpty())
+		Expect(resolution.Resolved()).To(BeTrue())
+		Expect(resolution.Parameters).To(Equal([]securityandcompliancecenterapiv3.ResolvedRuleParameter{
+			{Name: "minimum_days", Type: "numeric", Value: int64(30), Source: "profile", Path: "default_parameters[0]"},
+			{Name: "storage_classes", Type: "string_list", Value: []string{"standard", "vault"}, Source: "profile", Path: "default_parameters[1]"},
+			{Name: "prefix", Type: "string", Value: "scc", Source: "profile", Path: "default_parameters[2]"},
+		}))
+
+		data, err := json.Marshal(resolution.RequiredConfig)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{
+			"description": "The bucket is retained and restricted",
+			"and": [
+				{"property": "retention.days", "operator": "num_greater_than_equals", "value": 30},
+				{"property": "storage_class", "operator": "strings_in_list", "value": ["smart", "standard", "vault"]},
+				{"property": "name", "operator": "string_match", "value": "^scc-[a-z]+$"}
+			]
+		}`))
+
+		// The rule is left untouched.
+		Expect(rule.RequiredConfig.(*securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionAnd).And[0].(*securityandcompliancecenterapiv3.ConditionItemConditionBase).Value).To(Equal("${minimum_days}"))
+
+		result, err := securityandcompliancecenterapiv3.NewRuleEvaluator().EvaluateRequiredConfig(resolution.RequiredConfig, map[string]interface{}{
+			"retention":     map[string]interface{}{"days": 45},
+			"storage_class": "vault",
+			"name":          "scc-audit",
+		})
+		Expect(err).To(BeNil())
+		Expect(result.Passed()).To(BeTrue())
+	})
+	It(`Invoke ResolveRuleParameters with attachment parameters overriding the profile`, func() {
+		resolution, err := securityandcompliancecenterapiv3.ResolveRuleParameters(newRule(), newProfile(), []securityandcompliancecenterapiv3.Parameter{
+			{AssessmentID: core.StringPtr("rule-other"), ParameterName: core.StringPtr("prefix"), ParameterValue: "other"},
+			{AssessmentID: core.StringPtr(ruleID), ParameterName: core.StringPtr("minimum_days"), ParameterValue: float64(90)},
+			{ParameterName: core.StringPtr("storage_classes"), ParameterValue: []interface{}{"cold"}},
+		})
+		Expect(err).To(BeNil())
+		Expect(resolution.Diagnostics).To(BeEmpty())
+		Expect(resolution.Parameters[0].Value).To(Equal(float64(90)))
+		Expect(resolution.Parameters[0].Source).To(Equal("attachment"))
+		Expect(resolution.Parameters[0].Path).To(Equal("attachment_parameters[1]"))
+		Expect(resolution.Parameters[1].Value).To(Equal([]string{"cold"}))
+		Expect(resolution.Parameters[2].Source).To(Equal("profile"))
+
+		data, err := json.Marshal(resolution.RequiredConfig)
+		Expect(err).To(BeNil())
+		Expect(data).To(ContainSubstring(`"value":90`))
+		Expect(data).To(ContainSubstring(`"value":["smart","cold"]`))
+	})
+	It(`Invoke ResolveRuleParameters with an invalid attachment parameter overriding the profile`, func() {
+		resolution, err := securityandcompliancecenterapiv3.ResolveRuleParameters(newRule(), newProfile(), []securityandcompliancecenterapiv3.Parameter{
+			{AssessmentID: core.StringPtr(ruleID), ParameterName: core.StringPtr("minimum_days"), ParameterValue: "ninety"},
+		})
+		Expect(err).To(BeNil())
+		Expect(resolution.Resolved()).To(BeFalse())
+		Expect(resolution.Parameters).To(HaveLen(2))
+		Expect(resolution.Parameters[0].Name).To(Equal("storage_classes"))
+
+		var diagnostics []string
+		for _, diagnostic := range resolution.Diagnostics {
+			diagnostics = append(diagnostics, diagnostic.Code+" "+diagnostic.String())
+		}
+		Expect(diagnostics).To(Equal([]string{
+			`invalid-parameter-value attachment_parameters[0].parameter_value: the value of the numeric parameter "minimum_days" is not valid: ninety is not a number`,
+			`unresolved-parameter required_config.and[0].value: the parameter "minimum_days" has no value`,
+		}))
+
+		// The placeholder is not filled with the value of the profile.
+		data, err := json.Marshal(resolution.RequiredConfig)
+		Expect(err).To(BeNil())
+		Expect(data).To(ContainSubstring(`"value":"${minimum_days}"`))
+	})
+	It(`Invoke ResolveRuleParameters with an invalid profile parameter overridden by the attachment`, func() {
+		profile := newProfile()
+		profile.DefaultParameters[0].ParameterDefaultValue = core.StringPtr("thirty")
+		resolution, err := securityandcompliancecenterapiv3.ResolveRuleParameters(newRule(), profile, []securityandcompliancecenterapiv3.Parameter{
+			{AssessmentID: core.StringPtr(ruleID), ParameterName: core.StringPtr("minimum_days"), ParameterValue: float64(90)},
+		})
+		Expect(err).To(BeNil())
+		Expect(resolution.Diagnostics).To(HaveLen(1))
+		Expect(resolution.Diagnostics[0].Code).To(Equal(securityandcompliancecenterapiv3.RuleDiagnosticCodeInvalidParameterValueConst))
+		Expect(resolution.Resolved()).To(BeFalse())
+		Expect(resolution.Parameters[0].Value).To(Equal(float64(90)))
+	})
+	It(`Invoke ResolveRuleParameters with unresolved, undeclared and unused parameters`, func() {
+		rule := newRule()
+		rule.Import.Parameters = append(rule.Import.Parameters, securityandcompliancecenterapiv3.RuleParameter{
+			Name: core.StringPtr("legacy"), Type: core.StringPtr("boolean"),
+		})
+		rule.RequiredConfig = &securityandcompliancecenterapiv3.RequiredConfigConditionSubRuleConditionSubRuleConditionAll{
+			All: &securityandcompliancecenterapiv3.SubRule{
+				Target: &securityandcompliancecenterapiv3.RuleTarget{
+					ServiceName:  core.StringPtr("cloud-object-storage"),
+					ResourceKind: core.StringPtr("bucket-rule"),
+				},
+				RequiredConfig: &securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionOr{
+					Or: []securityandcompliancecenterapiv3.ConditionItemIntf{
+						&securityandcompliancecenterapiv3.ConditionItemConditionBase{
+							Property: core.StringPtr("days"),
+							Operator: core.StringPtr("num_equals"),
+							Value:    "${minimum_days}",
+						},
+						&securityandcompliancecenterapiv3.ConditionItemConditionBase{
+							Property: core.StringPtr("prefix"),
+							Operator: core.StringPtr("string_equals"),
+							Value:    "${prefix}/${region}",
+						},
+						&securityandcompliancecenterapiv3.ConditionItemConditionBase{
+							Property: core.StringPtr("classes"),
+							Operator: core.StringPtr("strings_allowed"),
+							Value:    []string{"${storage_classes}"},
+						},
+					},
+				},
+			},
+		}
+		resolution, err := securityandcompliancecenterapiv3.ResolveRuleParameters(rule, nil, []securityandcompliancecenterapiv3.Parameter{
+			{AssessmentID: core.StringPtr(ruleID), ParameterName: core.StringPtr("prefix"), ParameterValue: "logs"},
+			{AssessmentID: core.StringPtr(ruleID), ParameterName: core.StringPtr("storage_classes"), ParameterValue: `["smart"]`},
+			{AssessmentID: core.StringPtr(ruleID), ParameterName: core.StringPtr("retention"), ParameterValue: "7"},
+		})
+		Expect(err).To(BeNil())
+		Expect(resolution.Resolved()).To(BeFalse())
+
+		var diagnostics []string
+		for _, diagnostic := range resolution.Diagnostics {
+			diagnostics = append(diagnostics, diagnostic.Code+" "+diagnostic.String())
+		}
+		Expect(diagnostics).To(Equal([]string{
+			`unresolved-parameter required_config.all.required_config.or[0].value: the parameter "minimum_days" has no value`,
+			`undeclared-parameter required_config.all.required_config.or[1].value: the rule does not declare the parameter "region"`,
+			`unused-parameter import.parameters[3]: the parameter "legacy" is not used by the required config`,
+			`unknown-parameter attachment_parameters[2]: the rule does not declare the parameter "retention"`,
+		}))
+
+		data, err := json.Marshal(resolution.RequiredConfig)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{
+			"all": {
+				"target": {"service_name": "cloud-object-storage", "resource_kind": "bucket-rule"},
+				"required_config": {
+					"or": [
+						{"property": "days", "operator": "num_equals", "value": "${minimum_days}"},
+						{"property": "prefix", "operator": "string_equals", "value": "logs/${region}"},
+						{"property": "classes", "operator": "strings_allowed", "value": ["smart"]}
+					]
+				}
+			}
+		}`))
+	})
+	table.DescribeTable(`Invoke ResolveRuleParameters with a value of the wrong type`,
+		func(parameterType string, value interface{}, message string) {
+			rule := &securityandcompliancecenterapiv3.Rule{
+				RequiredConfig: &securityandcompliancecenterapiv3.RequiredConfigConditionBase{
+					Property: core.StringPtr("setting"),
+					Operator: core.StringPtr("is_true"),
+					Value:    "${setting}",
+				},
+				Import: &securityandcompliancecenterapiv3.Import{
+					Parameters: []securityandcompliancecenterapiv3.RuleParameter{
+						{Name: core.StringPtr("setting"), Type: core.StringPtr(parameterType)},
+					},
+				},
+			}
+			resolution, err := securityandcompliancecenterapiv3.ResolveRuleParameters(rule, nil, []securityandcompliancecenterapiv3.Parameter{
+				{ParameterName: core.StringPtr("setting"), ParameterValue: value},
+			})
+			Expect(err).To(BeNil())
+			Expect(resolution.Parameters).To(BeEmpty())
+			Expect(resolution.Resolved()).To(BeFalse())
+			Expect(resolution.Diagnostics).To(HaveLen(2))
+			Expect(resolution.Diagnostics[0].Code).To(Equal(securityandcompliancecenterapiv3.RuleDiagnosticCodeInvalidParameterValueConst))
+			Expect(resolution.Diagnostics[0].String()).To(Equal("attachment_parameters[0].parameter_value: " + message))
+			Expect(resolution.Diagnostics[1].Code).To(Equal(securityandcompliancecenterapiv3.RuleDiagnosticCodeUnresolvedParameterConst))
+		},
+		table.Entry(`boolean`, "boolean", "yes", `the value of the boolean parameter "setting" is not valid: yes is not a boolean`),
+		table.Entry(`numeric`, "numeric", "thirty", `the value of the numeric parameter "setting" is not valid: thirty is not a number`),
+		table.Entry(`string`, "string", []interface{}{"a"}, `the value of the string parameter "setting" is not valid: ["a"] is not a string`),
+		table.Entry(`string_list`, "string_list", map[string]interface{}{}, `the value of the string_list parameter "setting" is not valid: {} is not a list of strings`),
+		table.Entry(`ip_list`, "ip_list", "10.0.0.0/8, 300.1.1.1", `the value of the ip_list parameter "setting" is not valid: ParseAddr("300.1.1.1"): IPv4 field has value >255`),
+		table.Entry(`timestamp`, "timestamp", "yesterday", `the value of the timestamp parameter "setting" is not valid: yesterday is neither a timestamp nor a number of days`),
+	)
+	It(`Invoke ResolveRuleParameters with an invalid rule`, func() {
+		resolution, err := securityandcompliancecenterapiv3.ResolveRuleParameters(nil, nil, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(resolution).To(BeNil())
+
+		rule := newRule()
+		rule.RequiredConfig = &securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionAnd{
+			And: []securityandcompliancecenterapiv3.ConditionItemIntf{
+				&securityandcompliancecenterapiv3.ConditionItemConditionBase{Property: core.StringPtr("name")},
+			},
+		}
+		resolution, err = securityandcompliancecenterapiv3.ResolveRuleParameters(rule, newProfile(), nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("required_config.and[0]: a base condition requires both 'property' and 'operator'"))
+		Expect(resolution).To(BeNil())
+	})
+})
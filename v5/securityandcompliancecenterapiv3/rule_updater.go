@@ -0,0 +1,207 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// DefaultUpdateRuleMaxAttempts is the number of times UpdateRule tries to replace a rule when
+// UpdateRuleOptions.MaxAttempts is not set.
+const DefaultUpdateRuleMaxAttempts = 5
+
+// RuleConflictError is returned by UpdateRule when the rule was modified by someone else before
+// each of its attempts to replace it. It wraps the APIError of the last attempt, so it matches
+// ErrPreconditionFailed with errors.Is; the response of the last attempt is returned along with
+// the error.
+type RuleConflictError struct {
+	// The ID of the rule.
+	RuleID string
+
+	// The number of attempts to replace the rule.
+	Attempts int
+
+	// The *APIError of the last attempt, which matches ErrPreconditionFailed.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *RuleConflictError) Error() string {
+	return fmt.Sprintf("rule %s was modified concurrently during %d attempts to update it", e.RuleID, e.Attempts)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RuleConflictError) Unwrap() error {
+	return e.Err
+}
+
+// UpdateRuleOptions : The UpdateRule options.
+type UpdateRuleOptions struct {
+	// The ID of the Security and Compliance Center instance.
+	InstanceID *string `json:"instance_id" validate:"required,ne="`
+
+	// The ID of a rule/assessment.
+	RuleID *string `json:"rule_id" validate:"required,ne="`
+
+	// The function that changes the rule read from the service. After a conflict it is invoked
+	// again with the rule read anew, so it must not depend on the previous invocations.
+	// Returning an error aborts the update.
+	Mutate func(rule *Rule) error `json:"-" validate:"required"`
+
+	// The maximum number of attempts to replace the rule. Defaults to DefaultUpdateRuleMaxAttempts.
+	MaxAttempts int
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewUpdateRuleOptions : Instantiate UpdateRuleOptions
+func (*SecurityAndComplianceCenterAPIV3) NewUpdateRuleOptions(instanceID string, ruleID string, mutate func(rule *Rule) error) *UpdateRuleOptions {
+	return &UpdateRuleOptions{
+		InstanceID: core.StringPtr(instanceID),
+		RuleID:     core.StringPtr(ruleID),
+		Mutate:     mutate,
+	}
+}
+
+// SetInstanceID : Allow user to set InstanceID
+func (_options *UpdateRuleOptions) SetInstanceID(instanceID string) *UpdateRuleOptions {
+	_options.InstanceID = core.StringPtr(instanceID)
+	return _options
+}
+
+// SetRuleID : Allow user to set RuleID
+func (_options *UpdateRuleOptions) SetRuleID(ruleID string) *UpdateRuleOptions {
+	_options.RuleID = core.StringPtr(ruleID)
+	return _options
+}
+
+// SetMutate : Allow user to set Mutate
+func (_options *UpdateRuleOptions) SetMutate(mutate func(rule *Rule) error) *UpdateRuleOptions {
+	_options.Mutate = mutate
+	return _options
+}
+
+// SetMaxAttempts : Allow user to set MaxAttempts
+func (_options *UpdateRuleOptions) SetMaxAttempts(maxAttempts int) *UpdateRuleOptions {
+	_options.MaxAttempts = maxAttempts
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdateRuleOptions) SetHeaders(param map[string]string) *UpdateRuleOptions {
+	options.Headers = param
+	return options
+}
+
+// UpdateRule : Update a custom rule with optimistic concurrency
+// Read a custom rule, change it with a function and replace it on the condition that it was not
+// modified in the meantime, using the ETag of the rule. When the rule was modified (412
+// Precondition Failed), the rule is read and changed again, up to MaxAttempts times, before
+// failing with a RuleConflictError.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateRule(updateRuleOptions *UpdateRuleOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	result, response, err = securityAndComplianceCenterApi.UpdateRuleWithContext(context.Background(), updateRuleOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdateRuleWithContext is an alternate form of the UpdateRule method which supports a Context parameter
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateRuleWithContext(ctx context.Context, updateRuleOptions *UpdateRuleOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(updateRuleOptions, "updateRuleOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(updateRuleOptions, "updateRuleOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	maxAttempts := updateRuleOptions.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultUpdateRuleMaxAttempts
+	}
+	getRuleOptions := &GetRuleOptions{
+		InstanceID: updateRuleOptions.InstanceID,
+		RuleID:     updateRuleOptions.RuleID,
+		Headers:    updateRuleOptions.Headers,
+	}
+	for attempt := 1; ; attempt++ {
+		var rule *Rule
+		rule, response, err = securityAndComplianceCenterApi.GetRuleWithContext(ctx, getRuleOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-rule-error")
+			return
+		}
+		etag := response.GetHeaders().Get("ETag")
+		if etag == "" {
+			err = core.SDKErrorf(nil, "the service did not return the ETag of the rule", "missing-etag", common.GetComponentInfo())
+			return
+		}
+
+		err = updateRuleOptions.Mutate(rule)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "mutate-rule-error", common.GetComponentInfo())
+			return
+		}
+
+		replaceRuleOptions := &ReplaceRuleOptions{
+			InstanceID:     updateRuleOptions.InstanceID,
+			RuleID:         updateRuleOptions.RuleID,
+			IfMatch:        core.StringPtr(etag),
+			Description:    rule.Description,
+			RequiredConfig: rule.RequiredConfig,
+			Version:        rule.Version,
+			Import:         rule.Import,
+			Labels:         rule.Labels,
+			Headers:        updateRuleOptions.Headers,
+		}
+		if rule.Target != nil {
+			replaceRuleOptions.Target = &RuleTargetPrototype{
+				ServiceName:                rule.Target.ServiceName,
+				ResourceKind:               rule.Target.ResourceKind,
+				AdditionalTargetAttributes: rule.Target.AdditionalTargetAttributes,
+			}
+		}
+		result, response, err = securityAndComplianceCenterApi.ReplaceRuleWithContext(ctx, replaceRuleOptions)
+		if err == nil {
+			return
+		}
+		if !errors.Is(err, ErrPreconditionFailed) {
+			err = core.RepurposeSDKProblem(err, "replace-rule-error")
+			return
+		}
+		if attempt >= maxAttempts {
+			// Not wrapped in an SDK problem, which would replace the RuleConflictError with the
+			// problem found in its chain.
+			var apiErr *APIError
+			errors.As(err, &apiErr)
+			err = &RuleConflictError{
+				RuleID:   *updateRuleOptions.RuleID,
+				Attempts: attempt,
+				Err:      apiErr,
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/scctest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`UpdateRule`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	var server *scctest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var ruleID string
+
+	// modifyRule replaces the rule behind the back of UpdateRule.
+	modifyRule := func(description string) {
+		rule, response, err := securityAndComplianceCenterAPIService.GetRule(securityAndComplianceCenterAPIService.NewGetRuleOptions(instanceID, ruleID))
+		Expect(err).To(BeNil())
+		_, _, err = securityAndComplianceCenterAPIService.ReplaceRule(&securityandcompliancecenterapiv3.ReplaceRuleOptions{
+			InstanceID:     core.StringPtr(instanceID),
+			RuleID:         core.StringPtr(ruleID),
+			IfMatch:        core.StringPtr(response.GetHeaders().Get("ETag")),
+			Description:    core.StringPtr(description),
+			Target:         &securityandcompliancecenterapiv3.RuleTargetPrototype{ServiceName: rule.Target.ServiceName, ResourceKind: rule.Target.ResourceKind},
+			RequiredConfig: rule.RequiredConfig,
+			Labels:         rule.Labels,
+		})
+		Expect(err).To(BeNil())
+	}
+
+	BeforeEach(func() {
+		server = scctest.NewServer()
+		var err error
+		securityAndComplianceCenterAPIService, err = server.NewService()
+		Expect(err).To(BeNil())
+
+		ruleID, err = server.Seed(instanceID, scctest.CollectionRules, &securityandcompliancecenterapiv3.Rule{
+			Description: core.StringPtr("Buckets use the smart storage class"),
+			Version:     core.StringPtr("1.0.0"),
+			Target: &securityandcompliancecenterapiv3.RuleTarget{
+				ServiceName:  core.StringPtr("cloud-object-storage"),
+				ResourceKind: core.StringPtr("bucket"),
+			},
+			RequiredConfig: &securityandcompliancecenterapiv3.RequiredConfigConditionBase{
+				Property: core.StringPtr("storage_class"),
+				Operator: core.StringPtr("string_equals"),
+				Value:    "smart",
+			},
+			Labels: []string{"storage"},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Invoke UpdateRule successfully`, func() {
+		calls := 0
+		options := securityAndComplianceCenterAPIService.NewUpdateRuleOptions(instanceID, ruleID, func(rule *securityandcompliancecenterapiv3.Rule) error {
+			calls++
+			rule.Labels = append(rule.Labels, "encryption")
+			rule.Version = core.StringPtr("1.1.0")
+			return nil
+		})
+		rule, response, err := securityAndComplianceCenterAPIService.UpdateRule(options)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(calls).To(Equal(1))
+		Expect(rule.Labels).To(Equal([]string{"storage", "encryption"}))
+		Expect(*rule.Version).To(Equal("1.1.0"))
+		Expect(*rule.Description).To(Equal("Buckets use the smart storage class"))
+		Expect(*rule.Target.ResourceKind).To(Equal("bucket"))
+	})
+	It(`Invoke UpdateRule with a concurrent modification`, func() {
+		calls := 0
+		options := securityAndComplianceCenterAPIService.NewUpdateRuleOptions(instanceID, ruleID, func(rule *securityandcompliancecenterapiv3.Rule) error {
+			calls++
+			if calls == 1 {
+				modifyRule("Buckets use a cost-effective storage class")
+			}
+			rule.Labels = []string{"cost"}
+			return nil
+		})
+		rule, _, err := securityAndComplianceCenterAPIService.UpdateRule(options)
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal(2))
+		Expect(*rule.Description).To(Equal("Buckets use a cost-effective storage class"))
+		Expect(rule.Labels).To(Equal([]string{"cost"}))
+	})
+	It(`Invoke UpdateRule with persistent conflicts`, func() {
+		calls := 0
+		options := securityAndComplianceCenterAPIService.NewUpdateRuleOptions(instanceID, ruleID, func(rule *securityandcompliancecenterapiv3.Rule) error {
+			calls++
+			modifyRule(fmt.Sprintf("Concurrent update %d", calls))
+			return nil
+		}).SetMaxAttempts(3)
+		rule, response, err := securityAndComplianceCenterAPIService.UpdateRule(options)
+		Expect(err).ToNot(BeNil())
+		Expect(rule).To(BeNil())
+		Expect(response.StatusCode).To(Equal(412))
+		Expect(calls).To(Equal(3))
+
+		var conflictErr *securityandcompliancecenterapiv3.RuleConflictError
+		Expect(errors.As(err, &conflictErr)).To(BeTrue())
+		Expect(conflictErr.RuleID).To(Equal(ruleID))
+		Expect(conflictErr.Attempts).To(Equal(3))
+		Expect(errors.Is(err, securityandcompliancecenterapiv3.ErrPreconditionFailed)).To(BeTrue())
+		var apiErr *securityandcompliancecenterapiv3.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(412))
+	})
+	It(`Invoke UpdateRule with a mutation error`, func() {
+		mutateErr := errors.New("the rule is locked")
+		options := securityAndComplianceCenterAPIService.NewUpdateRuleOptions(instanceID, ruleID, func(rule *securityandcompliancecenterapiv3.Rule) error {
+			rule.Labels = nil
+			return mutateErr
+		})
+		rule, _, err := securityAndComplianceCenterAPIService.UpdateRule(options)
+		Expect(err).ToNot(BeNil())
+		Expect(rule).To(BeNil())
+		Expect(errors.Is(err, mutateErr)).To(BeTrue())
+
+		current, _, err := securityAndComplianceCenterAPIService.GetRule(securityAndComplianceCenterAPIService.NewGetRuleOptions(instanceID, ruleID))
+		Expect(err).To(BeNil())
+		Expect(current.Labels).To(Equal([]string{"storage"}))
+	})
+	It(`Invoke UpdateRule with error: Operation validation and request error`, func() {
+		rule, _, err := securityAndComplianceCenterAPIService.UpdateRule(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(rule).To(BeNil())
+
+		rule, _, err = securityAndComplianceCenterAPIService.UpdateRule(securityAndComplianceCenterAPIService.NewUpdateRuleOptions(instanceID, ruleID, nil))
+		Expect(err).ToNot(BeNil())
+		Expect(rule).To(BeNil())
+
+		rule, response, err := securityAndComplianceCenterAPIService.UpdateRule(securityAndComplianceCenterAPIService.NewUpdateRuleOptions(instanceID, "rule-unknown", func(*securityandcompliancecenterapiv3.Rule) error {
+			return nil
+		}))
+		Expect(err).ToNot(BeNil())
+		Expect(rule).To(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+})
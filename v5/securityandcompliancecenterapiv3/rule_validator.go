@@ -0,0 +1,356 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the RuleDiagnostic.Code property.
+const (
+	RuleDiagnosticCodeMissingTargetConst           = "missing-target"
+	RuleDiagnosticCodeUnknownServiceConst          = "unknown-service"
+	RuleDiagnosticCodeUnsupportedResourceKindConst = "unsupported-resource-kind"
+	RuleDiagnosticCodeUnknownTargetAttributeConst  = "unknown-target-attribute"
+	RuleDiagnosticCodeInvalidRequiredConfigConst   = "invalid-required-config"
+	RuleDiagnosticCodeUnknownPropertyConst         = "unknown-property"
+	RuleDiagnosticCodeUnknownOperatorConst         = "unknown-operator"
+	RuleDiagnosticCodeIncompatibleOperatorConst    = "incompatible-operator"
+)
+
+// RuleDiagnostic : A problem of a rule found by a RuleValidator.
+type RuleDiagnostic struct {
+	// The path of the offending property of the rule, such as "target.resource_kind" or
+	// "required_config.and[1].operator".
+	Path string `json:"path"`
+
+	// The kind of problem.
+	Code string `json:"code"`
+
+	// A description of the problem.
+	Message string `json:"message"`
+}
+
+// String returns the diagnostic prefixed by its path.
+func (diagnostic RuleDiagnostic) String() string {
+	return diagnostic.Path + ": " + diagnostic.Message
+}
+
+// RuleValidator checks rules against the service catalog before they are submitted: the target
+// service exists and supports the resource kind, and every property of the required config is a
+// property of the targeted resources with a type that suits the operator.
+type RuleValidator struct {
+	services map[string]*Service
+}
+
+// ruleOperatorsByPropertyType lists the operators that suit each type of property. The operators
+// of a "general" property are not checked.
+var ruleOperatorsByPropertyType = map[string][]string{
+	RulePropertyTypeBooleanConst: {
+		RequiredConfigOperatorIsTrueConst, RequiredConfigOperatorIsFalseConst,
+	},
+	RulePropertyTypeNumericConst: {
+		RequiredConfigOperatorNumEqualsConst, RequiredConfigOperatorNumNotEqualsConst,
+		RequiredConfigOperatorNumGreaterThanConst, RequiredConfigOperatorNumGreaterThanEqualsConst,
+		RequiredConfigOperatorNumLessThanConst, RequiredConfigOperatorNumLessThanEqualsConst,
+	},
+	RulePropertyTypeStringConst: {
+		RequiredConfigOperatorStringEqualsConst, RequiredConfigOperatorStringNotEqualsConst,
+		RequiredConfigOperatorStringContainsConst, RequiredConfigOperatorStringNotContainsConst,
+		RequiredConfigOperatorStringMatchConst, RequiredConfigOperatorStringNotMatchConst,
+		RequiredConfigOperatorStringsInListConst,
+	},
+	RulePropertyTypeStringListConst: {
+		RequiredConfigOperatorStringsAllowedConst, RequiredConfigOperatorStringsRequiredConst,
+	},
+	RulePropertyTypeIPListConst: {
+		RequiredConfigOperatorIpsEqualsConst, RequiredConfigOperatorIpsNotEqualsConst, RequiredConfigOperatorIpsInRangeConst,
+	},
+	RulePropertyTypeTimestampConst: {
+		RequiredConfigOperatorDaysLessThanConst,
+	},
+}
+
+// ruleOperators lists all of the operators. The empty and not empty operators suit any property.
+var ruleOperators = map[string]bool{
+	RequiredConfigOperatorIsEmptyConst:    true,
+	RequiredConfigOperatorIsNotEmptyConst: true,
+}
+
+func init() {
+	for _, operators := range ruleOperatorsByPropertyType {
+		for _, operator := range operators {
+			ruleOperators[operator] = true
+		}
+	}
+}
+
+// propertyIndexPattern matches the array indexes of a property path, such as "[0]" or ".0".
+var propertyIndexPattern = regexp.MustCompile(`\[\d+\]|\.\d+(?:$|\.)`)
+
+// NewRuleValidator returns a RuleValidator for the services of the catalog, such as the Services
+// of the ServiceCollection returned by ListServices or the result of GetService.
+func NewRuleValidator(services ...Service) *RuleValidator {
+	validator := &RuleValidator{services: make(map[string]*Service)}
+	for i := range services {
+		if services[i].ServiceName != nil {
+			validator.services[*services[i].ServiceName] = &services[i]
+		}
+	}
+	return validator
+}
+
+// ValidateCreateRuleOptions checks the options of a rule creation and returns its problems,
+// if any.
+func (validator *RuleValidator) ValidateCreateRuleOptions(createRuleOptions *CreateRuleOptions) []RuleDiagnostic {
+	if createRuleOptions == nil {
+		return nil
+	}
+	return validator.validate(ruleTargetOf(createRuleOptions.Target), createRuleOptions.RequiredConfig)
+}
+
+// ValidateReplaceRuleOptions checks the options of a rule replacement and returns its problems,
+// if any.
+func (validator *RuleValidator) ValidateReplaceRuleOptions(replaceRuleOptions *ReplaceRuleOptions) []RuleDiagnostic {
+	if replaceRuleOptions == nil {
+		return nil
+	}
+	return validator.validate(ruleTargetOf(replaceRuleOptions.Target), replaceRuleOptions.RequiredConfig)
+}
+
+// ValidateRule checks a rule and returns its problems, if any.
+func (validator *RuleValidator) ValidateRule(rule *Rule) []RuleDiagnostic {
+	if rule == nil {
+		return nil
+	}
+	return validator.validate(rule.Target, rule.RequiredConfig)
+}
+
+func ruleTargetOf(target *RuleTargetPrototype) *RuleTarget {
+	if target == nil {
+		return nil
+	}
+	return &RuleTarget{
+		ServiceName:                target.ServiceName,
+		ResourceKind:               target.ResourceKind,
+		AdditionalTargetAttributes: target.AdditionalTargetAttributes,
+	}
+}
+
+// ruleValidation accumulates the diagnostics of a rule.
+type ruleValidation struct {
+	validator   *RuleValidator
+	diagnostics []RuleDiagnostic
+}
+
+func (validator *RuleValidator) validate(target *RuleTarget, requiredConfig RequiredConfigIntf) []RuleDiagnostic {
+	validation := &ruleValidation{validator: validator}
+	config := validation.target(target, "target")
+	validation.requiredConfig(requiredConfig, config, "required_config")
+	return validation.diagnostics
+}
+
+func (validation *ruleValidation) report(path string, code string, format string, args ...interface{}) {
+	validation.diagnostics = append(validation.diagnostics, RuleDiagnostic{
+		Path:    path,
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// target checks a target and returns the supported config of its resource kind, or nil if the
+// target is not supported.
+func (validation *ruleValidation) target(target *RuleTarget, path string) *SupportedConfigs {
+	if target == nil {
+		validation.report(path, RuleDiagnosticCodeMissingTargetConst, "the target is required")
+		return nil
+	}
+	serviceName := core.StringNilMapper(target.ServiceName)
+	service, ok := validation.validator.services[serviceName]
+	if !ok {
+		validation.report(path+".service_name", RuleDiagnosticCodeUnknownServiceConst, "the service %q is not in the catalog", serviceName)
+		return nil
+	}
+
+	resourceKind := core.StringNilMapper(target.ResourceKind)
+	var config *SupportedConfigs
+	var kinds []string
+	for i := range service.SupportedConfigs {
+		kind := core.StringNilMapper(service.SupportedConfigs[i].ResourceKind)
+		kinds = append(kinds, kind)
+		if kind == resourceKind {
+			config = &service.SupportedConfigs[i]
+		}
+	}
+	if config == nil {
+		validation.report(path+".resource_kind", RuleDiagnosticCodeUnsupportedResourceKindConst,
+			"the service %q does not support the resource kind %q; the supported kinds are %s", serviceName, resourceKind, quoteAll(kinds))
+		return nil
+	}
+
+	var attributes []string
+	for _, attribute := range config.AdditionalTargetAttributes {
+		attributes = append(attributes, core.StringNilMapper(attribute.Name))
+	}
+	for i, attribute := range target.AdditionalTargetAttributes {
+		name := core.StringNilMapper(attribute.Name)
+		if !containsString(attributes, name) {
+			validation.report(fmt.Sprintf("%s.additional_target_attributes[%d].name", path, i), RuleDiagnosticCodeUnknownTargetAttributeConst,
+				"the resource kind %q has no target attribute %q", resourceKind, name)
+		}
+	}
+	return config
+}
+
+func (validation *ruleValidation) requiredConfig(requiredConfig RequiredConfigIntf, config *SupportedConfigs, path string) {
+	if core.IsNil(requiredConfig) {
+		validation.report(path, RuleDiagnosticCodeInvalidRequiredConfigConst, "the required config is required")
+		return
+	}
+	node, err := newRequiredConfigNode(requiredConfig)
+	if err != nil {
+		validation.report(path, RuleDiagnosticCodeInvalidRequiredConfigConst, "%s", problemMessage(err))
+		return
+	}
+	validation.node(node, config, path)
+}
+
+func (validation *ruleValidation) node(node *requiredConfigNode, config *SupportedConfigs, path string) {
+	switch node.kind {
+	case ConditionKindBaseConst:
+		validation.condition(*node.property, *node.operator, config, path)
+
+	case ConditionKindAndConst, ConditionKindOrConst:
+		for i, item := range node.items {
+			itemPath := fmt.Sprintf("%s.%s[%d]", path, node.kind, i)
+			if core.IsNil(item) {
+				validation.report(itemPath, RuleDiagnosticCodeInvalidRequiredConfigConst, "the condition is required")
+				continue
+			}
+			child, err := newConditionItemNode(item)
+			if err != nil {
+				validation.report(itemPath, RuleDiagnosticCodeInvalidRequiredConfigConst, "%s", problemMessage(err))
+				continue
+			}
+			validation.node(child, config, itemPath)
+		}
+
+	default:
+		// The properties of a sub-rule are the properties of the resources of its own target.
+		subRulePath := path + "." + node.kind
+		subRuleConfig := validation.target(node.subRule.Target, subRulePath+".target")
+		validation.requiredConfig(node.subRule.RequiredConfig, subRuleConfig, subRulePath+".required_config")
+	}
+}
+
+// condition checks the property and the operator of a base condition. The properties are not
+// checked when the target is not supported, which is already reported.
+func (validation *ruleValidation) condition(property string, operator string, config *SupportedConfigs, path string) {
+	if !ruleOperators[operator] {
+		validation.report(path+".operator", RuleDiagnosticCodeUnknownOperatorConst, "the operator %q is not supported", operator)
+	}
+	if config == nil {
+		return
+	}
+
+	ruleProperty := findRuleProperty(config.Properties, property)
+	if ruleProperty == nil {
+		var names []string
+		for _, candidate := range config.Properties {
+			names = append(names, core.StringNilMapper(candidate.Name))
+		}
+		sort.Strings(names)
+		validation.report(path+".property", RuleDiagnosticCodeUnknownPropertyConst,
+			"the resource kind %q has no property %q; the known properties are %s", core.StringNilMapper(config.ResourceKind), property, quoteAll(names))
+		return
+	}
+
+	propertyType := core.StringNilMapper(ruleProperty.Type)
+	operators, checked := ruleOperatorsByPropertyType[propertyType]
+	if !checked || !ruleOperators[operator] || operator == RequiredConfigOperatorIsEmptyConst || operator == RequiredConfigOperatorIsNotEmptyConst {
+		return
+	}
+	if !containsString(operators, operator) {
+		validation.report(path+".operator", RuleDiagnosticCodeIncompatibleOperatorConst,
+			"the operator %q does not suit the %s property %q; use one of %s", operator, propertyType, property, quoteAll(operators))
+	}
+}
+
+// findRuleProperty returns the property of the catalog that a property path refers to. The
+// array indexes of the path, such as "rules[0].port" or "rules.0.port", are ignored.
+func findRuleProperty(properties []RuleProperty, property string) *RuleProperty {
+	normalized := propertyIndexPattern.ReplaceAllStringFunc(property, func(index string) string {
+		if strings.HasSuffix(index, ".") {
+			return "."
+		}
+		return ""
+	})
+	for i := range properties {
+		name := core.StringNilMapper(properties[i].Name)
+		if name == property || name == normalized {
+			return &properties[i]
+		}
+	}
+	return nil
+}
+
+// problemMessage returns the message of an SDK problem without its prefixes.
+func problemMessage(err error) string {
+	if problem, ok := err.(*core.SDKProblem); ok {
+		return problem.Summary
+	}
+	return err.Error()
+}
+
+func quoteAll(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// LoadRuleValidator : Load a rule validator
+// Retrieve the service catalog and return a RuleValidator that checks rules against it.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) LoadRuleValidator(listServicesOptions *ListServicesOptions) (result *RuleValidator, err error) {
+	result, err = securityAndComplianceCenterApi.LoadRuleValidatorWithContext(context.Background(), listServicesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// LoadRuleValidatorWithContext is an alternate form of the LoadRuleValidator method which supports a Context parameter
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) LoadRuleValidatorWithContext(ctx context.Context, listServicesOptions *ListServicesOptions) (result *RuleValidator, err error) {
+	if listServicesOptions == nil {
+		listServicesOptions = securityAndComplianceCenterApi.NewListServicesOptions()
+	}
+	services, _, err := securityAndComplianceCenterApi.ListServicesWithContext(ctx, listServicesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-services-error")
+		return
+	}
+	result = NewRuleValidator(services.Services...)
+	return
+}
@@ -0,0 +1,270 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RuleValidator`, func() {
+	services := []securityandcompliancecenterapiv3.Service{
+		{
+			ServiceName: core.StringPtr("cloud-object-storage"),
+			SupportedConfigs: []securityandcompliancecenterapiv3.SupportedConfigs{
+				{
+					ResourceKind: core.StringPtr("bucket"),
+					AdditionalTargetAttributes: []securityandcompliancecenterapiv3.AdditionalTargetAttribute{
+						{Name: core.StringPtr("location")},
+					},
+					Properties: []securityandcompliancecenterapiv3.RuleProperty{
+						{Name: core.StringPtr("storage_class"), Type: core.StringPtr("string")},
+						{Name: core.StringPtr("versioning.enabled"), Type: core.StringPtr("boolean")},
+						{Name: core.StringPtr("retention.days"), Type: core.StringPtr("numeric")},
+						{Name: core.StringPtr("firewall.allowed_ip"), Type: core.StringPtr("ip_list")},
+						{Name: core.StringPtr("lifecycle.rules.prefix"), Type: core.StringPtr("string")},
+						{Name: core.StringPtr("metadata"), Type: core.StringPtr("general")},
+					},
+				},
+			},
+		},
+		{
+			ServiceName: core.StringPtr("iam-identity"),
+			SupportedConfigs: []securityandcompliancecenterapiv3.SupportedConfigs{
+				{
+					ResourceKind: core.StringPtr("serviceid"),
+					Properties: []securityandcompliancecenterapiv3.RuleProperty{
+						{Name: core.StringPtr("api_keys.created_at"), Type: core.StringPtr("timestamp")},
+						{Name: core.StringPtr("tags"), Type: core.StringPtr("string_list")},
+					},
+				},
+			},
+		},
+	}
+	validator := securityandcompliancecenterapiv3.NewRuleValidator(services...)
+
+	base := func(property string, operator string, value interface{}) *securityandcompliancecenterapiv3.RequiredConfigConditionBase {
+		return &securityandcompliancecenterapiv3.RequiredConfigConditionBase{
+			Property: core.StringPtr(property),
+			Operator: core.StringPtr(operator),
+			Value:    value,
+		}
+	}
+	item := func(property string, operator string, value interface{}) *securityandcompliancecenterapiv3.ConditionItemConditionBase {
+		return &securityandcompliancecenterapiv3.ConditionItemConditionBase{
+			Property: core.StringPtr(property),
+			Operator: core.StringPtr(operator),
+			Value:    value,
+		}
+	}
+	bucketTarget := func() *securityandcompliancecenterapiv3.RuleTargetPrototype {
+		return &securityandcompliancecenterapiv3.RuleTargetPrototype{
+			ServiceName:  core.StringPtr("cloud-object-storage"),
+			ResourceKind: core.StringPtr("bucket"),
+		}
+	}
+	messages := func(diagnostics []securityandcompliancecenterapiv3.RuleDiagnostic) []string {
+		var result []string
+		for _, diagnostic := range diagnostics {
+			result = append(result, diagnostic.String())
+		}
+		return result
+	}
+
+	It(`Invoke ValidateCreateRuleOptions with a valid rule`, func() {
+		options := &securityandcompliancecenterapiv3.CreateRuleOptions{
+			Target: &securityandcompliancecenterapiv3.RuleTargetPrototype{
+				ServiceName:  core.StringPtr("cloud-object-storage"),
+				ResourceKind: core.StringPtr("bucket"),
+				AdditionalTargetAttributes: []securityandcompliancecenterapiv3.AdditionalTargetAttribute{
+					{Name: core.StringPtr("location"), Operator: core.StringPtr("string_equals"), Value: core.StringPtr("us-south")},
+				},
+			},
+			RequiredConfig: &securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionAnd{
+				And: []securityandcompliancecenterapiv3.ConditionItemIntf{
+					item("storage_class", "strings_in_list", []string{"smart", "standard"}),
+					item("versioning.enabled", "is_true", nil),
+					item("retention.days", "num_greater_than_equals", 30),
+					item("firewall.allowed_ip", "ips_in_range", []string{"10.0.0.0/8"}),
+					item("lifecycle.rules[0].prefix", "is_not_empty", nil),
+					item("lifecycle.rules.1.prefix", "string_equals", "logs/"),
+					item("metadata", "days_less_than", 90),
+				},
+			},
+		}
+		Expect(validator.ValidateCreateRuleOptions(options)).To(BeEmpty())
+	})
+	It(`Invoke ValidateCreateRuleOptions with an unknown service`, func() {
+		options := &securityandcompliancecenterapiv3.CreateRuleOptions{
+			Target: &securityandcompliancecenterapiv3.RuleTargetPrototype{
+				ServiceName:  core.StringPtr("cloud-object-store"),
+				ResourceKind: core.StringPtr("bucket"),
+			},
+			RequiredConfig: base("no_such_property", "is_true", nil),
+		}
+		diagnostics := validator.ValidateCreateRuleOptions(options)
+		Expect(diagnostics).To(Equal([]securityandcompliancecenterapiv3.RuleDiagnostic{{
+			Path:    "target.service_name",
+			Code:    securityandcompliancecenterapiv3.RuleDiagnosticCodeUnknownServiceConst,
+			Message: `the service "cloud-object-store" is not in the catalog`,
+		}}))
+	})
+	It(`Invoke ValidateCreateRuleOptions with an unsupported resource kind and target attribute`, func() {
+		target := bucketTarget()
+		target.ResourceKind = core.StringPtr("instance")
+		diagnostics := validator.ValidateCreateRuleOptions(&securityandcompliancecenterapiv3.CreateRuleOptions{
+			Target:         target,
+			RequiredConfig: base("storage_class", "string_equals", "smart"),
+		})
+		Expect(messages(diagnostics)).To(Equal([]string{
+			`target.resource_kind: the service "cloud-object-storage" does not support the resource kind "instance"; the supported kinds are "bucket"`,
+		}))
+
+		target = bucketTarget()
+		target.AdditionalTargetAttributes = []securityandcompliancecenterapiv3.AdditionalTargetAttribute{
+			{Name: core.StringPtr("location")},
+			{Name: core.StringPtr("region")},
+		}
+		diagnostics = validator.ValidateCreateRuleOptions(&securityandcompliancecenterapiv3.CreateRuleOptions{
+			Target:         target,
+			RequiredConfig: base("storage_class", "string_equals", "smart"),
+		})
+		Expect(diagnostics).To(HaveLen(1))
+		Expect(diagnostics[0].Path).To(Equal("target.additional_target_attributes[1].name"))
+		Expect(diagnostics[0].Code).To(Equal(securityandcompliancecenterapiv3.RuleDiagnosticCodeUnknownTargetAttributeConst))
+	})
+	It(`Invoke ValidateCreateRuleOptions with positioned property and operator problems`, func() {
+		options := &securityandcompliancecenterapiv3.CreateRuleOptions{
+			Target: bucketTarget(),
+			RequiredConfig: &securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionOr{
+				Or: []securityandcompliancecenterapiv3.ConditionItemIntf{
+					item("storage_class", "string_equals", "smart"),
+					&securityandcompliancecenterapiv3.ConditionItemConditionListConditionListConditionAnd{
+						And: []securityandcompliancecenterapiv3.ConditionItemIntf{
+							item("versioning.enabled", "num_equals", 1),
+							item("encryption", "is_true", nil),
+							item("retention.days", "num_around", 30),
+						},
+					},
+				},
+			},
+		}
+		diagnostics := validator.ValidateCreateRuleOptions(options)
+		Expect(messages(diagnostics)).To(Equal([]string{
+			`required_config.or[1].and[0].operator: the operator "num_equals" does not suit the boolean property "versioning.enabled"; use one of "is_true", "is_false"`,
+			`required_config.or[1].and[1].property: the resource kind "bucket" has no property "encryption"; the known properties are "firewall.allowed_ip", "lifecycle.rules.prefix", "metadata", "retention.days", "storage_class", "versioning.enabled"`,
+			`required_config.or[1].and[2].operator: the operator "num_around" is not supported`,
+		}))
+		Expect(diagnostics[0].Code).To(Equal(securityandcompliancecenterapiv3.RuleDiagnosticCodeIncompatibleOperatorConst))
+		Expect(diagnostics[1].Code).To(Equal(securityandcompliancecenterapiv3.RuleDiagnosticCodeUnknownPropertyConst))
+		Expect(diagnostics[2].Code).To(Equal(securityandcompliancecenterapiv3.RuleDiagnosticCodeUnknownOperatorConst))
+	})
+	It(`Invoke ValidateRule with a sub-rule of another service`, func() {
+		rule := &securityandcompliancecenterapiv3.Rule{
+			Target: &securityandcompliancecenterapiv3.RuleTarget{
+				ServiceName:  core.StringPtr("cloud-object-storage"),
+				ResourceKind: core.StringPtr("bucket"),
+			},
+			RequiredConfig: &securityandcompliancecenterapiv3.RequiredConfigConditionSubRuleConditionSubRuleConditionAll{
+				All: &securityandcompliancecenterapiv3.SubRule{
+					Target: &securityandcompliancecenterapiv3.RuleTarget{
+						ServiceName:  core.StringPtr("iam-identity"),
+						ResourceKind: core.StringPtr("serviceid"),
+					},
+					RequiredConfig: &securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionAnd{
+						And: []securityandcompliancecenterapiv3.ConditionItemIntf{
+							item("api_keys.created_at", "days_less_than", 90),
+							item("tags", "strings_required", []string{"owner"}),
+							item("storage_class", "string_equals", "smart"),
+						},
+					},
+				},
+			},
+		}
+		Expect(messages(validator.ValidateRule(rule))).To(Equal([]string{
+			`required_config.all.required_config.and[2].property: the resource kind "serviceid" has no property "storage_class"; the known properties are "api_keys.created_at", "tags"`,
+		}))
+	})
+	It(`Invoke ValidateReplaceRuleOptions with an invalid required config`, func() {
+		options := &securityandcompliancecenterapiv3.ReplaceRuleOptions{
+			Target: bucketTarget(),
+			RequiredConfig: &securityandcompliancecenterapiv3.RequiredConfigConditionListConditionListConditionAnd{
+				And: []securityandcompliancecenterapiv3.ConditionItemIntf{
+					item("storage_class", "string_equals", "smart"),
+					&securityandcompliancecenterapiv3.ConditionItemConditionBase{},
+				},
+			},
+		}
+		diagnostics := validator.ValidateReplaceRuleOptions(options)
+		Expect(diagnostics).To(HaveLen(1))
+		Expect(diagnostics[0].Path).To(Equal("required_config.and[1]"))
+		Expect(diagnostics[0].Code).To(Equal(securityandcompliancecenterapiv3.RuleDiagnosticCodeInvalidRequiredConfigConst))
+
+		Expect(messages(validator.ValidateReplaceRuleOptions(&securityandcompliancecenterapiv3.ReplaceRuleOptions{}))).To(Equal([]string{
+			"target: the target is required",
+			"required_config: the required config is required",
+		}))
+	})
+	It(`Invoke LoadRuleValidator successfully`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/v3/services"))
+			Expect(req.Method).To(Equal("GET"))
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			Expect(json.NewEncoder(res).Encode(map[string]interface{}{"services": services})).To(Succeed())
+		}))
+		defer testServer.Close()
+
+		securityAndComplianceCenterAPIService, err := securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		loaded, err := securityAndComplianceCenterAPIService.LoadRuleValidator(nil)
+		Expect(err).To(BeNil())
+		Expect(loaded).ToNot(BeNil())
+		Expect(loaded.ValidateCreateRuleOptions(&securityandcompliancecenterapiv3.CreateRuleOptions{
+			Target:         bucketTarget(),
+			RequiredConfig: base("tags", "strings_required", []string{"owner"}),
+		})).To(HaveLen(1))
+	})
+	It(`Invoke LoadRuleValidator with an error`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.WriteHeader(500)
+		}))
+		defer testServer.Close()
+
+		securityAndComplianceCenterAPIService, err := securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		loaded, err := securityAndComplianceCenterAPIService.LoadRuleValidator(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(loaded).To(BeNil())
+	})
+})
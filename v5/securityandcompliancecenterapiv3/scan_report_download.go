@@ -0,0 +1,359 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// scanReportContentTypes lists the media types accepted for each format of the
+// DownloadScanReportOptions. The first media type of each format is the one requested from the
+// service.
+var scanReportContentTypes = map[string][]string{
+	DownloadScanReportOptionsFormatCSVConst: {"application/csv", "text/csv"},
+	DownloadScanReportOptionsFormatPDFConst: {"application/pdf"},
+}
+
+// ScanReportExportFailedError is returned (wrapped in an SDK problem) by DownloadScanReport when
+// the service reports that the scan report export failed. Use errors.As to retrieve it.
+type ScanReportExportFailedError struct {
+	// The ID of the report that was exported.
+	ReportID string
+
+	// The ID of the scan report (the export job).
+	JobID string
+
+	// The final status of the scan report.
+	Status string
+}
+
+// Error implements the error interface.
+func (e *ScanReportExportFailedError) Error() string {
+	return fmt.Sprintf("export %s of report %s ended with status '%s'", e.JobID, e.ReportID, e.Status)
+}
+
+// ScanReportDownload describes a scan report that DownloadScanReport saved to disk.
+type ScanReportDownload struct {
+	// The completed scan report.
+	ScanReport *ScanReport
+
+	// The path of the downloaded file.
+	Path string
+
+	// The content type of the downloaded file, as reported by the service.
+	ContentType string
+
+	// The size of the downloaded file in bytes.
+	Size int64
+}
+
+// DownloadScanReportOptions : The DownloadScanReport options.
+type DownloadScanReportOptions struct {
+	// The ID of the Security and Compliance Center instance.
+	InstanceID *string `json:"instance_id" validate:"required,ne="`
+
+	// The ID of the scan that is associated with a report.
+	ReportID *string `json:"report_id" validate:"required,ne="`
+
+	// The enum of different report format types.
+	Format *string `json:"format" validate:"required"`
+
+	// The ID of the scope.
+	ScopeID *string `json:"scope_id,omitempty"`
+
+	// The ID of the sub-scope.
+	SubscopeID *string `json:"subscope_id,omitempty"`
+
+	// The path of the file to write the scan report to. The file is replaced atomically once the
+	// download has completed, so a partial download never ends up at this path.
+	Path *string `json:"path" validate:"required,ne="`
+
+	// The time to wait between the first two polls. Defaults to DefaultScanPollInterval.
+	PollInterval time.Duration
+
+	// The upper bound of the time between two polls. Defaults to DefaultScanMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// The factor by which the time between two polls grows after each poll. Defaults to
+	// DefaultScanBackoffMultiplier; use 1 for a fixed poll interval.
+	BackoffMultiplier float64
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// Constants associated with the DownloadScanReportOptions.Format property.
+// The enum of different report format types.
+const (
+	DownloadScanReportOptionsFormatCSVConst = "csv"
+	DownloadScanReportOptionsFormatPDFConst = "pdf"
+)
+
+// NewDownloadScanReportOptions : Instantiate DownloadScanReportOptions
+func (*SecurityAndComplianceCenterAPIV3) NewDownloadScanReportOptions(instanceID string, reportID string, format string, path string) *DownloadScanReportOptions {
+	return &DownloadScanReportOptions{
+		InstanceID: core.StringPtr(instanceID),
+		ReportID:   core.StringPtr(reportID),
+		Format:     core.StringPtr(format),
+		Path:       core.StringPtr(path),
+	}
+}
+
+// SetInstanceID : Allow user to set InstanceID
+func (_options *DownloadScanReportOptions) SetInstanceID(instanceID string) *DownloadScanReportOptions {
+	_options.InstanceID = core.StringPtr(instanceID)
+	return _options
+}
+
+// SetReportID : Allow user to set ReportID
+func (_options *DownloadScanReportOptions) SetReportID(reportID string) *DownloadScanReportOptions {
+	_options.ReportID = core.StringPtr(reportID)
+	return _options
+}
+
+// SetFormat : Allow user to set Format
+func (_options *DownloadScanReportOptions) SetFormat(format string) *DownloadScanReportOptions {
+	_options.Format = core.StringPtr(format)
+	return _options
+}
+
+// SetScopeID : Allow user to set ScopeID
+func (_options *DownloadScanReportOptions) SetScopeID(scopeID string) *DownloadScanReportOptions {
+	_options.ScopeID = core.StringPtr(scopeID)
+	return _options
+}
+
+// SetSubscopeID : Allow user to set SubscopeID
+func (_options *DownloadScanReportOptions) SetSubscopeID(subscopeID string) *DownloadScanReportOptions {
+	_options.SubscopeID = core.StringPtr(subscopeID)
+	return _options
+}
+
+// SetPath : Allow user to set Path
+func (_options *DownloadScanReportOptions) SetPath(path string) *DownloadScanReportOptions {
+	_options.Path = core.StringPtr(path)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *DownloadScanReportOptions) SetPollInterval(pollInterval time.Duration) *DownloadScanReportOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *DownloadScanReportOptions) SetMaxPollInterval(maxPollInterval time.Duration) *DownloadScanReportOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetBackoffMultiplier : Allow user to set BackoffMultiplier
+func (_options *DownloadScanReportOptions) SetBackoffMultiplier(backoffMultiplier float64) *DownloadScanReportOptions {
+	_options.BackoffMultiplier = backoffMultiplier
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DownloadScanReportOptions) SetHeaders(param map[string]string) *DownloadScanReportOptions {
+	options.Headers = param
+	return options
+}
+
+// DownloadScanReport : Export a report and download it to a file
+// Create a scan report in the requested format, wait until the service has completed it and
+// stream the resulting file to disk. There is no overall timeout; use
+// DownloadScanReportWithContext with a deadline to bound the wait.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DownloadScanReport(downloadScanReportOptions *DownloadScanReportOptions) (result *ScanReportDownload, err error) {
+	result, err = securityAndComplianceCenterApi.DownloadScanReportWithContext(context.Background(), downloadScanReportOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DownloadScanReportWithContext is an alternate form of the DownloadScanReport method which supports a Context parameter
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DownloadScanReportWithContext(ctx context.Context, downloadScanReportOptions *DownloadScanReportOptions) (result *ScanReportDownload, err error) {
+	err = core.ValidateNotNil(downloadScanReportOptions, "downloadScanReportOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(downloadScanReportOptions, "downloadScanReportOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	contentTypes, ok := scanReportContentTypes[*downloadScanReportOptions.Format]
+	if !ok {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported scan report format '%s'", *downloadScanReportOptions.Format), "unsupported-format", common.GetComponentInfo())
+		return
+	}
+
+	createScanReportOptions := &CreateScanReportOptions{
+		InstanceID: downloadScanReportOptions.InstanceID,
+		ReportID:   downloadScanReportOptions.ReportID,
+		Format:     downloadScanReportOptions.Format,
+		ScopeID:    downloadScanReportOptions.ScopeID,
+		SubscopeID: downloadScanReportOptions.SubscopeID,
+		Headers:    downloadScanReportOptions.Headers,
+	}
+	job, _, err := securityAndComplianceCenterApi.CreateScanReportWithContext(ctx, createScanReportOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-scan-report-error")
+		return
+	}
+	if job.ID == nil || *job.ID == "" {
+		err = core.SDKErrorf(nil, "the created scan report has no ID", "missing-job-id", common.GetComponentInfo())
+		return
+	}
+
+	scanReport, err := securityAndComplianceCenterApi.waitForScanReport(ctx, downloadScanReportOptions, *job.ID)
+	if err != nil {
+		return
+	}
+
+	getScanReportDownloadFileOptions := &GetScanReportDownloadFileOptions{
+		InstanceID: downloadScanReportOptions.InstanceID,
+		ReportID:   downloadScanReportOptions.ReportID,
+		JobID:      job.ID,
+		Accept:     core.StringPtr(contentTypes[0]),
+		Headers:    downloadScanReportOptions.Headers,
+	}
+	body, response, err := securityAndComplianceCenterApi.GetScanReportDownloadFileWithContext(ctx, getScanReportDownloadFileOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-scan-report-download-file-error")
+		return
+	}
+	defer body.Close()
+
+	contentType := response.GetHeaders().Get("Content-Type")
+	if !isScanReportContentType(contentType, contentTypes) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("expected a %s scan report but the service returned content type '%s'", *downloadScanReportOptions.Format, contentType), "unexpected-content-type", common.GetComponentInfo())
+		return
+	}
+
+	size, err := writeFileAtomically(*downloadScanReportOptions.Path, body)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "write-file-error", common.GetComponentInfo())
+		return
+	}
+
+	result = &ScanReportDownload{
+		ScanReport:  scanReport,
+		Path:        *downloadScanReportOptions.Path,
+		ContentType: contentType,
+		Size:        size,
+	}
+	return
+}
+
+// waitForScanReport polls GetScanReport until the scan report has been completed.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) waitForScanReport(ctx context.Context, downloadScanReportOptions *DownloadScanReportOptions, jobID string) (scanReport *ScanReport, err error) {
+	getScanReportOptions := &GetScanReportOptions{
+		InstanceID: downloadScanReportOptions.InstanceID,
+		ReportID:   downloadScanReportOptions.ReportID,
+		JobID:      core.StringPtr(jobID),
+		Headers:    downloadScanReportOptions.Headers,
+	}
+	interval := newScanPollInterval(downloadScanReportOptions.PollInterval, downloadScanReportOptions.MaxPollInterval, downloadScanReportOptions.BackoffMultiplier)
+	for {
+		scanReport, _, err = securityAndComplianceCenterApi.GetScanReportWithContext(ctx, getScanReportOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-scan-report-error")
+			return
+		}
+
+		var status string
+		if scanReport.Status != nil {
+			status = *scanReport.Status
+		}
+		switch status {
+		case ScanReportStatusCompletedConst:
+			return
+		case ScanReportStatusErrorConst, ScanReportStatusDeletedConst:
+			err = core.SDKErrorf(&ScanReportExportFailedError{
+				ReportID: *downloadScanReportOptions.ReportID,
+				JobID:    jobID,
+				Status:   status,
+			}, "", "scan-report-export-failed", common.GetComponentInfo())
+			scanReport = nil
+			return
+		}
+
+		err = sleepWithContext(ctx, interval.next())
+		if err != nil {
+			err = core.SDKErrorf(err, "", "context-done", common.GetComponentInfo())
+			scanReport = nil
+			return
+		}
+	}
+}
+
+// isScanReportContentType returns true if the media type of "contentType" is one of "expected".
+func isScanReportContentType(contentType string, expected []string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, e := range expected {
+		if mediaType == e {
+			return true
+		}
+	}
+	return false
+}
+
+// writeFileAtomically streams "r" into a temporary file next to "path" and renames it to
+// "path" once all of the content has been written.
+func writeFileAtomically(path string, r io.Reader) (size int64, err error) {
+	tempFile, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			tempFile.Close()
+			os.Remove(tempFile.Name())
+		}
+	}()
+
+	size, err = io.Copy(tempFile, r)
+	if err != nil {
+		return
+	}
+	err = tempFile.Chmod(0644)
+	if err != nil {
+		return
+	}
+	err = tempFile.Sync()
+	if err != nil {
+		return
+	}
+	err = tempFile.Close()
+	if err != nil {
+		return
+	}
+	err = os.Rename(tempFile.Name(), path)
+	return
+}
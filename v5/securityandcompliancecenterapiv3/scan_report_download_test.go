@@ -0,0 +1,187 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DownloadScanReport`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	const reportID = "30b434b3-cb08-4845-af10-7a8fc682b6a8"
+	const jobID = "8f0b46a2-9ba4-4b23-8d87-0d65e5ef7bd1"
+	const csvContent = "Control ID,Assessment ID,Status\nSC-7,rule-a,pass\nAC-2,rule-b,failure\n"
+	scanReportsPath := "/instances/" + instanceID + "/v3/reports/" + reportID + "/scan_reports"
+
+	var testServer *httptest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var statuses []string
+	var downloadContentType string
+	var createBody map[string]interface{}
+	var downloadAccept string
+	var dir string
+
+	downloadScanReportOptions := func(format string) *securityandcompliancecenterapiv3.DownloadScanReportOptions {
+		return securityAndComplianceCenterAPIService.NewDownloadScanReportOptions(instanceID, reportID, format, filepath.Join(dir, "report."+format)).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(4 * time.Millisecond)
+	}
+
+	BeforeEach(func() {
+		statuses = []string{"pending", "in_progress", "completed"}
+		downloadContentType = "application/csv"
+		createBody = nil
+		downloadAccept = ""
+
+		var err error
+		dir, err = os.MkdirTemp("", "scan-report-download")
+		Expect(err).To(BeNil())
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			switch req.URL.EscapedPath() {
+			case scanReportsPath:
+				Expect(req.Method).To(Equal("POST"))
+				Expect(json.NewDecoder(req.Body).Decode(&createBody)).To(Succeed())
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(202)
+				fmt.Fprintf(res, `{"id":"%s"}`, jobID)
+			case scanReportsPath + "/" + jobID:
+				status := statuses[0]
+				if len(statuses) > 1 {
+					statuses = statuses[1:]
+				}
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id":"%s","status":"%s","format":"csv"}`, jobID, status)
+			case scanReportsPath + "/" + jobID + "/download":
+				downloadAccept = req.Header.Get("Accept")
+				res.Header().Set("Content-type", downloadContentType)
+				res.WriteHeader(200)
+				fmt.Fprint(res, csvContent)
+			default:
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors":[{"message":"not found"}]}`)
+			}
+		}))
+
+		securityAndComplianceCenterAPIService, err = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(dir)
+	})
+
+	It(`Invoke DownloadScanReport successfully`, func() {
+		options := downloadScanReportOptions(securityandcompliancecenterapiv3.DownloadScanReportOptionsFormatCSVConst).SetScopeID("scope-1")
+		result, err := securityAndComplianceCenterAPIService.DownloadScanReport(options)
+		Expect(err).To(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(*result.ScanReport.Status).To(Equal(securityandcompliancecenterapiv3.ScanReportStatusCompletedConst))
+		Expect(result.Path).To(Equal(*options.Path))
+		Expect(result.ContentType).To(Equal("application/csv"))
+		Expect(result.Size).To(Equal(int64(len(csvContent))))
+		Expect(createBody).To(Equal(map[string]interface{}{"format": "csv", "scope_id": "scope-1"}))
+		Expect(downloadAccept).To(Equal("application/csv"))
+
+		data, err := os.ReadFile(*options.Path)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(csvContent))
+
+		entries, err := os.ReadDir(dir)
+		Expect(err).To(BeNil())
+		Expect(entries).To(HaveLen(1))
+	})
+	It(`Invoke DownloadScanReport with a failed export`, func() {
+		statuses = []string{"in_progress", "error"}
+
+		options := downloadScanReportOptions(securityandcompliancecenterapiv3.DownloadScanReportOptionsFormatCSVConst)
+		result, err := securityAndComplianceCenterAPIService.DownloadScanReport(options)
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		var exportErr *securityandcompliancecenterapiv3.ScanReportExportFailedError
+		Expect(errors.As(err, &exportErr)).To(BeTrue())
+		Expect(exportErr.ReportID).To(Equal(reportID))
+		Expect(exportErr.JobID).To(Equal(jobID))
+		Expect(exportErr.Status).To(Equal("error"))
+
+		_, statErr := os.Stat(*options.Path)
+		Expect(os.IsNotExist(statErr)).To(BeTrue())
+	})
+	It(`Invoke DownloadScanReport with an unexpected content type`, func() {
+		downloadContentType = "application/json"
+
+		options := downloadScanReportOptions(securityandcompliancecenterapiv3.DownloadScanReportOptionsFormatPDFConst)
+		result, err := securityAndComplianceCenterAPIService.DownloadScanReport(options)
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("application/json"))
+		Expect(downloadAccept).To(Equal("application/pdf"))
+
+		entries, err := os.ReadDir(dir)
+		Expect(err).To(BeNil())
+		Expect(entries).To(BeEmpty())
+	})
+	It(`Invoke DownloadScanReport keeps an existing file when the download fails`, func() {
+		statuses = []string{"deleted"}
+		options := downloadScanReportOptions(securityandcompliancecenterapiv3.DownloadScanReportOptionsFormatCSVConst)
+		Expect(os.WriteFile(*options.Path, []byte("previous"), 0644)).To(Succeed())
+
+		_, err := securityAndComplianceCenterAPIService.DownloadScanReport(options)
+		Expect(err).ToNot(BeNil())
+		data, err := os.ReadFile(*options.Path)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal("previous"))
+	})
+	It(`Invoke DownloadScanReport with a context deadline`, func() {
+		statuses = []string{"in_progress"}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		result, err := securityAndComplianceCenterAPIService.DownloadScanReportWithContext(ctx, downloadScanReportOptions(securityandcompliancecenterapiv3.DownloadScanReportOptionsFormatCSVConst))
+		Expect(result).To(BeNil())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+	It(`Invoke DownloadScanReport with invalid options`, func() {
+		result, err := securityAndComplianceCenterAPIService.DownloadScanReport(nil)
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+
+		result, err = securityAndComplianceCenterAPIService.DownloadScanReport(downloadScanReportOptions("xlsx"))
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+	})
+})
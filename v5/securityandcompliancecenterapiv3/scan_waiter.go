@@ -0,0 +1,336 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+)
+
+// Default polling values used by WaitForScan and DownloadScanReport when the corresponding
+// option is not set.
+const (
+	DefaultScanPollInterval      = 10 * time.Second
+	DefaultScanMaxPollInterval   = 2 * time.Minute
+	DefaultScanBackoffMultiplier = 2.0
+)
+
+// ScanFailedError is returned (wrapped in an SDK problem) by WaitForScan when the service reports
+// that the scan ended without producing a report. Use errors.As to retrieve it.
+type ScanFailedError struct {
+	// The ID of the scan.
+	ScanID string
+
+	// The ID of the report associated with the scan.
+	ReportID string
+
+	// The final status of the scan, as reported by the profile attachment.
+	Status string
+}
+
+// Error implements the error interface.
+func (e *ScanFailedError) Error() string {
+	return fmt.Sprintf("scan %s ended with status '%s'", e.ScanID, e.Status)
+}
+
+// ScanResult is the outcome of a scan that WaitForScan waited for.
+type ScanResult struct {
+	// The scan that was triggered.
+	Scan *CreateScanResponse
+
+	// The report that was produced by the scan.
+	Report *Report
+
+	// The summary of the report.
+	Summary *ReportSummary
+}
+
+// WaitForScanOptions : The WaitForScan options.
+type WaitForScanOptions struct {
+	// The ID of the Security and Compliance Center instance.
+	InstanceID *string `json:"instance_id" validate:"required,ne="`
+
+	// The ID of the profile that the attachment belongs to. The status of the scan is tracked
+	// through the last scan of the profile attachment.
+	ProfileID *string `json:"profile_id" validate:"required,ne="`
+
+	// The ID of the profile attachment to scan.
+	AttachmentID *string `json:"attachment_id" validate:"required,ne="`
+
+	// The user account ID.
+	AccountID *string `json:"account_id,omitempty"`
+
+	// The time to wait before the first poll. Defaults to DefaultScanPollInterval.
+	PollInterval time.Duration
+
+	// The upper bound of the time between two polls. Defaults to DefaultScanMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// The factor by which the time between two polls grows after each poll. Defaults to
+	// DefaultScanBackoffMultiplier; use 1 for a fixed poll interval.
+	BackoffMultiplier float64
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWaitForScanOptions : Instantiate WaitForScanOptions
+func (*SecurityAndComplianceCenterAPIV3) NewWaitForScanOptions(instanceID string, profileID string, attachmentID string) *WaitForScanOptions {
+	return &WaitForScanOptions{
+		InstanceID:   core.StringPtr(instanceID),
+		ProfileID:    core.StringPtr(profileID),
+		AttachmentID: core.StringPtr(attachmentID),
+	}
+}
+
+// SetInstanceID : Allow user to set InstanceID
+func (_options *WaitForScanOptions) SetInstanceID(instanceID string) *WaitForScanOptions {
+	_options.InstanceID = core.StringPtr(instanceID)
+	return _options
+}
+
+// SetProfileID : Allow user to set ProfileID
+func (_options *WaitForScanOptions) SetProfileID(profileID string) *WaitForScanOptions {
+	_options.ProfileID = core.StringPtr(profileID)
+	return _options
+}
+
+// SetAttachmentID : Allow user to set AttachmentID
+func (_options *WaitForScanOptions) SetAttachmentID(attachmentID string) *WaitForScanOptions {
+	_options.AttachmentID = core.StringPtr(attachmentID)
+	return _options
+}
+
+// SetAccountID : Allow user to set AccountID
+func (_options *WaitForScanOptions) SetAccountID(accountID string) *WaitForScanOptions {
+	_options.AccountID = core.StringPtr(accountID)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *WaitForScanOptions) SetPollInterval(pollInterval time.Duration) *WaitForScanOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *WaitForScanOptions) SetMaxPollInterval(maxPollInterval time.Duration) *WaitForScanOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetBackoffMultiplier : Allow user to set BackoffMultiplier
+func (_options *WaitForScanOptions) SetBackoffMultiplier(backoffMultiplier float64) *WaitForScanOptions {
+	_options.BackoffMultiplier = backoffMultiplier
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForScanOptions) SetHeaders(param map[string]string) *WaitForScanOptions {
+	options.Headers = param
+	return options
+}
+
+// WaitForScan : Create a scan and wait for its report
+// Create an on-demand scan of a profile attachment and block until the report of the scan is
+// available, polling with exponential backoff. There is no overall timeout; use
+// WaitForScanWithContext with a deadline to bound the wait.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) WaitForScan(waitForScanOptions *WaitForScanOptions) (result *ScanResult, err error) {
+	result, err = securityAndComplianceCenterApi.WaitForScanWithContext(context.Background(), waitForScanOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForScanWithContext is an alternate form of the WaitForScan method which supports a Context parameter
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) WaitForScanWithContext(ctx context.Context, waitForScanOptions *WaitForScanOptions) (result *ScanResult, err error) {
+	err = core.ValidateNotNil(waitForScanOptions, "waitForScanOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(waitForScanOptions, "waitForScanOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	createScanOptions := &CreateScanOptions{
+		InstanceID:   waitForScanOptions.InstanceID,
+		AttachmentID: waitForScanOptions.AttachmentID,
+		AccountID:    waitForScanOptions.AccountID,
+		Headers:      waitForScanOptions.Headers,
+	}
+	scan, _, err := securityAndComplianceCenterApi.CreateScanWithContext(ctx, createScanOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-scan-error")
+		return
+	}
+	if scan.ReportID == nil || *scan.ReportID == "" {
+		err = core.SDKErrorf(nil, "the created scan is not associated with a report", "missing-report-id", common.GetComponentInfo())
+		return
+	}
+
+	interval := newScanPollInterval(waitForScanOptions.PollInterval, waitForScanOptions.MaxPollInterval, waitForScanOptions.BackoffMultiplier)
+	for {
+		err = sleepWithContext(ctx, interval.next())
+		if err != nil {
+			err = core.SDKErrorf(err, "", "context-done", common.GetComponentInfo())
+			return
+		}
+
+		var done bool
+		result, done, err = securityAndComplianceCenterApi.pollScan(ctx, waitForScanOptions, scan)
+		if err != nil || done {
+			return
+		}
+	}
+}
+
+// pollScan checks once whether the scan has completed. It returns done=false if the
+// caller should keep polling.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) pollScan(ctx context.Context, waitForScanOptions *WaitForScanOptions, scan *CreateScanResponse) (result *ScanResult, done bool, err error) {
+	getProfileAttachmentOptions := &GetProfileAttachmentOptions{
+		InstanceID:   waitForScanOptions.InstanceID,
+		ProfileID:    waitForScanOptions.ProfileID,
+		AttachmentID: waitForScanOptions.AttachmentID,
+		AccountID:    waitForScanOptions.AccountID,
+		Headers:      waitForScanOptions.Headers,
+	}
+	attachment, _, err := securityAndComplianceCenterApi.GetProfileAttachmentWithContext(ctx, getProfileAttachmentOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-profile-attachment-error")
+		return
+	}
+
+	// The last scan of the attachment may still be a previous one until the service picks up
+	// the scan that was just created.
+	lastScan := attachment.LastScan
+	if lastScan == nil || lastScan.ID == nil || scan.ID == nil || *lastScan.ID != *scan.ID || lastScan.Status == nil {
+		return
+	}
+	switch *lastScan.Status {
+	case ScanReportStatusPendingConst, ScanReportStatusInProgressConst:
+		return
+	case ScanReportStatusErrorConst, ScanReportStatusDeletedConst:
+		err = core.SDKErrorf(&ScanFailedError{
+			ScanID:   *scan.ID,
+			ReportID: *scan.ReportID,
+			Status:   *lastScan.Status,
+		}, "", "scan-failed", common.GetComponentInfo())
+		return
+	case ScanReportStatusCompletedConst:
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("scan %s has the unexpected status '%s'", *scan.ID, *lastScan.Status), "unexpected-scan-status", common.GetComponentInfo())
+		return
+	}
+
+	getReportOptions := &GetReportOptions{
+		ReportID:   scan.ReportID,
+		InstanceID: waitForScanOptions.InstanceID,
+		Headers:    waitForScanOptions.Headers,
+	}
+	report, response, err := securityAndComplianceCenterApi.GetReportWithContext(ctx, getReportOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			err = nil
+			return
+		}
+		err = core.RepurposeSDKProblem(err, "get-report-error")
+		return
+	}
+
+	getReportSummaryOptions := &GetReportSummaryOptions{
+		InstanceID: waitForScanOptions.InstanceID,
+		ReportID:   scan.ReportID,
+		Headers:    waitForScanOptions.Headers,
+	}
+	summary, response, err := securityAndComplianceCenterApi.GetReportSummaryWithContext(ctx, getReportSummaryOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			err = nil
+			return
+		}
+		err = core.RepurposeSDKProblem(err, "get-report-summary-error")
+		return
+	}
+
+	result = &ScanResult{
+		Scan:    scan,
+		Report:  report,
+		Summary: summary,
+	}
+	done = true
+	return
+}
+
+// scanPollInterval computes the time between two polls using exponential backoff.
+type scanPollInterval struct {
+	current    time.Duration
+	max        time.Duration
+	multiplier float64
+}
+
+// newScanPollInterval creates a scanPollInterval, replacing unset values with the defaults.
+func newScanPollInterval(pollInterval time.Duration, maxPollInterval time.Duration, backoffMultiplier float64) *scanPollInterval {
+	interval := &scanPollInterval{
+		current:    pollInterval,
+		max:        maxPollInterval,
+		multiplier: backoffMultiplier,
+	}
+	if interval.current <= 0 {
+		interval.current = DefaultScanPollInterval
+	}
+	if interval.max <= 0 {
+		interval.max = DefaultScanMaxPollInterval
+	}
+	if interval.max < interval.current {
+		interval.max = interval.current
+	}
+	if interval.multiplier < 1 {
+		interval.multiplier = DefaultScanBackoffMultiplier
+	}
+	return interval
+}
+
+// next returns the time to wait before the next poll and grows the interval for the one after.
+func (interval *scanPollInterval) next() time.Duration {
+	wait := interval.current
+	grown := time.Duration(float64(interval.current) * interval.multiplier)
+	if grown > interval.max || grown < interval.current {
+		grown = interval.max
+	}
+	interval.current = grown
+	return wait
+}
+
+// sleepWithContext waits for the specified duration, returning early with the context's error
+// if the context is done first.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
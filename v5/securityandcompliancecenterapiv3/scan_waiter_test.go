@@ -0,0 +1,185 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WaitForScan`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	const profileID = "9c265b4a-4cdf-47f1-acd3-17b5808f7f91"
+	const attachmentID = "130003ea8bfa43c5aacea07a86da3000"
+	const reportID = "30b434b3-cb08-4845-af10-7a8fc682b6a8"
+	const scanID = "e8a39d25-0051-4328-8462-988ad321f49a"
+
+	var testServer *httptest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var attachmentStatuses []string
+	var reportNotFoundCount int
+	var requests []string
+
+	waitForScanOptions := func() *securityandcompliancecenterapiv3.WaitForScanOptions {
+		return securityAndComplianceCenterAPIService.NewWaitForScanOptions(instanceID, profileID, attachmentID).
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(4 * time.Millisecond)
+	}
+
+	BeforeEach(func() {
+		attachmentStatuses = nil
+		reportNotFoundCount = 0
+		requests = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			requests = append(requests, req.Method+" "+req.URL.EscapedPath())
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/instances/" + instanceID + "/v3/scans":
+				Expect(req.Method).To(Equal("POST"))
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id":"%s","attachment_id":"%s","report_id":"%s","status":"in_progress"}`, scanID, attachmentID, reportID)
+			case "/instances/" + instanceID + "/v3/profiles/" + profileID + "/attachments/" + attachmentID:
+				status := attachmentStatuses[0]
+				if len(attachmentStatuses) > 1 {
+					attachmentStatuses = attachmentStatuses[1:]
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id":"%s","last_scan":{"id":"%s","status":"%s"}}`, attachmentID, scanID, status)
+			case "/instances/" + instanceID + "/v3/reports/" + reportID:
+				if reportNotFoundCount > 0 {
+					reportNotFoundCount--
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors":[{"code":"not_found","message":"report not found"}]}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id":"%s","instance_id":"%s"}`, reportID, instanceID)
+			case "/instances/" + instanceID + "/v3/reports/" + reportID + "/summary":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"report_id":"%s","score":{"passed":9,"total_count":10,"percent":90}}`, reportID)
+			default:
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"errors":[{"message":"unexpected request"}]}`)
+			}
+		}))
+
+		var serviceErr error
+		securityAndComplianceCenterAPIService, serviceErr = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke WaitForScan tracking the status of the profile attachment`, func() {
+		attachmentStatuses = []string{"pending", "in_progress", "completed"}
+
+		result, err := securityAndComplianceCenterAPIService.WaitForScan(waitForScanOptions())
+		Expect(err).To(BeNil())
+		Expect(result).ToNot(BeNil())
+		Expect(*result.Scan.ID).To(Equal(scanID))
+		Expect(*result.Report.ID).To(Equal(reportID))
+		Expect(*result.Summary.Score.Percent).To(Equal(int64(90)))
+		Expect(requests).To(Equal([]string{
+			"POST /instances/" + instanceID + "/v3/scans",
+			"GET /instances/" + instanceID + "/v3/profiles/" + profileID + "/attachments/" + attachmentID,
+			"GET /instances/" + instanceID + "/v3/profiles/" + profileID + "/attachments/" + attachmentID,
+			"GET /instances/" + instanceID + "/v3/profiles/" + profileID + "/attachments/" + attachmentID,
+			"GET /instances/" + instanceID + "/v3/reports/" + reportID,
+			"GET /instances/" + instanceID + "/v3/reports/" + reportID + "/summary",
+		}))
+	})
+	It(`Invoke WaitForScan until the report of a completed scan is available`, func() {
+		attachmentStatuses = []string{"completed"}
+		reportNotFoundCount = 2
+
+		result, err := securityAndComplianceCenterAPIService.WaitForScan(waitForScanOptions())
+		Expect(err).To(BeNil())
+		Expect(*result.Report.ID).To(Equal(reportID))
+		Expect(requests).To(HaveLen(8))
+		Expect(requests[7]).To(Equal("GET /instances/" + instanceID + "/v3/reports/" + reportID + "/summary"))
+	})
+	It(`Invoke WaitForScan with a scan with an unexpected status`, func() {
+		attachmentStatuses = []string{"in_progress", "unknown"}
+
+		result, err := securityAndComplianceCenterAPIService.WaitForScan(waitForScanOptions())
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unexpected status 'unknown'"))
+	})
+	It(`Invoke WaitForScan with a failed scan`, func() {
+		attachmentStatuses = []string{"in_progress", "error"}
+
+		result, err := securityAndComplianceCenterAPIService.WaitForScan(waitForScanOptions())
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		var scanFailedErr *securityandcompliancecenterapiv3.ScanFailedError
+		Expect(errors.As(err, &scanFailedErr)).To(BeTrue())
+		Expect(scanFailedErr.ScanID).To(Equal(scanID))
+		Expect(scanFailedErr.ReportID).To(Equal(reportID))
+		Expect(scanFailedErr.Status).To(Equal("error"))
+	})
+	It(`Invoke WaitForScan with a context deadline`, func() {
+		attachmentStatuses = []string{"in_progress"}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		result, err := securityAndComplianceCenterAPIService.WaitForScanWithContext(ctx, waitForScanOptions())
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+	It(`Invoke WaitForScan with a server error`, func() {
+		testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == "POST" {
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"id":"%s","report_id":"%s"}`, scanID, reportID)
+				return
+			}
+			res.WriteHeader(500)
+			fmt.Fprint(res, `{"errors":[{"message":"boom"}]}`)
+		})
+
+		result, err := securityAndComplianceCenterAPIService.WaitForScan(waitForScanOptions())
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke WaitForScan without the required parameters`, func() {
+		result, err := securityAndComplianceCenterAPIService.WaitForScan(nil)
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+
+		result, err = securityAndComplianceCenterAPIService.WaitForScan(&securityandcompliancecenterapiv3.WaitForScanOptions{InstanceID: core.StringPtr(instanceID), AttachmentID: core.StringPtr(attachmentID)})
+		Expect(result).To(BeNil())
+		Expect(err).ToNot(BeNil())
+	})
+})
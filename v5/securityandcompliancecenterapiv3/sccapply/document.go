@@ -0,0 +1,188 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sccapply reconciles the custom rules, control libraries, profiles, scopes and
+// attachments of a Security and Compliance Center instance with a declarative document.
+//
+// The document describes every resource with the property names of the API, as in
+// the following example:
+//
+//	rules:
+//	  - description: Object Storage buckets use the smart storage class
+//	    target:
+//	      service_name: cloud-object-storage
+//	      resource_kind: bucket
+//	    required_config:
+//	      property: storage_class
+//	      operator: string_equals
+//	      value: smart
+//	control_libraries:
+//	  - control_library_name: Storage controls
+//	    control_library_description: Controls of the storage services
+//	    control_library_version: 1.0.0
+//	    controls:
+//	      - control_name: STORAGE-1
+//	        control_description: Buckets use the smart storage class
+//	        control_category: Storage
+//	        control_requirement: true
+//	        control_specifications:
+//	          - component_id: cloud-object-storage
+//	            environment: ibm-cloud
+//	            control_specification_description: Buckets use the smart storage class
+//	            assessments:
+//	              - rule: Object Storage buckets use the smart storage class
+//	profiles:
+//	  - profile_name: Storage profile
+//	    profile_version: 1.0.0
+//	    controls:
+//	      - control_library: Storage controls
+//	        control_name: STORAGE-1
+//	scopes:
+//	  - name: Production
+//	    environment: ibm-cloud
+//	    properties:
+//	      - name: scope_id
+//	        value: 130003ea8bfa43c5aacea07a86da3000
+//	      - name: scope_type
+//	        value: account
+//	attachments:
+//	  - profile: Storage profile
+//	    name: Production storage
+//	    scope:
+//	      - scope: Production
+//
+// Resources are identified by their name: the description (or the ID) of a rule,
+// the control_library_name of a control library, the profile_name of a profile, the name of a
+// scope, and the profile and name of an attachment. References between resources use
+// the same names instead of IDs:
+//
+//   - the "rule" of an assessment of a control library is replaced with the assessment_id
+//     of the rule,
+//   - the "control_library" and "control_name" of a control of a profile are replaced with
+//     the control_library_id and control_id of the control,
+//   - the "profile" of an attachment selects the profile that the attachment belongs to, and
+//   - the "scope" of an entry of the scope of an attachment is replaced with the id of the scope.
+//
+// A Reconciler compares the document with the resources of the instance, computes a Plan of
+// the changes and applies it in dependency order: rules, control libraries, profiles, scopes
+// and then attachments.
+package sccapply
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	"gopkg.in/yaml.v3"
+)
+
+// Document is the desired state of the resources of an instance.
+//
+// Each resource is a JSON-compatible object with the property names of the API.
+// A nil list leaves the resources of that kind unmanaged, while an empty list manages them
+// and, when pruning is enabled, deletes all of them.
+type Document struct {
+	Rules            []map[string]interface{} `yaml:"rules" json:"rules,omitempty"`
+	ControlLibraries []map[string]interface{} `yaml:"control_libraries" json:"control_libraries,omitempty"`
+	Profiles         []map[string]interface{} `yaml:"profiles" json:"profiles,omitempty"`
+	Scopes           []map[string]interface{} `yaml:"scopes" json:"scopes,omitempty"`
+	Attachments      []map[string]interface{} `yaml:"attachments" json:"attachments,omitempty"`
+}
+
+// LoadDocument reads a YAML (or JSON) document from the reader and validates it.
+func LoadDocument(reader io.Reader) (document *Document, err error) {
+	decoder := yaml.NewDecoder(reader)
+	decoder.KnownFields(true)
+
+	document = &Document{}
+	err = decoder.Decode(document)
+	if err != nil && !errors.Is(err, io.EOF) {
+		err = core.SDKErrorf(err, "", "document-decode-error", common.GetComponentInfo())
+		return nil, err
+	}
+
+	for _, k := range kinds {
+		entries := k.entries(document)
+		for i, entry := range entries {
+			entries[i], err = normalizeObject(entry)
+			if err != nil {
+				err = core.SDKErrorf(err, fmt.Sprintf("%s %d is not a valid JSON object: %s", k.kind, i, err.Error()), "document-decode-error", common.GetComponentInfo())
+				return nil, err
+			}
+		}
+	}
+
+	err = document.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// LoadDocumentFile reads and validates the YAML (or JSON) document stored in the file.
+func LoadDocumentFile(path string) (document *Document, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "document-open-error", common.GetComponentInfo())
+		return nil, err
+	}
+	defer file.Close()
+	return LoadDocument(file)
+}
+
+// Validate checks that every resource of the document has a name and that no two resources of
+// the same kind have the same name.
+func (document *Document) Validate() error {
+	for _, k := range kinds {
+		keys := make(map[string]bool)
+		for i, entry := range k.entries(document) {
+			key, err := k.desiredKey(entry)
+			if err != nil {
+				return core.SDKErrorf(nil, fmt.Sprintf("%s %d: %s", k.kind, i, err.Error()), "invalid-document", common.GetComponentInfo())
+			}
+			if keys[key] {
+				return core.SDKErrorf(nil, fmt.Sprintf("%s %q is declared more than once", k.kind, key), "invalid-document", common.GetComponentInfo())
+			}
+			keys[key] = true
+		}
+	}
+	return nil
+}
+
+// normalizeObject converts the object to the representation produced by encoding/json,
+// where every number is a float64.
+func normalizeObject(object map[string]interface{}) (result map[string]interface{}, err error) {
+	if object == nil {
+		return nil, fmt.Errorf("the resource is empty")
+	}
+	err = convertJSON(object, &result)
+	return
+}
+
+// convertJSON converts the value to the result through its JSON encoding.
+func convertJSON(value interface{}, result interface{}) error {
+	buffer, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buffer))
+	return decoder.Decode(result)
+}
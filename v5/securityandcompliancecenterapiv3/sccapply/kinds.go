@@ -0,0 +1,574 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sccapply
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+)
+
+// kind describes how a Reconciler reads, creates, updates and deletes one kind of resource.
+// The functions must not refer to the kinds variable, which would create an initialization cycle.
+type kind struct {
+	kind Kind
+
+	// entries returns the resources of the kind declared by the document.
+	entries func(document *Document) []map[string]interface{}
+
+	// desiredKey returns the name of a resource of the document.
+	desiredKey func(desired map[string]interface{}) (string, error)
+
+	// compared lists the properties that are compared and updated, or nil for all of them.
+	compared []string
+
+	// writeOnly lists the properties that the service accepts but doesn't return, which are
+	// not compared. The elements of an array are denoted by "[]", as in "controls[].status".
+	writeOnly []string
+
+	// list returns the resources of the kind that exist in the instance.
+	list func(s *session) ([]*resource, error)
+
+	// get returns the properties and the ETag of a resource.
+	get func(s *session, res *resource) (data map[string]interface{}, etag string, err error)
+
+	// resolve replaces the references of a resource of the document with IDs.
+	resolve func(s *session, desired map[string]interface{}) (map[string]interface{}, error)
+
+	create func(s *session, desired map[string]interface{}, resolved map[string]interface{}) (*resource, error)
+	update func(s *session, res *resource, resolved map[string]interface{}) error
+	delete func(s *session, res *resource) error
+}
+
+// kinds lists the kinds of resources in dependency order.
+var kinds = []*kind{ruleKind, controlLibraryKind, profileKind, scopeKind, attachmentKind}
+
+var ruleKind = &kind{
+	kind:       KindRule,
+	entries:    func(document *Document) []map[string]interface{} { return document.Rules },
+	desiredKey: requiredString("description"),
+	list: func(s *session) (resources []*resource, err error) {
+		options := &scc.ListRulesOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			Type:       core.StringPtr("user_defined"),
+		}
+		for rule, err := range s.service().AllRules(s.ctx, options) {
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, &resource{
+				id:      core.StringNilMapper(rule.ID),
+				key:     core.StringNilMapper(rule.Description),
+				managed: core.StringNilMapper(rule.Type) == "user_defined",
+			})
+		}
+		return
+	},
+	get: func(s *session, res *resource) (map[string]interface{}, string, error) {
+		rule, response, err := s.service().GetRuleWithContext(s.ctx, &scc.GetRuleOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			RuleID:     &res.id,
+		})
+		return toObject(rule, response, err)
+	},
+	resolve: func(s *session, desired map[string]interface{}) (map[string]interface{}, error) {
+		resolved := copyObject(desired)
+		delete(resolved, "id")
+		return resolved, nil
+	},
+	create: func(s *session, desired map[string]interface{}, resolved map[string]interface{}) (*resource, error) {
+		options := &scc.CreateRuleOptions{
+			InstanceID:  &s.reconciler.InstanceID,
+			Description: stringProperty(resolved, "description"),
+			Version:     stringProperty(resolved, "version"),
+		}
+		err := firstError(
+			convertJSON(resolved["labels"], &options.Labels),
+			toModel(resolved["target"], &options.Target, scc.UnmarshalRuleTargetPrototype),
+			toModel(resolved["required_config"], &options.RequiredConfig, scc.UnmarshalRequiredConfig),
+			toModel(resolved["import"], &options.Import, scc.UnmarshalImport),
+		)
+		if err != nil {
+			return nil, err
+		}
+		rule, _, err := s.service().CreateRuleWithContext(s.ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		return &resource{id: core.StringNilMapper(rule.ID)}, nil
+	},
+	update: func(s *session, res *resource, resolved map[string]interface{}) error {
+		merged := mergeObjects(res.data, resolved)
+		options := &scc.ReplaceRuleOptions{
+			InstanceID:  &s.reconciler.InstanceID,
+			RuleID:      &res.id,
+			IfMatch:     core.StringPtr(res.ifMatch()),
+			Description: stringProperty(merged, "description"),
+			Version:     stringProperty(merged, "version"),
+		}
+		err := firstError(
+			convertJSON(merged["labels"], &options.Labels),
+			toModel(merged["target"], &options.Target, scc.UnmarshalRuleTargetPrototype),
+			toModel(merged["required_config"], &options.RequiredConfig, scc.UnmarshalRequiredConfig),
+			toModel(merged["import"], &options.Import, scc.UnmarshalImport),
+		)
+		if err != nil {
+			return err
+		}
+		_, _, err = s.service().ReplaceRuleWithContext(s.ctx, options)
+		return err
+	},
+	delete: func(s *session, res *resource) error {
+		_, err := s.service().DeleteRuleWithContext(s.ctx, &scc.DeleteRuleOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			RuleID:     &res.id,
+			Headers:    res.ifMatchHeaders(),
+		})
+		return err
+	},
+}
+
+var controlLibraryKind = &kind{
+	kind:       KindControlLibrary,
+	entries:    func(document *Document) []map[string]interface{} { return document.ControlLibraries },
+	desiredKey: requiredString("control_library_name"),
+	writeOnly:  []string{"controls[].control_requirement"},
+	list: func(s *session) (resources []*resource, err error) {
+		options := &scc.ListControlLibrariesOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			AccountID:  s.reconciler.accountID(),
+		}
+		for library, err := range s.service().AllControlLibraries(s.ctx, options) {
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, &resource{
+				id:      core.StringNilMapper(library.ID),
+				key:     core.StringNilMapper(library.ControlLibraryName),
+				managed: core.StringNilMapper(library.ControlLibraryType) == "custom",
+			})
+		}
+		return
+	},
+	get: func(s *session, res *resource) (map[string]interface{}, string, error) {
+		library, response, err := s.service().GetControlLibraryWithContext(s.ctx, &scc.GetControlLibraryOptions{
+			InstanceID:       &s.reconciler.InstanceID,
+			ControlLibraryID: &res.id,
+			AccountID:        s.reconciler.accountID(),
+		})
+		data, etag, err := toObject(library, response, err)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The service returns the control specifications with other property names than
+		// the ones used to create them.
+		for _, control := range objects(data["controls"]) {
+			for _, specification := range objects(control["control_specifications"]) {
+				renameProperty(specification, "id", "control_specification_id")
+				renameProperty(specification, "description", "control_specification_description")
+			}
+		}
+		return data, etag, nil
+	},
+	resolve: func(s *session, desired map[string]interface{}) (map[string]interface{}, error) {
+		resolved := copyObject(desired)
+		delete(resolved, "id")
+		for i, control := range objects(resolved["controls"]) {
+			for j, specification := range objects(control["control_specifications"]) {
+				for k, assessment := range objects(specification["assessments"]) {
+					rule, ok := assessment["rule"]
+					if !ok {
+						continue
+					}
+					id, err := s.reference(KindRule, rule)
+					if err != nil {
+						return nil, fmt.Errorf("controls[%d].control_specifications[%d].assessments[%d].rule: %s", i, j, k, err.Error())
+					}
+					assessment["assessment_id"] = id
+					delete(assessment, "rule")
+				}
+			}
+		}
+		return resolved, nil
+	},
+	create: func(s *session, desired map[string]interface{}, resolved map[string]interface{}) (*resource, error) {
+		options := &scc.CreateControlLibraryOptions{
+			InstanceID:                &s.reconciler.InstanceID,
+			ControlLibraryName:        stringProperty(resolved, "control_library_name"),
+			ControlLibraryDescription: stringProperty(resolved, "control_library_description"),
+			ControlLibraryType:        core.StringPtr("custom"),
+			ControlLibraryVersion:     stringProperty(resolved, "control_library_version"),
+			AccountID:                 s.reconciler.accountID(),
+		}
+		err := toModel(resolved["controls"], &options.Controls, scc.UnmarshalControlPrototype)
+		if err != nil {
+			return nil, err
+		}
+		library, _, err := s.service().CreateControlLibraryWithContext(s.ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		return &resource{id: core.StringNilMapper(library.ID)}, nil
+	},
+	update: func(s *session, res *resource, resolved map[string]interface{}) error {
+		merged := mergeObjects(res.data, resolved)
+		options := &scc.ReplaceCustomControlLibraryOptions{
+			InstanceID:                &s.reconciler.InstanceID,
+			ControlLibraryID:          &res.id,
+			ControlLibraryName:        stringProperty(merged, "control_library_name"),
+			ControlLibraryDescription: stringProperty(merged, "control_library_description"),
+			ControlLibraryType:        core.StringPtr("custom"),
+			ControlLibraryVersion:     stringProperty(merged, "control_library_version"),
+			Headers:                   res.ifMatchHeaders(),
+		}
+		err := toModel(merged["controls"], &options.Controls, scc.UnmarshalControlPrototype)
+		if err != nil {
+			return err
+		}
+		_, _, err = s.service().ReplaceCustomControlLibraryWithContext(s.ctx, options)
+		return err
+	},
+	delete: func(s *session, res *resource) error {
+		_, _, err := s.service().DeleteCustomControlLibraryWithContext(s.ctx, &scc.DeleteCustomControlLibraryOptions{
+			InstanceID:       &s.reconciler.InstanceID,
+			ControlLibraryID: &res.id,
+			AccountID:        s.reconciler.accountID(),
+			Headers:          res.ifMatchHeaders(),
+		})
+		return err
+	},
+}
+
+var profileKind = &kind{
+	kind:       KindProfile,
+	entries:    func(document *Document) []map[string]interface{} { return document.Profiles },
+	desiredKey: requiredString("profile_name"),
+	list: func(s *session) (resources []*resource, err error) {
+		options := &scc.ListProfilesOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			AccountID:  s.reconciler.accountID(),
+		}
+		for profile, err := range s.service().AllProfiles(s.ctx, options) {
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, &resource{
+				id:      core.StringNilMapper(profile.ID),
+				key:     core.StringNilMapper(profile.ProfileName),
+				managed: core.StringNilMapper(profile.ProfileType) == "custom",
+			})
+		}
+		return
+	},
+	get: func(s *session, res *resource) (map[string]interface{}, string, error) {
+		profile, response, err := s.service().GetProfileWithContext(s.ctx, &scc.GetProfileOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			ProfileID:  &res.id,
+			AccountID:  s.reconciler.accountID(),
+		})
+		return toObject(profile, response, err)
+	},
+	resolve: func(s *session, desired map[string]interface{}) (map[string]interface{}, error) {
+		resolved := copyObject(desired)
+		delete(resolved, "id")
+		for i, control := range objects(resolved["controls"]) {
+			library, ok := control["control_library"]
+			if !ok {
+				continue
+			}
+			libraryID, controlID, err := s.controlReference(library, control["control_name"])
+			if err != nil {
+				return nil, fmt.Errorf("controls[%d]: %s", i, err.Error())
+			}
+			control["control_library_id"] = libraryID
+			control["control_id"] = controlID
+			delete(control, "control_library")
+			delete(control, "control_name")
+		}
+		return resolved, nil
+	},
+	create: func(s *session, desired map[string]interface{}, resolved map[string]interface{}) (*resource, error) {
+		options := &scc.CreateProfileOptions{
+			InstanceID:         &s.reconciler.InstanceID,
+			ProfileName:        stringProperty(resolved, "profile_name"),
+			ProfileDescription: stringProperty(resolved, "profile_description"),
+			ProfileVersion:     stringProperty(resolved, "profile_version"),
+			DefaultParameters:  []scc.DefaultParameters{},
+			AccountID:          s.reconciler.accountID(),
+		}
+		err := firstError(
+			toModel(resolved["controls"], &options.Controls, scc.UnmarshalProfileControlsPrototype),
+			toModel(resolved["default_parameters"], &options.DefaultParameters, scc.UnmarshalDefaultParameters),
+		)
+		if err != nil {
+			return nil, err
+		}
+		profile, _, err := s.service().CreateProfileWithContext(s.ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		return &resource{id: core.StringNilMapper(profile.ID)}, nil
+	},
+	update: func(s *session, res *resource, resolved map[string]interface{}) error {
+		merged := mergeObjects(res.data, resolved)
+		options := &scc.ReplaceProfileOptions{
+			InstanceID:            &s.reconciler.InstanceID,
+			ProfileID:             &res.id,
+			NewProfileType:        core.StringPtr("custom"),
+			NewProfileName:        stringProperty(merged, "profile_name"),
+			NewProfileDescription: stringProperty(merged, "profile_description"),
+			NewProfileVersion:     stringProperty(merged, "profile_version"),
+			NewDefaultParameters:  []scc.DefaultParameters{},
+			AccountID:             s.reconciler.accountID(),
+			Headers:               res.ifMatchHeaders(),
+		}
+		err := firstError(
+			toModel(merged["controls"], &options.NewControls, scc.UnmarshalProfileControls),
+			toModel(merged["default_parameters"], &options.NewDefaultParameters, scc.UnmarshalDefaultParameters),
+		)
+		if err != nil {
+			return err
+		}
+		_, _, err = s.service().ReplaceProfileWithContext(s.ctx, options)
+		return err
+	},
+	delete: func(s *session, res *resource) error {
+		_, _, err := s.service().DeleteCustomProfileWithContext(s.ctx, &scc.DeleteCustomProfileOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			ProfileID:  &res.id,
+			AccountID:  s.reconciler.accountID(),
+			Headers:    res.ifMatchHeaders(),
+		})
+		return err
+	},
+}
+
+var scopeKind = &kind{
+	kind:       KindScope,
+	entries:    func(document *Document) []map[string]interface{} { return document.Scopes },
+	desiredKey: requiredString("name"),
+	// The environment and the properties of a scope can't be updated.
+	compared: []string{"name", "description"},
+	list: func(s *session) (resources []*resource, err error) {
+		options := &scc.ListScopesOptions{
+			InstanceID: &s.reconciler.InstanceID,
+		}
+		for scope, err := range s.service().AllScopes(s.ctx, options) {
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, &resource{
+				id:      core.StringNilMapper(scope.ID),
+				key:     core.StringNilMapper(scope.Name),
+				managed: true,
+			})
+		}
+		return
+	},
+	get: func(s *session, res *resource) (map[string]interface{}, string, error) {
+		scope, response, err := s.service().GetScopeWithContext(s.ctx, &scc.GetScopeOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			ScopeID:    &res.id,
+		})
+		return toObject(scope, response, err)
+	},
+	resolve: func(s *session, desired map[string]interface{}) (map[string]interface{}, error) {
+		resolved := copyObject(desired)
+		delete(resolved, "id")
+		return resolved, nil
+	},
+	create: func(s *session, desired map[string]interface{}, resolved map[string]interface{}) (*resource, error) {
+		options := &scc.CreateScopeOptions{
+			InstanceID:  &s.reconciler.InstanceID,
+			Name:        stringProperty(resolved, "name"),
+			Description: stringProperty(resolved, "description"),
+			Environment: stringProperty(resolved, "environment"),
+		}
+		err := toModel(resolved["properties"], &options.Properties, scc.UnmarshalScopeProperty)
+		if err != nil {
+			return nil, err
+		}
+		scope, _, err := s.service().CreateScopeWithContext(s.ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		return &resource{id: core.StringNilMapper(scope.ID)}, nil
+	},
+	update: func(s *session, res *resource, resolved map[string]interface{}) error {
+		_, _, err := s.service().UpdateScopeWithContext(s.ctx, &scc.UpdateScopeOptions{
+			InstanceID:  &s.reconciler.InstanceID,
+			ScopeID:     &res.id,
+			Name:        stringProperty(resolved, "name"),
+			Description: stringProperty(resolved, "description"),
+			Headers:     res.ifMatchHeaders(),
+		})
+		return err
+	},
+	delete: func(s *session, res *resource) error {
+		_, err := s.service().DeleteScopeWithContext(s.ctx, &scc.DeleteScopeOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			ScopeID:    &res.id,
+			Headers:    res.ifMatchHeaders(),
+		})
+		return err
+	},
+}
+
+var attachmentKind = &kind{
+	kind:    KindAttachment,
+	entries: func(document *Document) []map[string]interface{} { return document.Attachments },
+	desiredKey: func(desired map[string]interface{}) (string, error) {
+		profile, err := requiredString("profile")(desired)
+		if err != nil {
+			return "", err
+		}
+		name, err := requiredString("name")(desired)
+		if err != nil {
+			return "", err
+		}
+		return profile + "/" + name, nil
+	},
+	list: func(s *session) (resources []*resource, err error) {
+		options := &scc.ListInstanceAttachmentsOptions{
+			InstanceID: &s.reconciler.InstanceID,
+			AccountID:  s.reconciler.accountID(),
+		}
+		for attachment, err := range s.service().AllInstanceAttachments(s.ctx, options) {
+			if err != nil {
+				return nil, err
+			}
+			profileID := core.StringNilMapper(attachment.ProfileID)
+			profile := profileID
+			if res := s.find(KindProfile, profileID); res != nil {
+				profile = res.key
+			}
+			resources = append(resources, &resource{
+				id:       core.StringNilMapper(attachment.ID),
+				key:      profile + "/" + core.StringNilMapper(attachment.Name),
+				parentID: profileID,
+				managed:  true,
+			})
+		}
+		return
+	},
+	get: func(s *session, res *resource) (map[string]interface{}, string, error) {
+		attachment, response, err := s.service().GetProfileAttachmentWithContext(s.ctx, &scc.GetProfileAttachmentOptions{
+			InstanceID:   &s.reconciler.InstanceID,
+			ProfileID:    &res.parentID,
+			AttachmentID: &res.id,
+			AccountID:    s.reconciler.accountID(),
+		})
+		return toObject(attachment, response, err)
+	},
+	resolve: func(s *session, desired map[string]interface{}) (map[string]interface{}, error) {
+		resolved := copyObject(desired)
+		delete(resolved, "id")
+		delete(resolved, "profile")
+		for i, scope := range objects(resolved["scope"]) {
+			name, ok := scope["scope"]
+			if !ok {
+				continue
+			}
+			id, err := s.reference(KindScope, name)
+			if err != nil {
+				return nil, fmt.Errorf("scope[%d].scope: %s", i, err.Error())
+			}
+			scope["id"] = id
+			delete(scope, "scope")
+		}
+		return resolved, nil
+	},
+	create: func(s *session, desired map[string]interface{}, resolved map[string]interface{}) (*resource, error) {
+		profileID, err := s.reference(KindProfile, desired["profile"])
+		if err != nil {
+			return nil, err
+		}
+
+		// The service requires the properties that have a default value in the console.
+		attachment := mergeObjects(map[string]interface{}{
+			"attachment_parameters": []interface{}{},
+			"description":           "",
+			"notifications":         map[string]interface{}{"enabled": false},
+			"schedule":              "daily",
+			"status":                "enabled",
+		}, resolved)
+		var base *scc.ProfileAttachmentBase
+		err = toModel(attachment, &base, scc.UnmarshalProfileAttachmentBase)
+		if err != nil {
+			return nil, err
+		}
+		result, _, err := s.service().CreateProfileAttachmentWithContext(s.ctx, &scc.CreateProfileAttachmentOptions{
+			InstanceID:     &s.reconciler.InstanceID,
+			ProfileID:      &profileID,
+			NewAttachments: []scc.ProfileAttachmentBase{*base},
+			AccountID:      s.reconciler.accountID(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Attachments) != 1 {
+			return nil, fmt.Errorf("the service returned %d attachments instead of 1", len(result.Attachments))
+		}
+		return &resource{id: core.StringNilMapper(result.Attachments[0].ID), parentID: profileID}, nil
+	},
+	update: func(s *session, res *resource, resolved map[string]interface{}) error {
+		var base *scc.ProfileAttachmentBase
+		err := toModel(mergeObjects(res.data, resolved), &base, scc.UnmarshalProfileAttachmentBase)
+		if err != nil {
+			return err
+		}
+		_, _, err = s.service().ReplaceProfileAttachmentWithContext(s.ctx, &scc.ReplaceProfileAttachmentOptions{
+			InstanceID:           &s.reconciler.InstanceID,
+			ProfileID:            &res.parentID,
+			AttachmentID:         &res.id,
+			AttachmentParameters: base.AttachmentParameters,
+			Description:          base.Description,
+			Name:                 base.Name,
+			Notifications:        base.Notifications,
+			Schedule:             base.Schedule,
+			Scope:                base.Scope,
+			Status:               base.Status,
+			DataSelectionRange:   base.DataSelectionRange,
+			AccountID:            s.reconciler.accountID(),
+			Headers:              res.ifMatchHeaders(),
+		})
+		return err
+	},
+	delete: func(s *session, res *resource) error {
+		_, _, err := s.service().DeleteProfileAttachmentWithContext(s.ctx, &scc.DeleteProfileAttachmentOptions{
+			InstanceID:   &s.reconciler.InstanceID,
+			ProfileID:    &res.parentID,
+			AttachmentID: &res.id,
+			AccountID:    s.reconciler.accountID(),
+			Headers:      res.ifMatchHeaders(),
+		})
+		return err
+	},
+}
+
+// requiredString returns a function that returns the value of a required string property.
+func requiredString(name string) func(object map[string]interface{}) (string, error) {
+	return func(object map[string]interface{}) (string, error) {
+		value, ok := object[name].(string)
+		if !ok || value == "" {
+			return "", fmt.Errorf("the %q property is required", name)
+		}
+		return value, nil
+	}
+}
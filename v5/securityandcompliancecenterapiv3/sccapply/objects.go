@@ -0,0 +1,162 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sccapply
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// toObject converts the result of an operation to a JSON-compatible object and returns it
+// with the ETag of the response.
+func toObject(model interface{}, response *core.DetailedResponse, err error) (object map[string]interface{}, etag string, _ error) {
+	if err != nil {
+		return nil, "", err
+	}
+	err = convertJSON(model, &object)
+	if err != nil {
+		return nil, "", err
+	}
+	if response != nil {
+		etag = response.GetHeaders().Get("ETag")
+	}
+	return object, etag, nil
+}
+
+// toModel converts a JSON-compatible value to a model, or to a slice of models, with
+// the unmarshaller of the model. A nil value leaves the result unchanged.
+func toModel(value interface{}, result interface{}, unmarshaller core.ModelUnmarshaller) error {
+	if value == nil {
+		return nil
+	}
+	buffer, err := json.Marshal(map[string]interface{}{"value": value})
+	if err != nil {
+		return err
+	}
+	var raw map[string]json.RawMessage
+	err = json.Unmarshal(buffer, &raw)
+	if err != nil {
+		return err
+	}
+	return core.UnmarshalModel(raw, "value", result, unmarshaller)
+}
+
+// copyObject returns a deep copy of a JSON-compatible object.
+func copyObject(object map[string]interface{}) map[string]interface{} {
+	return copyValue(object).(map[string]interface{})
+}
+
+func copyValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for name, element := range value {
+			result[name] = copyValue(element)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, element := range value {
+			result[i] = copyValue(element)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// mergeObjects returns a copy of base with the top-level properties of overlay.
+func mergeObjects(base map[string]interface{}, overlay map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(base)+len(overlay))
+	for name, value := range base {
+		result[name] = value
+	}
+	for name, value := range overlay {
+		result[name] = value
+	}
+	return result
+}
+
+// objects returns the objects of an array, skipping the elements that are not objects.
+// The objects are not copied.
+func objects(value interface{}) (result []map[string]interface{}) {
+	array, _ := value.([]interface{})
+	for _, element := range array {
+		if object, ok := element.(map[string]interface{}); ok {
+			result = append(result, object)
+		}
+	}
+	return
+}
+
+// renameProperty renames a property of the object, unless the new name is already used.
+func renameProperty(object map[string]interface{}, name string, newName string) {
+	value, ok := object[name]
+	if !ok {
+		return
+	}
+	if _, exists := object[newName]; !exists {
+		object[newName] = value
+	}
+	delete(object, name)
+}
+
+// removeProperty removes the property at the path from the object.
+// A name ending with "[]" denotes the elements of an array.
+func removeProperty(object map[string]interface{}, path []string) {
+	name := path[0]
+	if len(path) == 1 {
+		delete(object, name)
+		return
+	}
+	if strings.HasSuffix(name, "[]") {
+		for _, element := range objects(object[strings.TrimSuffix(name, "[]")]) {
+			removeProperty(element, path[1:])
+		}
+	} else if child, ok := object[name].(map[string]interface{}); ok {
+		removeProperty(child, path[1:])
+	}
+}
+
+// stringProperty returns a pointer to the value of a string property, or nil.
+func stringProperty(object map[string]interface{}, name string) *string {
+	if value, ok := object[name].(string); ok {
+		return &value
+	}
+	return nil
+}
+
+func sortedNames(object map[string]interface{}) []string {
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
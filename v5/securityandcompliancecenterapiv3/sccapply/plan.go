@@ -0,0 +1,202 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sccapply
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Kind identifies a kind of resource managed by a Reconciler.
+type Kind string
+
+// The kinds of resources managed by a Reconciler, in the order in which they are created.
+const (
+	KindRule           Kind = "rule"
+	KindControlLibrary Kind = "control_library"
+	KindProfile        Kind = "profile"
+	KindScope          Kind = "scope"
+	KindAttachment     Kind = "attachment"
+)
+
+// Action is the operation performed by a change.
+type Action string
+
+// The actions of a change.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// KnownAfterApply is the desired value of a reference to a resource that does not exist yet.
+const KnownAfterApply = "(known after apply)"
+
+// Plan is the ordered list of changes that reconcile an instance with a document.
+type Plan struct {
+	Changes []*Change
+
+	session *session
+}
+
+// Change is the creation, update or deletion of one resource.
+type Change struct {
+	Kind   Kind
+	Action Action
+
+	// The name of the resource, such as "Storage profile" or "Storage profile/Production storage"
+	// for an attachment.
+	Key string
+
+	// The ID of the resource. It is empty for a creation that has not been applied yet.
+	ID string
+
+	// The differences between the current and the desired properties of the resource.
+	// The current value is nil for a creation and the desired value is nil for a deletion.
+	Diffs []FieldDiff
+
+	// Whether the change was applied.
+	Applied bool
+
+	kind     *kind
+	desired  map[string]interface{}
+	resource *resource
+}
+
+// FieldDiff is the difference between the current and the desired value of a property.
+type FieldDiff struct {
+	// The path of the property, such as "controls[0].control_description".
+	Path string
+
+	Current interface{}
+	Desired interface{}
+}
+
+// Empty returns true if the plan has no changes.
+func (plan *Plan) Empty() bool {
+	return plan == nil || len(plan.Changes) == 0
+}
+
+// Count returns the number of changes of the plan with the given action.
+func (plan *Plan) Count(action Action) (count int) {
+	if plan == nil {
+		return
+	}
+	for _, change := range plan.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return
+}
+
+// String returns a human readable description of the plan, with one line per change followed by
+// its property differences.
+func (plan *Plan) String() string {
+	if plan.Empty() {
+		return "No changes.\n"
+	}
+
+	var builder strings.Builder
+	for _, change := range plan.Changes {
+		builder.WriteString(change.String())
+		builder.WriteString("\n")
+		for _, diff := range change.Diffs {
+			switch change.Action {
+			case ActionCreate:
+				fmt.Fprintf(&builder, "    %s: %s\n", diff.Path, formatValue(diff.Desired))
+			case ActionUpdate:
+				fmt.Fprintf(&builder, "    %s: %s => %s\n", diff.Path, formatValue(diff.Current), formatValue(diff.Desired))
+			}
+		}
+	}
+	fmt.Fprintf(&builder, "Plan: %d to create, %d to update, %d to delete.\n",
+		plan.Count(ActionCreate), plan.Count(ActionUpdate), plan.Count(ActionDelete))
+	return builder.String()
+}
+
+// String returns a one line description of the change, such as `~ profile "Storage profile" (id)`.
+func (change *Change) String() string {
+	symbol := map[Action]string{ActionCreate: "+", ActionUpdate: "~", ActionDelete: "-"}[change.Action]
+	description := fmt.Sprintf("%s %s %q", symbol, change.Kind, change.Key)
+	if change.ID != "" {
+		description += " (" + change.ID + ")"
+	}
+	return description
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "null"
+	}
+	if value == KnownAfterApply {
+		return KnownAfterApply
+	}
+	buffer, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(buffer)
+}
+
+// diff compares the desired value with the current one.
+// Only the properties of the objects of the desired value are compared, and arrays are compared
+// element by element. Both values must be normalized by normalizeObject.
+func diff(path string, current interface{}, desired interface{}) (diffs []FieldDiff) {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		currentObject, ok := current.(map[string]interface{})
+		if !ok && current != nil {
+			return []FieldDiff{{Path: path, Current: current, Desired: desired}}
+		}
+		for _, name := range sortedNames(desired) {
+			diffs = append(diffs, diff(joinPath(path, name), currentObject[name], desired[name])...)
+		}
+		return diffs
+
+	case []interface{}:
+		currentArray, ok := current.([]interface{})
+		if !ok && current == nil && len(desired) == 0 {
+			return nil
+		}
+		if !ok || len(currentArray) != len(desired) {
+			return []FieldDiff{{Path: path, Current: current, Desired: desired}}
+		}
+		for i := range desired {
+			diffs = append(diffs, diff(fmt.Sprintf("%s[%d]", path, i), currentArray[i], desired[i])...)
+		}
+		return diffs
+
+	case nil:
+		return nil
+
+	default:
+		if desired == KnownAfterApply || !reflect.DeepEqual(current, desired) {
+			return []FieldDiff{{Path: path, Current: current, Desired: desired}}
+		}
+		return nil
+	}
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
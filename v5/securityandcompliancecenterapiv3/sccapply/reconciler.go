@@ -0,0 +1,441 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sccapply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+)
+
+// Reconciler reconciles the resources of an instance with a Document.
+type Reconciler struct {
+	// The service used to read and change the resources. Required.
+	Service scc.SecurityAndComplianceCenterAPIV3Interface
+
+	// The ID of the instance. Required.
+	InstanceID string
+
+	// The account ID, sent with the operations that accept one. Optional.
+	AccountID string
+
+	// Whether to delete the custom resources of the instance that are not declared by
+	// the document. Only the kinds of resources that the document declares (with a list that
+	// may be empty) are pruned.
+	Prune bool
+
+	// Whether Apply only computes the plan, without changing the instance.
+	DryRun bool
+}
+
+// NewReconciler returns a Reconciler of the resources of an instance.
+func NewReconciler(service scc.SecurityAndComplianceCenterAPIV3Interface, instanceID string) *Reconciler {
+	return &Reconciler{
+		Service:    service,
+		InstanceID: instanceID,
+	}
+}
+
+// Plan reads the resources of the instance and returns the changes that reconcile them with
+// the document, without applying them.
+//
+// The changes create and update resources in dependency order (rules, control libraries,
+// profiles, scopes and attachments), and then delete resources in the reverse order.
+// An update only lists the properties that the document declares with a different value,
+// compared after resolving the references.
+func (r *Reconciler) Plan(ctx context.Context, document *Document) (plan *Plan, err error) {
+	err = r.validate(document)
+	if err != nil {
+		return
+	}
+
+	s, err := r.newSession(ctx, document)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("unable to read the resources of the instance: %s", err.Error()), "read-state-error", common.GetComponentInfo())
+		return
+	}
+
+	plan = &Plan{session: s}
+	for _, k := range kinds {
+		for _, desired := range k.entries(document) {
+			var change *Change
+			change, err = s.plan(k, desired)
+			if err != nil {
+				err = core.SDKErrorf(err, err.Error(), "plan-error", common.GetComponentInfo())
+				return nil, err
+			}
+			if change != nil {
+				plan.Changes = append(plan.Changes, change)
+			}
+		}
+	}
+
+	if r.Prune {
+		for i := len(kinds) - 1; i >= 0; i-- {
+			k := kinds[i]
+			if k.entries(document) == nil {
+				continue
+			}
+			for _, res := range s.resources[k.kind] {
+				if res.managed && !s.isDesired(k.kind, res) {
+					plan.Changes = append(plan.Changes, &Change{
+						Kind:     k.kind,
+						Action:   ActionDelete,
+						Key:      res.key,
+						ID:       res.id,
+						kind:     k,
+						resource: res,
+					})
+				}
+			}
+		}
+	}
+	return
+}
+
+// Apply computes the plan of the document and, unless DryRun is set, applies it.
+// The plan is returned even when applying it fails, with the Applied field of the changes
+// that were applied set to true.
+func (r *Reconciler) Apply(ctx context.Context, document *Document) (plan *Plan, err error) {
+	plan, err = r.Plan(ctx, document)
+	if err != nil || r.DryRun {
+		return
+	}
+	err = r.ApplyPlan(ctx, plan)
+	return
+}
+
+// ApplyPlan applies the changes of a plan returned by Plan, stopping at the first error.
+// The updates and deletions are conditional on the version of the resources read by Plan,
+// so they fail with the status code 412 if a resource was modified in the meantime.
+func (r *Reconciler) ApplyPlan(ctx context.Context, plan *Plan) error {
+	if plan == nil || plan.session == nil || plan.session.reconciler != r {
+		return core.SDKErrorf(nil, "the plan was not computed by this reconciler", "invalid-plan", common.GetComponentInfo())
+	}
+
+	s := plan.session
+	s.ctx = ctx
+	for _, change := range plan.Changes {
+		if change.Applied {
+			continue
+		}
+		err := s.apply(change)
+		if err != nil {
+			return core.SDKErrorf(err, fmt.Sprintf("unable to %s %s %q: %s", change.Action, change.Kind, change.Key, err.Error()), "apply-error", common.GetComponentInfo())
+		}
+		change.Applied = true
+	}
+	return nil
+}
+
+func (r *Reconciler) validate(document *Document) error {
+	if r.Service == nil || r.InstanceID == "" {
+		return core.SDKErrorf(nil, "the reconciler requires a service and an instance ID", "invalid-reconciler", common.GetComponentInfo())
+	}
+	if document == nil {
+		return core.SDKErrorf(nil, "the document must not be nil", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	return document.Validate()
+}
+
+func (r *Reconciler) accountID() *string {
+	if r.AccountID == "" {
+		return nil
+	}
+	return &r.AccountID
+}
+
+// resource is a resource of the instance.
+type resource struct {
+	kind     *kind
+	id       string
+	key      string
+	parentID string
+
+	// Whether the resource is a custom resource that the reconciler may update or delete.
+	managed bool
+
+	// The properties and the ETag of the resource, read when fetched is true.
+	fetched bool
+	data    map[string]interface{}
+	etag    string
+}
+
+// ifMatch returns the value of the If-Match header that makes a request conditional on
+// the version of the resource.
+func (res *resource) ifMatch() string {
+	if res.etag == "" {
+		return "*"
+	}
+	return res.etag
+}
+
+func (res *resource) ifMatchHeaders() map[string]string {
+	if res.etag == "" {
+		return nil
+	}
+	return map[string]string{"If-Match": res.etag}
+}
+
+// session holds the resources of the instance while a plan is computed and applied.
+type session struct {
+	ctx        context.Context
+	reconciler *Reconciler
+	resources  map[Kind][]*resource
+	desired    map[Kind]map[string]map[string]interface{}
+}
+
+func (r *Reconciler) newSession(ctx context.Context, document *Document) (*session, error) {
+	s := &session{
+		ctx:        ctx,
+		reconciler: r,
+		resources:  make(map[Kind][]*resource),
+		desired:    make(map[Kind]map[string]map[string]interface{}),
+	}
+	for _, k := range kinds {
+		s.desired[k.kind] = make(map[string]map[string]interface{})
+		for _, desired := range k.entries(document) {
+			key, _ := k.desiredKey(desired)
+			s.desired[k.kind][key] = desired
+		}
+
+		resources, err := k.list(s)
+		if err != nil {
+			return nil, err
+		}
+		for _, res := range resources {
+			res.kind = k
+		}
+		s.resources[k.kind] = resources
+	}
+	return s, nil
+}
+
+func (s *session) service() scc.SecurityAndComplianceCenterAPIV3Interface {
+	return s.reconciler.Service
+}
+
+// find returns the resource of the instance with the given name or ID, or nil.
+func (s *session) find(k Kind, key string) *resource {
+	for _, res := range s.resources[k] {
+		if res.key == key || res.id == key {
+			return res
+		}
+	}
+	return nil
+}
+
+// isDesired returns true if the document declares the resource of the instance.
+func (s *session) isDesired(k Kind, res *resource) bool {
+	for key, desired := range s.desired[k] {
+		if id, ok := desired["id"].(string); ok {
+			if id == res.id {
+				return true
+			}
+		} else if key == res.key {
+			return true
+		}
+	}
+	return false
+}
+
+// fetch reads the properties and the ETag of the resource, unless they were already read.
+func (s *session) fetch(res *resource) error {
+	if res.fetched {
+		return nil
+	}
+	data, etag, err := res.kind.get(s, res)
+	if err != nil {
+		return err
+	}
+	res.data, res.etag, res.fetched = data, etag, true
+	return nil
+}
+
+// reference returns the ID of the resource with the given name, or KnownAfterApply if the resource
+// is declared by the document but doesn't exist yet.
+func (s *session) reference(k Kind, name interface{}) (string, error) {
+	key, ok := name.(string)
+	if !ok || key == "" {
+		return "", fmt.Errorf("the reference to a %s must be a non-empty string", k)
+	}
+	if res := s.find(k, key); res != nil {
+		return res.id, nil
+	}
+	if _, ok := s.desired[k][key]; ok {
+		return KnownAfterApply, nil
+	}
+	return "", fmt.Errorf("the %s %q does not exist", k, key)
+}
+
+// controlReference returns the IDs of a control library and of one of its controls.
+func (s *session) controlReference(library interface{}, control interface{}) (libraryID string, controlID string, err error) {
+	libraryID, err = s.reference(KindControlLibrary, library)
+	if err != nil {
+		return
+	}
+	controlName, ok := control.(string)
+	if !ok || controlName == "" {
+		return "", "", fmt.Errorf("the control_name of the control must be a non-empty string")
+	}
+
+	if res := s.find(KindControlLibrary, library.(string)); res != nil {
+		err = s.fetch(res)
+		if err != nil {
+			return "", "", err
+		}
+		for _, existing := range objects(res.data["controls"]) {
+			if existing["control_name"] == controlName {
+				if id, ok := existing["control_id"].(string); ok && id != "" {
+					return libraryID, id, nil
+				}
+			}
+		}
+	}
+
+	// The control doesn't exist yet, but it will if the document declares it.
+	for _, declared := range objects(s.desired[KindControlLibrary][library.(string)]["controls"]) {
+		if declared["control_name"] == controlName {
+			return libraryID, KnownAfterApply, nil
+		}
+	}
+	return "", "", fmt.Errorf("the control %q of the control library %q does not exist", controlName, library)
+}
+
+// plan returns the change that reconciles the resource of the instance with a resource of
+// the document, or nil if they don't differ.
+func (s *session) plan(k *kind, desired map[string]interface{}) (*Change, error) {
+	key, _ := k.desiredKey(desired)
+	res := s.find(k.kind, key)
+	if id, ok := desired["id"].(string); ok {
+		res = s.find(k.kind, id)
+		if res == nil {
+			return nil, fmt.Errorf("the %s %q does not exist", k.kind, id)
+		}
+	}
+
+	resolved, err := k.resolve(s, desired)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q: %s", k.kind, key, err.Error())
+	}
+
+	if res == nil {
+		change := &Change{Kind: k.kind, Action: ActionCreate, Key: key, kind: k, desired: desired}
+		for _, name := range sortedNames(resolved) {
+			change.Diffs = append(change.Diffs, FieldDiff{Path: name, Desired: resolved[name]})
+		}
+		return change, nil
+	}
+
+	if !res.managed {
+		return nil, fmt.Errorf("the %s %q is not a custom resource and can't be changed", k.kind, key)
+	}
+	err = s.fetch(res)
+	if err != nil {
+		return nil, err
+	}
+	if k.compared != nil {
+		compared := make(map[string]interface{})
+		for _, name := range k.compared {
+			if value, ok := resolved[name]; ok {
+				compared[name] = value
+			}
+		}
+		resolved = compared
+	}
+	for _, path := range k.writeOnly {
+		removeProperty(resolved, strings.Split(path, "."))
+	}
+	diffs := diff("", res.data, resolved)
+	if len(diffs) == 0 {
+		return nil, nil
+	}
+	return &Change{Kind: k.kind, Action: ActionUpdate, Key: key, ID: res.id, Diffs: diffs, kind: k, desired: desired, resource: res}, nil
+}
+
+// apply applies the change, resolving the references to the resources created by
+// the previous changes.
+func (s *session) apply(change *Change) (err error) {
+	k := change.kind
+	if change.Action == ActionDelete {
+		err = k.delete(s, change.resource)
+		if err != nil {
+			return
+		}
+		resources := s.resources[k.kind]
+		for i, res := range resources {
+			if res == change.resource {
+				s.resources[k.kind] = append(resources[:i:i], resources[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+
+	resolved, err := k.resolve(s, change.desired)
+	if err != nil {
+		return
+	}
+	if hasUnknownValue(resolved) {
+		return fmt.Errorf("a reference of the %s can't be resolved", k.kind)
+	}
+
+	if change.Action == ActionCreate {
+		var res *resource
+		res, err = k.create(s, change.desired, resolved)
+		if err != nil {
+			return
+		}
+		res.kind, res.key, res.managed = k, change.Key, true
+		s.resources[k.kind] = append(s.resources[k.kind], res)
+		change.ID, change.resource = res.id, res
+		return
+	}
+
+	err = k.update(s, change.resource, resolved)
+	if err != nil {
+		return
+	}
+	// The properties and the ETag of the resource changed.
+	change.resource.fetched = false
+	return
+}
+
+// hasUnknownValue returns true if the value contains KnownAfterApply.
+func hasUnknownValue(value interface{}) bool {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for _, element := range value {
+			if hasUnknownValue(element) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, element := range value {
+			if hasUnknownValue(element) {
+				return true
+			}
+		}
+	case string:
+		return value == KnownAfterApply
+	}
+	return false
+}
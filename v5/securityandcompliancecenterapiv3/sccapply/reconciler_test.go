@@ -0,0 +1,306 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sccapply_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/sccapply"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/scctest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Reconciler`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	var server *scctest.Server
+	var service *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var reconciler *sccapply.Reconciler
+	var document *sccapply.Document
+	ctx := context.Background()
+
+	summary := func(plan *sccapply.Plan) (result []string) {
+		for _, change := range plan.Changes {
+			result = append(result, string(change.Action)+" "+string(change.Kind)+" "+change.Key)
+		}
+		return
+	}
+	paths := func(change *sccapply.Change) (result []string) {
+		for _, diff := range change.Diffs {
+			result = append(result, diff.Path)
+		}
+		return
+	}
+	apply := func() *sccapply.Plan {
+		plan, err := reconciler.Apply(ctx, document)
+		Expect(err).To(BeNil())
+		return plan
+	}
+	expectNoChanges := func() {
+		plan, err := reconciler.Plan(ctx, document)
+		Expect(err).To(BeNil())
+		Expect(plan.Empty()).To(BeTrue(), plan.String())
+	}
+
+	BeforeEach(func() {
+		server = scctest.NewServer()
+		var err error
+		service, err = server.NewService()
+		Expect(err).To(BeNil())
+		reconciler = sccapply.NewReconciler(service, instanceID)
+		document, err = sccapply.LoadDocumentFile("testdata/document.yaml")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Plan the creation of all the resources`, func() {
+		plan, err := reconciler.Plan(ctx, document)
+		Expect(err).To(BeNil())
+		Expect(summary(plan)).To(Equal([]string{
+			"create rule Object Storage buckets use the smart storage class",
+			"create control_library Storage controls",
+			"create profile Storage profile",
+			"create scope Production",
+			"create attachment Storage profile/Production storage",
+		}))
+		Expect(plan.Changes[0].ID).To(BeEmpty())
+		Expect(paths(plan.Changes[4])).To(Equal([]string{"description", "name", "schedule", "scope"}))
+		Expect(plan.Changes[4].Diffs[3].Desired).To(Equal([]interface{}{
+			map[string]interface{}{"id": sccapply.KnownAfterApply},
+		}))
+
+		output := plan.String()
+		Expect(output).To(ContainSubstring(`+ profile "Storage profile"` + "\n"))
+		Expect(output).To(ContainSubstring(`    controls: [{"control_id":"(known after apply)","control_library_id":"(known after apply)"}]` + "\n"))
+		Expect(output).To(HaveSuffix("Plan: 5 to create, 0 to update, 0 to delete.\n"))
+		Expect(server.Len(instanceID, scctest.CollectionRules)).To(Equal(0))
+	})
+	It(`Apply the document and converge`, func() {
+		plan := apply()
+		Expect(plan.Changes).To(HaveLen(5))
+		for _, change := range plan.Changes {
+			Expect(change.Applied).To(BeTrue())
+			Expect(change.ID).ToNot(BeEmpty())
+		}
+		for _, collection := range []scctest.Collection{scctest.CollectionRules, scctest.CollectionControlLibraries, scctest.CollectionProfiles, scctest.CollectionScopes, scctest.CollectionAttachments} {
+			Expect(server.Len(instanceID, collection)).To(Equal(1), string(collection))
+		}
+
+		ruleID, libraryID, profileID, scopeID, attachmentID := plan.Changes[0].ID, plan.Changes[1].ID, plan.Changes[2].ID, plan.Changes[3].ID, plan.Changes[4].ID
+		library, _, err := service.GetControlLibrary(&securityandcompliancecenterapiv3.GetControlLibraryOptions{
+			InstanceID:       core.StringPtr(instanceID),
+			ControlLibraryID: &libraryID,
+		})
+		Expect(err).To(BeNil())
+		Expect(library.Controls[0].ControlSpecifications[0].Assessments[0].AssessmentID).To(Equal(&ruleID))
+
+		profile, _, err := service.GetProfile(&securityandcompliancecenterapiv3.GetProfileOptions{
+			InstanceID: core.StringPtr(instanceID),
+			ProfileID:  &profileID,
+		})
+		Expect(err).To(BeNil())
+		Expect(profile.Controls[0].ControlLibraryID).To(Equal(&libraryID))
+		Expect(profile.Controls[0].ControlID).To(Equal(library.Controls[0].ControlID))
+
+		attachment, _, err := service.GetProfileAttachment(&securityandcompliancecenterapiv3.GetProfileAttachmentOptions{
+			InstanceID:   core.StringPtr(instanceID),
+			ProfileID:    &profileID,
+			AttachmentID: &attachmentID,
+		})
+		Expect(err).To(BeNil())
+		Expect(attachment.Scope[0].(*securityandcompliancecenterapiv3.MultiCloudScopePayload).ID).To(Equal(&scopeID))
+		Expect(*attachment.Status).To(Equal("enabled"))
+		Expect(*attachment.Notifications.Enabled).To(BeFalse())
+
+		expectNoChanges()
+	})
+	It(`Update the resources that differ from the document`, func() {
+		apply()
+
+		document.Rules[0]["labels"] = []interface{}{"storage", "encryption"}
+		document.ControlLibraries[0]["controls"].([]interface{})[0].(map[string]interface{})["control_description"] = "Buckets are encrypted"
+		document.Profiles[0]["profile_description"] = "Storage controls"
+		document.Scopes[0]["description"] = "Production accounts"
+		document.Scopes[0]["environment"] = "ignored"
+		document.Attachments[0]["schedule"] = "every_7_days"
+
+		plan, err := reconciler.Plan(ctx, document)
+		Expect(err).To(BeNil())
+		Expect(summary(plan)).To(Equal([]string{
+			"update rule Object Storage buckets use the smart storage class",
+			"update control_library Storage controls",
+			"update profile Storage profile",
+			"update scope Production",
+			"update attachment Storage profile/Production storage",
+		}))
+		Expect(paths(plan.Changes[0])).To(Equal([]string{"labels"}))
+		Expect(paths(plan.Changes[1])).To(Equal([]string{"controls[0].control_description"}))
+		Expect(plan.Changes[1].Diffs[0]).To(Equal(sccapply.FieldDiff{
+			Path:    "controls[0].control_description",
+			Current: "Buckets use the smart storage class",
+			Desired: "Buckets are encrypted",
+		}))
+		Expect(paths(plan.Changes[3])).To(Equal([]string{"description"}))
+		Expect(plan.String()).To(ContainSubstring(`    schedule: "daily" => "every_7_days"` + "\n"))
+
+		Expect(reconciler.ApplyPlan(ctx, plan)).To(Succeed())
+		expectNoChanges()
+	})
+	It(`Fail to apply a plan when a resource was modified after planning`, func() {
+		apply()
+		document.Rules[0]["version"] = "2.0.0"
+		plan, err := reconciler.Plan(ctx, document)
+		Expect(err).To(BeNil())
+		Expect(plan.Changes).To(HaveLen(1))
+
+		rule, response, err := service.GetRule(service.NewGetRuleOptions(instanceID, plan.Changes[0].ID))
+		Expect(err).To(BeNil())
+		_, _, err = service.ReplaceRule(&securityandcompliancecenterapiv3.ReplaceRuleOptions{
+			InstanceID:     core.StringPtr(instanceID),
+			RuleID:         rule.ID,
+			IfMatch:        core.StringPtr(response.GetHeaders().Get("ETag")),
+			Description:    rule.Description,
+			Target:         &securityandcompliancecenterapiv3.RuleTargetPrototype{ServiceName: rule.Target.ServiceName, ResourceKind: rule.Target.ResourceKind},
+			RequiredConfig: rule.RequiredConfig,
+			Labels:         []string{"modified"},
+		})
+		Expect(err).To(BeNil())
+
+		err = reconciler.ApplyPlan(ctx, plan)
+		Expect(err).ToNot(BeNil())
+		var problem *core.HTTPProblem
+		Expect(errors.As(err, &problem)).To(BeTrue())
+		Expect(problem.Response.StatusCode).To(Equal(412))
+		Expect(errors.Is(err, securityandcompliancecenterapiv3.ErrPreconditionFailed)).To(BeTrue())
+		Expect(plan.Changes[0].Applied).To(BeFalse())
+	})
+	It(`Prune the resources that the document doesn't declare`, func() {
+		apply()
+		_, err := server.Seed(instanceID, scctest.CollectionRules, &securityandcompliancecenterapiv3.Rule{
+			Description: core.StringPtr("System rule"),
+			Type:        core.StringPtr("system_defined"),
+		})
+		Expect(err).To(BeNil())
+
+		document.Attachments = []map[string]interface{}{}
+		document.Scopes = []map[string]interface{}{}
+		document.Rules = nil
+
+		plan, err := reconciler.Plan(ctx, document)
+		Expect(err).To(BeNil())
+		Expect(plan.Empty()).To(BeTrue())
+
+		reconciler.Prune = true
+		plan = apply()
+		Expect(summary(plan)).To(Equal([]string{
+			"delete attachment Storage profile/Production storage",
+			"delete scope Production",
+		}))
+		Expect(plan.String()).To(ContainSubstring(`- scope "Production" (` + plan.Changes[1].ID + ")\n"))
+		Expect(server.Len(instanceID, scctest.CollectionAttachments)).To(Equal(0))
+		Expect(server.Len(instanceID, scctest.CollectionScopes)).To(Equal(0))
+		Expect(server.Len(instanceID, scctest.CollectionProfiles)).To(Equal(1))
+		Expect(server.Len(instanceID, scctest.CollectionRules)).To(Equal(2))
+
+		document.Rules = []map[string]interface{}{}
+		document.ControlLibraries = []map[string]interface{}{}
+		document.Profiles = []map[string]interface{}{}
+		plan = apply()
+		Expect(summary(plan)).To(Equal([]string{
+			"delete profile Storage profile",
+			"delete control_library Storage controls",
+			"delete rule Object Storage buckets use the smart storage class",
+		}))
+		Expect(server.Len(instanceID, scctest.CollectionRules)).To(Equal(1))
+	})
+	It(`Plan without applying in dry-run mode`, func() {
+		reconciler.DryRun = true
+		plan := apply()
+		Expect(plan.Changes).To(HaveLen(5))
+		Expect(plan.Changes[0].Applied).To(BeFalse())
+		Expect(server.Len(instanceID, scctest.CollectionRules)).To(Equal(0))
+	})
+	It(`Reject invalid references`, func() {
+		document.Profiles[0]["controls"] = []interface{}{
+			map[string]interface{}{"control_library": "Storage controls", "control_name": "STORAGE-2"},
+		}
+		_, err := reconciler.Plan(ctx, document)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`profile "Storage profile": controls[0]: the control "STORAGE-2" of the control library "Storage controls" does not exist`))
+
+		document.ControlLibraries = nil
+		_, err = reconciler.Plan(ctx, document)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`the control_library "Storage controls" does not exist`))
+
+		_, err = server.Seed(instanceID, scctest.CollectionProfiles, &securityandcompliancecenterapiv3.Profile{
+			ProfileName: core.StringPtr("Storage profile"),
+			ProfileType: core.StringPtr("predefined"),
+		})
+		Expect(err).To(BeNil())
+		document.Profiles = nil
+		document.Attachments[0]["profile"] = "Storage profile"
+		_, err = reconciler.Plan(ctx, document)
+		Expect(err).To(BeNil())
+
+		document.Profiles = []map[string]interface{}{{"profile_name": "Storage profile", "profile_version": "2.0.0"}}
+		_, err = reconciler.Plan(ctx, document)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`the profile "Storage profile" is not a custom resource`))
+
+		Expect(reconciler.ApplyPlan(ctx, &sccapply.Plan{})).ToNot(Succeed())
+	})
+
+	Describe(`LoadDocument`, func() {
+		It(`Distinguish unmanaged kinds from empty lists`, func() {
+			document, err := sccapply.LoadDocument(strings.NewReader("rules: []\n"))
+			Expect(err).To(BeNil())
+			Expect(document.Rules).ToNot(BeNil())
+			Expect(document.Profiles).To(BeNil())
+
+			document, err = sccapply.LoadDocument(strings.NewReader(""))
+			Expect(err).To(BeNil())
+			Expect(document.Rules).To(BeNil())
+		})
+		It(`Normalize numbers`, func() {
+			document, err := sccapply.LoadDocument(strings.NewReader("rules:\n  - description: rule\n    required_config: {property: size, operator: num_equals, value: 3}\n"))
+			Expect(err).To(BeNil())
+			Expect(document.Rules[0]["required_config"]).To(HaveKeyWithValue("value", float64(3)))
+		})
+		It(`Reject invalid documents`, func() {
+			for input, message := range map[string]string{
+				"rule: []\n": "field rule not found",
+				"profiles:\n  - profile_description: missing\n": `profile 0: the "profile_name" property is required`,
+				"scopes:\n  - name: a\n  - name: a\n":           `scope "a" is declared more than once`,
+				"attachments:\n  - name: a\n":                   `attachment 0: the "profile" property is required`,
+				"rules: {}\n":                                   "cannot unmarshal",
+			} {
+				_, err := sccapply.LoadDocument(strings.NewReader(input))
+				Expect(err).ToNot(BeNil(), input)
+				Expect(err.Error()).To(ContainSubstring(message), input)
+			}
+			_, err := sccapply.LoadDocumentFile("testdata/missing.yaml")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
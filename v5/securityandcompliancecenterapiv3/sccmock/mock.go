@@ -0,0 +1,1602 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by internal/mockgen from client_interface.go. DO NOT EDIT.
+
+package sccmock
+
+import (
+	"context"
+	"io"
+	"iter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/stretchr/testify/mock"
+)
+
+// SecurityAndComplianceCenterAPIV3 is a mock implementation of securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Interface.
+type SecurityAndComplianceCenterAPIV3 struct {
+	mock.Mock
+}
+
+var _ securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Interface = (*SecurityAndComplianceCenterAPIV3)(nil)
+
+// GetSettings : List settings
+func (_m *SecurityAndComplianceCenterAPIV3) GetSettings(getSettingsOptions *securityandcompliancecenterapiv3.GetSettingsOptions) (*securityandcompliancecenterapiv3.Settings, *core.DetailedResponse, error) {
+	ret := _m.Called(getSettingsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetSettingsOptions) (*securityandcompliancecenterapiv3.Settings, *core.DetailedResponse, error)); ok {
+		return fn(getSettingsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Settings](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetSettingsWithContext is an alternate form of the GetSettings method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetSettingsWithContext(ctx context.Context, getSettingsOptions *securityandcompliancecenterapiv3.GetSettingsOptions) (*securityandcompliancecenterapiv3.Settings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getSettingsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetSettingsOptions) (*securityandcompliancecenterapiv3.Settings, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getSettingsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Settings](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateSettings : Update settings
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateSettings(updateSettingsOptions *securityandcompliancecenterapiv3.UpdateSettingsOptions) (*securityandcompliancecenterapiv3.Settings, *core.DetailedResponse, error) {
+	ret := _m.Called(updateSettingsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.UpdateSettingsOptions) (*securityandcompliancecenterapiv3.Settings, *core.DetailedResponse, error)); ok {
+		return fn(updateSettingsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Settings](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateSettingsWithContext is an alternate form of the UpdateSettings method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateSettingsWithContext(ctx context.Context, updateSettingsOptions *securityandcompliancecenterapiv3.UpdateSettingsOptions) (*securityandcompliancecenterapiv3.Settings, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateSettingsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.UpdateSettingsOptions) (*securityandcompliancecenterapiv3.Settings, *core.DetailedResponse, error)); ok {
+		return fn(ctx, updateSettingsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Settings](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// PostTestEvent : Create a test event
+func (_m *SecurityAndComplianceCenterAPIV3) PostTestEvent(postTestEventOptions *securityandcompliancecenterapiv3.PostTestEventOptions) (*securityandcompliancecenterapiv3.TestEvent, *core.DetailedResponse, error) {
+	ret := _m.Called(postTestEventOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.PostTestEventOptions) (*securityandcompliancecenterapiv3.TestEvent, *core.DetailedResponse, error)); ok {
+		return fn(postTestEventOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.TestEvent](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// PostTestEventWithContext is an alternate form of the PostTestEvent method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) PostTestEventWithContext(ctx context.Context, postTestEventOptions *securityandcompliancecenterapiv3.PostTestEventOptions) (*securityandcompliancecenterapiv3.TestEvent, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, postTestEventOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.PostTestEventOptions) (*securityandcompliancecenterapiv3.TestEvent, *core.DetailedResponse, error)); ok {
+		return fn(ctx, postTestEventOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.TestEvent](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListRules : Get all rules
+func (_m *SecurityAndComplianceCenterAPIV3) ListRules(listRulesOptions *securityandcompliancecenterapiv3.ListRulesOptions) (*securityandcompliancecenterapiv3.RuleCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listRulesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListRulesOptions) (*securityandcompliancecenterapiv3.RuleCollection, *core.DetailedResponse, error)); ok {
+		return fn(listRulesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.RuleCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListRulesWithContext is an alternate form of the ListRules method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListRulesWithContext(ctx context.Context, listRulesOptions *securityandcompliancecenterapiv3.ListRulesOptions) (*securityandcompliancecenterapiv3.RuleCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listRulesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListRulesOptions) (*securityandcompliancecenterapiv3.RuleCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listRulesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.RuleCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateRule : Create a custom rule
+func (_m *SecurityAndComplianceCenterAPIV3) CreateRule(createRuleOptions *securityandcompliancecenterapiv3.CreateRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(createRuleOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error)); ok {
+		return fn(createRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Rule](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateRuleWithContext is an alternate form of the CreateRule method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateRuleWithContext(ctx context.Context, createRuleOptions *securityandcompliancecenterapiv3.CreateRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createRuleOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Rule](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetRule : Get a custom rule
+func (_m *SecurityAndComplianceCenterAPIV3) GetRule(getRuleOptions *securityandcompliancecenterapiv3.GetRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(getRuleOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error)); ok {
+		return fn(getRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Rule](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetRuleWithContext is an alternate form of the GetRule method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetRuleWithContext(ctx context.Context, getRuleOptions *securityandcompliancecenterapiv3.GetRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getRuleOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Rule](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceRule : Update a custom rule
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceRule(replaceRuleOptions *securityandcompliancecenterapiv3.ReplaceRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceRuleOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ReplaceRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error)); ok {
+		return fn(replaceRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Rule](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceRuleWithContext is an alternate form of the ReplaceRule method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceRuleWithContext(ctx context.Context, replaceRuleOptions *securityandcompliancecenterapiv3.ReplaceRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceRuleOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ReplaceRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error)); ok {
+		return fn(ctx, replaceRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Rule](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateRule : Update a custom rule with optimistic concurrency
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateRule(updateRuleOptions *securityandcompliancecenterapiv3.UpdateRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(updateRuleOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.UpdateRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error)); ok {
+		return fn(updateRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Rule](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateRuleWithContext is an alternate form of the UpdateRule method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateRuleWithContext(ctx context.Context, updateRuleOptions *securityandcompliancecenterapiv3.UpdateRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateRuleOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.UpdateRuleOptions) (*securityandcompliancecenterapiv3.Rule, *core.DetailedResponse, error)); ok {
+		return fn(ctx, updateRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Rule](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteRule : Delete a custom rule
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteRule(deleteRuleOptions *securityandcompliancecenterapiv3.DeleteRuleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteRuleOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.DeleteRuleOptions) (*core.DetailedResponse, error)); ok {
+		return fn(deleteRuleOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// DeleteRuleWithContext is an alternate form of the DeleteRule method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteRuleWithContext(ctx context.Context, deleteRuleOptions *securityandcompliancecenterapiv3.DeleteRuleOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteRuleOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.DeleteRuleOptions) (*core.DetailedResponse, error)); ok {
+		return fn(ctx, deleteRuleOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// NewRulesPager returns a new RulesPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewRulesPager(options *securityandcompliancecenterapiv3.ListRulesOptions) (*securityandcompliancecenterapiv3.RulesPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListRulesOptions) (*securityandcompliancecenterapiv3.RulesPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.RulesPager](ret, 0), get[error](ret, 1)
+}
+
+// AllRules returns an iterator over all of the results of the "ListRules" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllRules(ctx context.Context, options *securityandcompliancecenterapiv3.ListRulesOptions) iter.Seq2[securityandcompliancecenterapiv3.Rule, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListRulesOptions) iter.Seq2[securityandcompliancecenterapiv3.Rule, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.Rule, error]](ret, 0)
+}
+
+// CreateControlLibrary : Create a custom control library
+func (_m *SecurityAndComplianceCenterAPIV3) CreateControlLibrary(createControlLibraryOptions *securityandcompliancecenterapiv3.CreateControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error) {
+	ret := _m.Called(createControlLibraryOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error)); ok {
+		return fn(createControlLibraryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibrary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateControlLibraryWithContext is an alternate form of the CreateControlLibrary method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateControlLibraryWithContext(ctx context.Context, createControlLibraryOptions *securityandcompliancecenterapiv3.CreateControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createControlLibraryOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createControlLibraryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibrary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListControlLibraries : Get all control libraries
+func (_m *SecurityAndComplianceCenterAPIV3) ListControlLibraries(listControlLibrariesOptions *securityandcompliancecenterapiv3.ListControlLibrariesOptions) (*securityandcompliancecenterapiv3.ControlLibraryCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listControlLibrariesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListControlLibrariesOptions) (*securityandcompliancecenterapiv3.ControlLibraryCollection, *core.DetailedResponse, error)); ok {
+		return fn(listControlLibrariesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibraryCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListControlLibrariesWithContext is an alternate form of the ListControlLibraries method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListControlLibrariesWithContext(ctx context.Context, listControlLibrariesOptions *securityandcompliancecenterapiv3.ListControlLibrariesOptions) (*securityandcompliancecenterapiv3.ControlLibraryCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listControlLibrariesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListControlLibrariesOptions) (*securityandcompliancecenterapiv3.ControlLibraryCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listControlLibrariesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibraryCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceCustomControlLibrary : Update a custom control library
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceCustomControlLibrary(replaceCustomControlLibraryOptions *securityandcompliancecenterapiv3.ReplaceCustomControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceCustomControlLibraryOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ReplaceCustomControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error)); ok {
+		return fn(replaceCustomControlLibraryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibrary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceCustomControlLibraryWithContext is an alternate form of the ReplaceCustomControlLibrary method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceCustomControlLibraryWithContext(ctx context.Context, replaceCustomControlLibraryOptions *securityandcompliancecenterapiv3.ReplaceCustomControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceCustomControlLibraryOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ReplaceCustomControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error)); ok {
+		return fn(ctx, replaceCustomControlLibraryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibrary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetControlLibrary : Get a control library
+func (_m *SecurityAndComplianceCenterAPIV3) GetControlLibrary(getControlLibraryOptions *securityandcompliancecenterapiv3.GetControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error) {
+	ret := _m.Called(getControlLibraryOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error)); ok {
+		return fn(getControlLibraryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibrary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetControlLibraryWithContext is an alternate form of the GetControlLibrary method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetControlLibraryWithContext(ctx context.Context, getControlLibraryOptions *securityandcompliancecenterapiv3.GetControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getControlLibraryOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getControlLibraryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibrary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteCustomControlLibrary : Delete a custom control library
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteCustomControlLibrary(deleteCustomControlLibraryOptions *securityandcompliancecenterapiv3.DeleteCustomControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteCustomControlLibraryOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.DeleteCustomControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error)); ok {
+		return fn(deleteCustomControlLibraryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibrary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteCustomControlLibraryWithContext is an alternate form of the DeleteCustomControlLibrary method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteCustomControlLibraryWithContext(ctx context.Context, deleteCustomControlLibraryOptions *securityandcompliancecenterapiv3.DeleteCustomControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteCustomControlLibraryOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.DeleteCustomControlLibraryOptions) (*securityandcompliancecenterapiv3.ControlLibrary, *core.DetailedResponse, error)); ok {
+		return fn(ctx, deleteCustomControlLibraryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibrary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// NewControlLibrariesPager returns a new ControlLibrariesPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewControlLibrariesPager(options *securityandcompliancecenterapiv3.ListControlLibrariesOptions) (*securityandcompliancecenterapiv3.ControlLibrariesPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListControlLibrariesOptions) (*securityandcompliancecenterapiv3.ControlLibrariesPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.ControlLibrariesPager](ret, 0), get[error](ret, 1)
+}
+
+// AllControlLibraries returns an iterator over all of the results of the "ListControlLibraries" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllControlLibraries(ctx context.Context, options *securityandcompliancecenterapiv3.ListControlLibrariesOptions) iter.Seq2[securityandcompliancecenterapiv3.ControlLibrary, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListControlLibrariesOptions) iter.Seq2[securityandcompliancecenterapiv3.ControlLibrary, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.ControlLibrary, error]](ret, 0)
+}
+
+// CreateProfile : Create a custom profile
+func (_m *SecurityAndComplianceCenterAPIV3) CreateProfile(createProfileOptions *securityandcompliancecenterapiv3.CreateProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error) {
+	ret := _m.Called(createProfileOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error)); ok {
+		return fn(createProfileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Profile](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateProfileWithContext is an alternate form of the CreateProfile method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateProfileWithContext(ctx context.Context, createProfileOptions *securityandcompliancecenterapiv3.CreateProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createProfileOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createProfileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Profile](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListProfiles : Get all profiles
+func (_m *SecurityAndComplianceCenterAPIV3) ListProfiles(listProfilesOptions *securityandcompliancecenterapiv3.ListProfilesOptions) (*securityandcompliancecenterapiv3.ProfileCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listProfilesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListProfilesOptions) (*securityandcompliancecenterapiv3.ProfileCollection, *core.DetailedResponse, error)); ok {
+		return fn(listProfilesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListProfilesWithContext is an alternate form of the ListProfiles method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListProfilesWithContext(ctx context.Context, listProfilesOptions *securityandcompliancecenterapiv3.ListProfilesOptions) (*securityandcompliancecenterapiv3.ProfileCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listProfilesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListProfilesOptions) (*securityandcompliancecenterapiv3.ProfileCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listProfilesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceProfile : Update a custom profile
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceProfile(replaceProfileOptions *securityandcompliancecenterapiv3.ReplaceProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceProfileOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ReplaceProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error)); ok {
+		return fn(replaceProfileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Profile](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceProfileWithContext is an alternate form of the ReplaceProfile method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceProfileWithContext(ctx context.Context, replaceProfileOptions *securityandcompliancecenterapiv3.ReplaceProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceProfileOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ReplaceProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error)); ok {
+		return fn(ctx, replaceProfileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Profile](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetProfile : Get a profile
+func (_m *SecurityAndComplianceCenterAPIV3) GetProfile(getProfileOptions *securityandcompliancecenterapiv3.GetProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error) {
+	ret := _m.Called(getProfileOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error)); ok {
+		return fn(getProfileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Profile](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetProfileWithContext is an alternate form of the GetProfile method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetProfileWithContext(ctx context.Context, getProfileOptions *securityandcompliancecenterapiv3.GetProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getProfileOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getProfileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Profile](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteCustomProfile : Delete a custom profile
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteCustomProfile(deleteCustomProfileOptions *securityandcompliancecenterapiv3.DeleteCustomProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteCustomProfileOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.DeleteCustomProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error)); ok {
+		return fn(deleteCustomProfileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Profile](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteCustomProfileWithContext is an alternate form of the DeleteCustomProfile method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteCustomProfileWithContext(ctx context.Context, deleteCustomProfileOptions *securityandcompliancecenterapiv3.DeleteCustomProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteCustomProfileOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.DeleteCustomProfileOptions) (*securityandcompliancecenterapiv3.Profile, *core.DetailedResponse, error)); ok {
+		return fn(ctx, deleteCustomProfileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Profile](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceProfileParameters : Update custom profile parameters
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceProfileParameters(replaceProfileParametersOptions *securityandcompliancecenterapiv3.ReplaceProfileParametersOptions) (*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceProfileParametersOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ReplaceProfileParametersOptions) (*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse, *core.DetailedResponse, error)); ok {
+		return fn(replaceProfileParametersOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceProfileParametersWithContext is an alternate form of the ReplaceProfileParameters method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceProfileParametersWithContext(ctx context.Context, replaceProfileParametersOptions *securityandcompliancecenterapiv3.ReplaceProfileParametersOptions) (*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceProfileParametersOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ReplaceProfileParametersOptions) (*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse, *core.DetailedResponse, error)); ok {
+		return fn(ctx, replaceProfileParametersOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListProfileParameters : List profile parameters for a given profile
+func (_m *SecurityAndComplianceCenterAPIV3) ListProfileParameters(listProfileParametersOptions *securityandcompliancecenterapiv3.ListProfileParametersOptions) (*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(listProfileParametersOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListProfileParametersOptions) (*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse, *core.DetailedResponse, error)); ok {
+		return fn(listProfileParametersOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListProfileParametersWithContext is an alternate form of the ListProfileParameters method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListProfileParametersWithContext(ctx context.Context, listProfileParametersOptions *securityandcompliancecenterapiv3.ListProfileParametersOptions) (*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listProfileParametersOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListProfileParametersOptions) (*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listProfileParametersOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileDefaultParametersResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CompareProfiles : Compare profiles
+func (_m *SecurityAndComplianceCenterAPIV3) CompareProfiles(compareProfilesOptions *securityandcompliancecenterapiv3.CompareProfilesOptions) (*securityandcompliancecenterapiv3.ComparePredefinedProfilesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(compareProfilesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CompareProfilesOptions) (*securityandcompliancecenterapiv3.ComparePredefinedProfilesResponse, *core.DetailedResponse, error)); ok {
+		return fn(compareProfilesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ComparePredefinedProfilesResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CompareProfilesWithContext is an alternate form of the CompareProfiles method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CompareProfilesWithContext(ctx context.Context, compareProfilesOptions *securityandcompliancecenterapiv3.CompareProfilesOptions) (*securityandcompliancecenterapiv3.ComparePredefinedProfilesResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, compareProfilesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CompareProfilesOptions) (*securityandcompliancecenterapiv3.ComparePredefinedProfilesResponse, *core.DetailedResponse, error)); ok {
+		return fn(ctx, compareProfilesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ComparePredefinedProfilesResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// NewProfilesPager returns a new ProfilesPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewProfilesPager(options *securityandcompliancecenterapiv3.ListProfilesOptions) (*securityandcompliancecenterapiv3.ProfilesPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListProfilesOptions) (*securityandcompliancecenterapiv3.ProfilesPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfilesPager](ret, 0), get[error](ret, 1)
+}
+
+// AllProfiles returns an iterator over all of the results of the "ListProfiles" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllProfiles(ctx context.Context, options *securityandcompliancecenterapiv3.ListProfilesOptions) iter.Seq2[securityandcompliancecenterapiv3.Profile, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListProfilesOptions) iter.Seq2[securityandcompliancecenterapiv3.Profile, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.Profile, error]](ret, 0)
+}
+
+// ListInstanceAttachments : Get all instance attachments
+func (_m *SecurityAndComplianceCenterAPIV3) ListInstanceAttachments(listInstanceAttachmentsOptions *securityandcompliancecenterapiv3.ListInstanceAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listInstanceAttachmentsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListInstanceAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentCollection, *core.DetailedResponse, error)); ok {
+		return fn(listInstanceAttachmentsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachmentCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListInstanceAttachmentsWithContext is an alternate form of the ListInstanceAttachments method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListInstanceAttachmentsWithContext(ctx context.Context, listInstanceAttachmentsOptions *securityandcompliancecenterapiv3.ListInstanceAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listInstanceAttachmentsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListInstanceAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listInstanceAttachmentsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachmentCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateProfileAttachment : Create an attachment
+func (_m *SecurityAndComplianceCenterAPIV3) CreateProfileAttachment(createProfileAttachmentOptions *securityandcompliancecenterapiv3.CreateProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createProfileAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentResponse, *core.DetailedResponse, error)); ok {
+		return fn(createProfileAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachmentResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateProfileAttachmentWithContext is an alternate form of the CreateProfileAttachment method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateProfileAttachmentWithContext(ctx context.Context, createProfileAttachmentOptions *securityandcompliancecenterapiv3.CreateProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createProfileAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentResponse, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createProfileAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachmentResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetProfileAttachment : Get an attachment
+func (_m *SecurityAndComplianceCenterAPIV3) GetProfileAttachment(getProfileAttachmentOptions *securityandcompliancecenterapiv3.GetProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error) {
+	ret := _m.Called(getProfileAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error)); ok {
+		return fn(getProfileAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachment](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetProfileAttachmentWithContext is an alternate form of the GetProfileAttachment method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetProfileAttachmentWithContext(ctx context.Context, getProfileAttachmentOptions *securityandcompliancecenterapiv3.GetProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getProfileAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getProfileAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachment](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceProfileAttachment : Update an attachment
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceProfileAttachment(replaceProfileAttachmentOptions *securityandcompliancecenterapiv3.ReplaceProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceProfileAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ReplaceProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error)); ok {
+		return fn(replaceProfileAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachment](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceProfileAttachmentWithContext is an alternate form of the ReplaceProfileAttachment method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceProfileAttachmentWithContext(ctx context.Context, replaceProfileAttachmentOptions *securityandcompliancecenterapiv3.ReplaceProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceProfileAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ReplaceProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error)); ok {
+		return fn(ctx, replaceProfileAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachment](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteProfileAttachment : Delete an attachment
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteProfileAttachment(deleteProfileAttachmentOptions *securityandcompliancecenterapiv3.DeleteProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error) {
+	ret := _m.Called(deleteProfileAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.DeleteProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error)); ok {
+		return fn(deleteProfileAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachment](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteProfileAttachmentWithContext is an alternate form of the DeleteProfileAttachment method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteProfileAttachmentWithContext(ctx context.Context, deleteProfileAttachmentOptions *securityandcompliancecenterapiv3.DeleteProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteProfileAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.DeleteProfileAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error)); ok {
+		return fn(ctx, deleteProfileAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachment](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpgradeAttachment : Upgrade an attachment
+func (_m *SecurityAndComplianceCenterAPIV3) UpgradeAttachment(upgradeAttachmentOptions *securityandcompliancecenterapiv3.UpgradeAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error) {
+	ret := _m.Called(upgradeAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.UpgradeAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error)); ok {
+		return fn(upgradeAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachment](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpgradeAttachmentWithContext is an alternate form of the UpgradeAttachment method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) UpgradeAttachmentWithContext(ctx context.Context, upgradeAttachmentOptions *securityandcompliancecenterapiv3.UpgradeAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, upgradeAttachmentOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.UpgradeAttachmentOptions) (*securityandcompliancecenterapiv3.ProfileAttachment, *core.DetailedResponse, error)); ok {
+		return fn(ctx, upgradeAttachmentOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachment](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListProfileAttachments : Get all attachments linked to a specific profile
+func (_m *SecurityAndComplianceCenterAPIV3) ListProfileAttachments(listProfileAttachmentsOptions *securityandcompliancecenterapiv3.ListProfileAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listProfileAttachmentsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListProfileAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentCollection, *core.DetailedResponse, error)); ok {
+		return fn(listProfileAttachmentsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachmentCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListProfileAttachmentsWithContext is an alternate form of the ListProfileAttachments method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListProfileAttachmentsWithContext(ctx context.Context, listProfileAttachmentsOptions *securityandcompliancecenterapiv3.ListProfileAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listProfileAttachmentsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListProfileAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listProfileAttachmentsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachmentCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// NewProfileAttachmentsPager returns a new ProfileAttachmentsPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewProfileAttachmentsPager(options *securityandcompliancecenterapiv3.ListProfileAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentsPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListProfileAttachmentsOptions) (*securityandcompliancecenterapiv3.ProfileAttachmentsPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.ProfileAttachmentsPager](ret, 0), get[error](ret, 1)
+}
+
+// AllProfileAttachments returns an iterator over all of the results of the "ListProfileAttachments" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllProfileAttachments(ctx context.Context, options *securityandcompliancecenterapiv3.ListProfileAttachmentsOptions) iter.Seq2[securityandcompliancecenterapiv3.ProfileAttachment, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListProfileAttachmentsOptions) iter.Seq2[securityandcompliancecenterapiv3.ProfileAttachment, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.ProfileAttachment, error]](ret, 0)
+}
+
+// NewInstanceAttachmentsPager returns a new InstanceAttachmentsPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewInstanceAttachmentsPager(options *securityandcompliancecenterapiv3.ListInstanceAttachmentsOptions) (*securityandcompliancecenterapiv3.InstanceAttachmentsPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListInstanceAttachmentsOptions) (*securityandcompliancecenterapiv3.InstanceAttachmentsPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.InstanceAttachmentsPager](ret, 0), get[error](ret, 1)
+}
+
+// AllInstanceAttachments returns an iterator over all of the results of the "ListInstanceAttachments" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllInstanceAttachments(ctx context.Context, options *securityandcompliancecenterapiv3.ListInstanceAttachmentsOptions) iter.Seq2[securityandcompliancecenterapiv3.ProfileAttachment, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListInstanceAttachmentsOptions) iter.Seq2[securityandcompliancecenterapiv3.ProfileAttachment, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.ProfileAttachment, error]](ret, 0)
+}
+
+// CreateScan : Create a scan
+func (_m *SecurityAndComplianceCenterAPIV3) CreateScan(createScanOptions *securityandcompliancecenterapiv3.CreateScanOptions) (*securityandcompliancecenterapiv3.CreateScanResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createScanOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateScanOptions) (*securityandcompliancecenterapiv3.CreateScanResponse, *core.DetailedResponse, error)); ok {
+		return fn(createScanOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.CreateScanResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateScanWithContext is an alternate form of the CreateScan method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateScanWithContext(ctx context.Context, createScanOptions *securityandcompliancecenterapiv3.CreateScanOptions) (*securityandcompliancecenterapiv3.CreateScanResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createScanOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateScanOptions) (*securityandcompliancecenterapiv3.CreateScanResponse, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createScanOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.CreateScanResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// WaitForScan : Create a scan and wait for its report
+func (_m *SecurityAndComplianceCenterAPIV3) WaitForScan(waitForScanOptions *securityandcompliancecenterapiv3.WaitForScanOptions) (*securityandcompliancecenterapiv3.ScanResult, error) {
+	ret := _m.Called(waitForScanOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.WaitForScanOptions) (*securityandcompliancecenterapiv3.ScanResult, error)); ok {
+		return fn(waitForScanOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScanResult](ret, 0), get[error](ret, 1)
+}
+
+// WaitForScanWithContext is an alternate form of the WaitForScan method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) WaitForScanWithContext(ctx context.Context, waitForScanOptions *securityandcompliancecenterapiv3.WaitForScanOptions) (*securityandcompliancecenterapiv3.ScanResult, error) {
+	ret := _m.Called(ctx, waitForScanOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.WaitForScanOptions) (*securityandcompliancecenterapiv3.ScanResult, error)); ok {
+		return fn(ctx, waitForScanOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScanResult](ret, 0), get[error](ret, 1)
+}
+
+// CreateScope : Create a scope
+func (_m *SecurityAndComplianceCenterAPIV3) CreateScope(createScopeOptions *securityandcompliancecenterapiv3.CreateScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error) {
+	ret := _m.Called(createScopeOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error)); ok {
+		return fn(createScopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Scope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateScopeWithContext is an alternate form of the CreateScope method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateScopeWithContext(ctx context.Context, createScopeOptions *securityandcompliancecenterapiv3.CreateScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createScopeOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createScopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Scope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListScopes : Get all scopes
+func (_m *SecurityAndComplianceCenterAPIV3) ListScopes(listScopesOptions *securityandcompliancecenterapiv3.ListScopesOptions) (*securityandcompliancecenterapiv3.ScopeCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listScopesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListScopesOptions) (*securityandcompliancecenterapiv3.ScopeCollection, *core.DetailedResponse, error)); ok {
+		return fn(listScopesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScopeCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListScopesWithContext is an alternate form of the ListScopes method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListScopesWithContext(ctx context.Context, listScopesOptions *securityandcompliancecenterapiv3.ListScopesOptions) (*securityandcompliancecenterapiv3.ScopeCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listScopesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListScopesOptions) (*securityandcompliancecenterapiv3.ScopeCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listScopesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScopeCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateScope : Update a scope
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateScope(updateScopeOptions *securityandcompliancecenterapiv3.UpdateScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error) {
+	ret := _m.Called(updateScopeOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.UpdateScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error)); ok {
+		return fn(updateScopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Scope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateScopeWithContext is an alternate form of the UpdateScope method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateScopeWithContext(ctx context.Context, updateScopeOptions *securityandcompliancecenterapiv3.UpdateScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateScopeOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.UpdateScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error)); ok {
+		return fn(ctx, updateScopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Scope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetScope : Get a scope
+func (_m *SecurityAndComplianceCenterAPIV3) GetScope(getScopeOptions *securityandcompliancecenterapiv3.GetScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error) {
+	ret := _m.Called(getScopeOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error)); ok {
+		return fn(getScopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Scope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetScopeWithContext is an alternate form of the GetScope method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetScopeWithContext(ctx context.Context, getScopeOptions *securityandcompliancecenterapiv3.GetScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getScopeOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetScopeOptions) (*securityandcompliancecenterapiv3.Scope, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getScopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Scope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteScope : Delete a scope
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteScope(deleteScopeOptions *securityandcompliancecenterapiv3.DeleteScopeOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteScopeOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.DeleteScopeOptions) (*core.DetailedResponse, error)); ok {
+		return fn(deleteScopeOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// DeleteScopeWithContext is an alternate form of the DeleteScope method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteScopeWithContext(ctx context.Context, deleteScopeOptions *securityandcompliancecenterapiv3.DeleteScopeOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteScopeOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.DeleteScopeOptions) (*core.DetailedResponse, error)); ok {
+		return fn(ctx, deleteScopeOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// CreateSubscope : Create a subscope
+func (_m *SecurityAndComplianceCenterAPIV3) CreateSubscope(createSubscopeOptions *securityandcompliancecenterapiv3.CreateSubscopeOptions) (*securityandcompliancecenterapiv3.SubScopeResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(createSubscopeOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateSubscopeOptions) (*securityandcompliancecenterapiv3.SubScopeResponse, *core.DetailedResponse, error)); ok {
+		return fn(createSubscopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.SubScopeResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateSubscopeWithContext is an alternate form of the CreateSubscope method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateSubscopeWithContext(ctx context.Context, createSubscopeOptions *securityandcompliancecenterapiv3.CreateSubscopeOptions) (*securityandcompliancecenterapiv3.SubScopeResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createSubscopeOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateSubscopeOptions) (*securityandcompliancecenterapiv3.SubScopeResponse, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createSubscopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.SubScopeResponse](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListSubscopes : Get all subscopes
+func (_m *SecurityAndComplianceCenterAPIV3) ListSubscopes(listSubscopesOptions *securityandcompliancecenterapiv3.ListSubscopesOptions) (*securityandcompliancecenterapiv3.SubScopeCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listSubscopesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListSubscopesOptions) (*securityandcompliancecenterapiv3.SubScopeCollection, *core.DetailedResponse, error)); ok {
+		return fn(listSubscopesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.SubScopeCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListSubscopesWithContext is an alternate form of the ListSubscopes method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListSubscopesWithContext(ctx context.Context, listSubscopesOptions *securityandcompliancecenterapiv3.ListSubscopesOptions) (*securityandcompliancecenterapiv3.SubScopeCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listSubscopesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListSubscopesOptions) (*securityandcompliancecenterapiv3.SubScopeCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listSubscopesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.SubScopeCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetSubscope : Get a subscope
+func (_m *SecurityAndComplianceCenterAPIV3) GetSubscope(getSubscopeOptions *securityandcompliancecenterapiv3.GetSubscopeOptions) (*securityandcompliancecenterapiv3.SubScope, *core.DetailedResponse, error) {
+	ret := _m.Called(getSubscopeOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetSubscopeOptions) (*securityandcompliancecenterapiv3.SubScope, *core.DetailedResponse, error)); ok {
+		return fn(getSubscopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.SubScope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetSubscopeWithContext is an alternate form of the GetSubscope method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetSubscopeWithContext(ctx context.Context, getSubscopeOptions *securityandcompliancecenterapiv3.GetSubscopeOptions) (*securityandcompliancecenterapiv3.SubScope, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getSubscopeOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetSubscopeOptions) (*securityandcompliancecenterapiv3.SubScope, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getSubscopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.SubScope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateSubscope : Update a subscope
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateSubscope(updateSubscopeOptions *securityandcompliancecenterapiv3.UpdateSubscopeOptions) (*securityandcompliancecenterapiv3.SubScope, *core.DetailedResponse, error) {
+	ret := _m.Called(updateSubscopeOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.UpdateSubscopeOptions) (*securityandcompliancecenterapiv3.SubScope, *core.DetailedResponse, error)); ok {
+		return fn(updateSubscopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.SubScope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateSubscopeWithContext is an alternate form of the UpdateSubscope method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateSubscopeWithContext(ctx context.Context, updateSubscopeOptions *securityandcompliancecenterapiv3.UpdateSubscopeOptions) (*securityandcompliancecenterapiv3.SubScope, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateSubscopeOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.UpdateSubscopeOptions) (*securityandcompliancecenterapiv3.SubScope, *core.DetailedResponse, error)); ok {
+		return fn(ctx, updateSubscopeOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.SubScope](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteSubscope : Delete a subscope
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteSubscope(deleteSubscopeOptions *securityandcompliancecenterapiv3.DeleteSubscopeOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteSubscopeOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.DeleteSubscopeOptions) (*core.DetailedResponse, error)); ok {
+		return fn(deleteSubscopeOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// DeleteSubscopeWithContext is an alternate form of the DeleteSubscope method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteSubscopeWithContext(ctx context.Context, deleteSubscopeOptions *securityandcompliancecenterapiv3.DeleteSubscopeOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteSubscopeOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.DeleteSubscopeOptions) (*core.DetailedResponse, error)); ok {
+		return fn(ctx, deleteSubscopeOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// NewScopesPager returns a new ScopesPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewScopesPager(options *securityandcompliancecenterapiv3.ListScopesOptions) (*securityandcompliancecenterapiv3.ScopesPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListScopesOptions) (*securityandcompliancecenterapiv3.ScopesPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.ScopesPager](ret, 0), get[error](ret, 1)
+}
+
+// NewSubscopesPager returns a new SubscopesPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewSubscopesPager(options *securityandcompliancecenterapiv3.ListSubscopesOptions) (*securityandcompliancecenterapiv3.SubscopesPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListSubscopesOptions) (*securityandcompliancecenterapiv3.SubscopesPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.SubscopesPager](ret, 0), get[error](ret, 1)
+}
+
+// AllScopes returns an iterator over all of the results of the "ListScopes" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllScopes(ctx context.Context, options *securityandcompliancecenterapiv3.ListScopesOptions) iter.Seq2[securityandcompliancecenterapiv3.Scope, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListScopesOptions) iter.Seq2[securityandcompliancecenterapiv3.Scope, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.Scope, error]](ret, 0)
+}
+
+// AllSubscopes returns an iterator over all of the results of the "ListSubscopes" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllSubscopes(ctx context.Context, options *securityandcompliancecenterapiv3.ListSubscopesOptions) iter.Seq2[securityandcompliancecenterapiv3.SubScope, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListSubscopesOptions) iter.Seq2[securityandcompliancecenterapiv3.SubScope, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.SubScope, error]](ret, 0)
+}
+
+// CreateTarget : Create a target
+func (_m *SecurityAndComplianceCenterAPIV3) CreateTarget(createTargetOptions *securityandcompliancecenterapiv3.CreateTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(createTargetOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error)); ok {
+		return fn(createTargetOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Target](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateTargetWithContext is an alternate form of the CreateTarget method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateTargetWithContext(ctx context.Context, createTargetOptions *securityandcompliancecenterapiv3.CreateTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createTargetOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createTargetOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Target](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListTargets : Get a list of targets with pagination
+func (_m *SecurityAndComplianceCenterAPIV3) ListTargets(listTargetsOptions *securityandcompliancecenterapiv3.ListTargetsOptions) (*securityandcompliancecenterapiv3.TargetCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listTargetsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListTargetsOptions) (*securityandcompliancecenterapiv3.TargetCollection, *core.DetailedResponse, error)); ok {
+		return fn(listTargetsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.TargetCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListTargetsWithContext is an alternate form of the ListTargets method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListTargetsWithContext(ctx context.Context, listTargetsOptions *securityandcompliancecenterapiv3.ListTargetsOptions) (*securityandcompliancecenterapiv3.TargetCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listTargetsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListTargetsOptions) (*securityandcompliancecenterapiv3.TargetCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listTargetsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.TargetCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// NewTargetsPager returns a new TargetsPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewTargetsPager(options *securityandcompliancecenterapiv3.ListTargetsOptions) (*securityandcompliancecenterapiv3.TargetsPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListTargetsOptions) (*securityandcompliancecenterapiv3.TargetsPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.TargetsPager](ret, 0), get[error](ret, 1)
+}
+
+// AllTargets returns an iterator over all of the results of the "ListTargets" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllTargets(ctx context.Context, options *securityandcompliancecenterapiv3.ListTargetsOptions) iter.Seq2[securityandcompliancecenterapiv3.Target, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListTargetsOptions) iter.Seq2[securityandcompliancecenterapiv3.Target, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.Target, error]](ret, 0)
+}
+
+// GetTarget : Get a target by ID
+func (_m *SecurityAndComplianceCenterAPIV3) GetTarget(getTargetOptions *securityandcompliancecenterapiv3.GetTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(getTargetOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error)); ok {
+		return fn(getTargetOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Target](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetTargetWithContext is an alternate form of the GetTarget method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetTargetWithContext(ctx context.Context, getTargetOptions *securityandcompliancecenterapiv3.GetTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getTargetOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getTargetOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Target](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceTarget : replace a target by ID
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceTarget(replaceTargetOptions *securityandcompliancecenterapiv3.ReplaceTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(replaceTargetOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ReplaceTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error)); ok {
+		return fn(replaceTargetOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Target](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ReplaceTargetWithContext is an alternate form of the ReplaceTarget method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ReplaceTargetWithContext(ctx context.Context, replaceTargetOptions *securityandcompliancecenterapiv3.ReplaceTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, replaceTargetOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ReplaceTargetOptions) (*securityandcompliancecenterapiv3.Target, *core.DetailedResponse, error)); ok {
+		return fn(ctx, replaceTargetOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Target](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteTarget : Delete a target by ID
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteTarget(deleteTargetOptions *securityandcompliancecenterapiv3.DeleteTargetOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteTargetOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.DeleteTargetOptions) (*core.DetailedResponse, error)); ok {
+		return fn(deleteTargetOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// DeleteTargetWithContext is an alternate form of the DeleteTarget method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *securityandcompliancecenterapiv3.DeleteTargetOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteTargetOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.DeleteTargetOptions) (*core.DetailedResponse, error)); ok {
+		return fn(ctx, deleteTargetOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// CreateProviderTypeInstance : Create a provider type instance
+func (_m *SecurityAndComplianceCenterAPIV3) CreateProviderTypeInstance(createProviderTypeInstanceOptions *securityandcompliancecenterapiv3.CreateProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(createProviderTypeInstanceOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error)); ok {
+		return fn(createProviderTypeInstanceOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeInstance](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateProviderTypeInstanceWithContext is an alternate form of the CreateProviderTypeInstance method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateProviderTypeInstanceWithContext(ctx context.Context, createProviderTypeInstanceOptions *securityandcompliancecenterapiv3.CreateProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createProviderTypeInstanceOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createProviderTypeInstanceOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeInstance](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListProviderTypeInstances : List instances of a specific provider type
+func (_m *SecurityAndComplianceCenterAPIV3) ListProviderTypeInstances(listProviderTypeInstancesOptions *securityandcompliancecenterapiv3.ListProviderTypeInstancesOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstanceCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listProviderTypeInstancesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListProviderTypeInstancesOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstanceCollection, *core.DetailedResponse, error)); ok {
+		return fn(listProviderTypeInstancesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeInstanceCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListProviderTypeInstancesWithContext is an alternate form of the ListProviderTypeInstances method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListProviderTypeInstancesWithContext(ctx context.Context, listProviderTypeInstancesOptions *securityandcompliancecenterapiv3.ListProviderTypeInstancesOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstanceCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listProviderTypeInstancesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListProviderTypeInstancesOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstanceCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listProviderTypeInstancesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeInstanceCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetProviderTypeInstance : Get a provider type instance
+func (_m *SecurityAndComplianceCenterAPIV3) GetProviderTypeInstance(getProviderTypeInstanceOptions *securityandcompliancecenterapiv3.GetProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(getProviderTypeInstanceOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error)); ok {
+		return fn(getProviderTypeInstanceOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeInstance](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetProviderTypeInstanceWithContext is an alternate form of the GetProviderTypeInstance method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetProviderTypeInstanceWithContext(ctx context.Context, getProviderTypeInstanceOptions *securityandcompliancecenterapiv3.GetProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getProviderTypeInstanceOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getProviderTypeInstanceOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeInstance](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateProviderTypeInstance : Update a provider type instance
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateProviderTypeInstance(updateProviderTypeInstanceOptions *securityandcompliancecenterapiv3.UpdateProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(updateProviderTypeInstanceOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.UpdateProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error)); ok {
+		return fn(updateProviderTypeInstanceOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeInstance](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// UpdateProviderTypeInstanceWithContext is an alternate form of the UpdateProviderTypeInstance method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) UpdateProviderTypeInstanceWithContext(ctx context.Context, updateProviderTypeInstanceOptions *securityandcompliancecenterapiv3.UpdateProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateProviderTypeInstanceOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.UpdateProviderTypeInstanceOptions) (*securityandcompliancecenterapiv3.ProviderTypeInstance, *core.DetailedResponse, error)); ok {
+		return fn(ctx, updateProviderTypeInstanceOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeInstance](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DeleteProviderTypeInstance : Delete a provider type instance
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteProviderTypeInstance(deleteProviderTypeInstanceOptions *securityandcompliancecenterapiv3.DeleteProviderTypeInstanceOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(deleteProviderTypeInstanceOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.DeleteProviderTypeInstanceOptions) (*core.DetailedResponse, error)); ok {
+		return fn(deleteProviderTypeInstanceOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// DeleteProviderTypeInstanceWithContext is an alternate form of the DeleteProviderTypeInstance method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) DeleteProviderTypeInstanceWithContext(ctx context.Context, deleteProviderTypeInstanceOptions *securityandcompliancecenterapiv3.DeleteProviderTypeInstanceOptions) (*core.DetailedResponse, error) {
+	ret := _m.Called(ctx, deleteProviderTypeInstanceOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.DeleteProviderTypeInstanceOptions) (*core.DetailedResponse, error)); ok {
+		return fn(ctx, deleteProviderTypeInstanceOptions)
+	}
+	return get[*core.DetailedResponse](ret, 0), get[error](ret, 1)
+}
+
+// ListProviderTypes : List provider types
+func (_m *SecurityAndComplianceCenterAPIV3) ListProviderTypes(listProviderTypesOptions *securityandcompliancecenterapiv3.ListProviderTypesOptions) (*securityandcompliancecenterapiv3.ProviderTypeCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listProviderTypesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListProviderTypesOptions) (*securityandcompliancecenterapiv3.ProviderTypeCollection, *core.DetailedResponse, error)); ok {
+		return fn(listProviderTypesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListProviderTypesWithContext is an alternate form of the ListProviderTypes method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListProviderTypesWithContext(ctx context.Context, listProviderTypesOptions *securityandcompliancecenterapiv3.ListProviderTypesOptions) (*securityandcompliancecenterapiv3.ProviderTypeCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listProviderTypesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListProviderTypesOptions) (*securityandcompliancecenterapiv3.ProviderTypeCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listProviderTypesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderTypeCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetProviderTypeByID : Get a provider type
+func (_m *SecurityAndComplianceCenterAPIV3) GetProviderTypeByID(getProviderTypeByIDOptions *securityandcompliancecenterapiv3.GetProviderTypeByIDOptions) (*securityandcompliancecenterapiv3.ProviderType, *core.DetailedResponse, error) {
+	ret := _m.Called(getProviderTypeByIDOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetProviderTypeByIDOptions) (*securityandcompliancecenterapiv3.ProviderType, *core.DetailedResponse, error)); ok {
+		return fn(getProviderTypeByIDOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderType](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetProviderTypeByIDWithContext is an alternate form of the GetProviderTypeByID method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetProviderTypeByIDWithContext(ctx context.Context, getProviderTypeByIDOptions *securityandcompliancecenterapiv3.GetProviderTypeByIDOptions) (*securityandcompliancecenterapiv3.ProviderType, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getProviderTypeByIDOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetProviderTypeByIDOptions) (*securityandcompliancecenterapiv3.ProviderType, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getProviderTypeByIDOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ProviderType](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetLatestReports : List latest reports
+func (_m *SecurityAndComplianceCenterAPIV3) GetLatestReports(getLatestReportsOptions *securityandcompliancecenterapiv3.GetLatestReportsOptions) (*securityandcompliancecenterapiv3.ReportLatest, *core.DetailedResponse, error) {
+	ret := _m.Called(getLatestReportsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetLatestReportsOptions) (*securityandcompliancecenterapiv3.ReportLatest, *core.DetailedResponse, error)); ok {
+		return fn(getLatestReportsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportLatest](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetLatestReportsWithContext is an alternate form of the GetLatestReports method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetLatestReportsWithContext(ctx context.Context, getLatestReportsOptions *securityandcompliancecenterapiv3.GetLatestReportsOptions) (*securityandcompliancecenterapiv3.ReportLatest, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getLatestReportsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetLatestReportsOptions) (*securityandcompliancecenterapiv3.ReportLatest, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getLatestReportsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportLatest](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListReports : List reports
+func (_m *SecurityAndComplianceCenterAPIV3) ListReports(listReportsOptions *securityandcompliancecenterapiv3.ListReportsOptions) (*securityandcompliancecenterapiv3.ReportCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listReportsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListReportsOptions) (*securityandcompliancecenterapiv3.ReportCollection, *core.DetailedResponse, error)); ok {
+		return fn(listReportsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListReportsWithContext is an alternate form of the ListReports method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListReportsWithContext(ctx context.Context, listReportsOptions *securityandcompliancecenterapiv3.ListReportsOptions) (*securityandcompliancecenterapiv3.ReportCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listReportsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListReportsOptions) (*securityandcompliancecenterapiv3.ReportCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listReportsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReport : Get a report
+func (_m *SecurityAndComplianceCenterAPIV3) GetReport(getReportOptions *securityandcompliancecenterapiv3.GetReportOptions) (*securityandcompliancecenterapiv3.Report, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetReportOptions) (*securityandcompliancecenterapiv3.Report, *core.DetailedResponse, error)); ok {
+		return fn(getReportOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Report](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportWithContext is an alternate form of the GetReport method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportWithContext(ctx context.Context, getReportOptions *securityandcompliancecenterapiv3.GetReportOptions) (*securityandcompliancecenterapiv3.Report, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetReportOptions) (*securityandcompliancecenterapiv3.Report, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getReportOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Report](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportSummary : Get a report summary
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportSummary(getReportSummaryOptions *securityandcompliancecenterapiv3.GetReportSummaryOptions) (*securityandcompliancecenterapiv3.ReportSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportSummaryOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetReportSummaryOptions) (*securityandcompliancecenterapiv3.ReportSummary, *core.DetailedResponse, error)); ok {
+		return fn(getReportSummaryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportSummary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportSummaryWithContext is an alternate form of the GetReportSummary method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportSummaryWithContext(ctx context.Context, getReportSummaryOptions *securityandcompliancecenterapiv3.GetReportSummaryOptions) (*securityandcompliancecenterapiv3.ReportSummary, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportSummaryOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetReportSummaryOptions) (*securityandcompliancecenterapiv3.ReportSummary, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getReportSummaryOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportSummary](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportDownloadFile : Get report evaluation details
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportDownloadFile(getReportDownloadFileOptions *securityandcompliancecenterapiv3.GetReportDownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportDownloadFileOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetReportDownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error)); ok {
+		return fn(getReportDownloadFileOptions)
+	}
+	return get[io.ReadCloser](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportDownloadFileWithContext is an alternate form of the GetReportDownloadFile method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportDownloadFileWithContext(ctx context.Context, getReportDownloadFileOptions *securityandcompliancecenterapiv3.GetReportDownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportDownloadFileOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetReportDownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getReportDownloadFileOptions)
+	}
+	return get[io.ReadCloser](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportDownloadFileCSV : Get report evaluation details as CSV rows
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportDownloadFileCSV(getReportDownloadFileOptions *securityandcompliancecenterapiv3.GetReportDownloadFileOptions) (*securityandcompliancecenterapiv3.ReportCSVDecoder, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportDownloadFileOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetReportDownloadFileOptions) (*securityandcompliancecenterapiv3.ReportCSVDecoder, *core.DetailedResponse, error)); ok {
+		return fn(getReportDownloadFileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportCSVDecoder](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportDownloadFileCSVWithContext is an alternate form of the GetReportDownloadFileCSV method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportDownloadFileCSVWithContext(ctx context.Context, getReportDownloadFileOptions *securityandcompliancecenterapiv3.GetReportDownloadFileOptions) (*securityandcompliancecenterapiv3.ReportCSVDecoder, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportDownloadFileOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetReportDownloadFileOptions) (*securityandcompliancecenterapiv3.ReportCSVDecoder, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getReportDownloadFileOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportCSVDecoder](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportControls : Get report controls
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportControls(getReportControlsOptions *securityandcompliancecenterapiv3.GetReportControlsOptions) (*securityandcompliancecenterapiv3.ReportControls, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportControlsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetReportControlsOptions) (*securityandcompliancecenterapiv3.ReportControls, *core.DetailedResponse, error)); ok {
+		return fn(getReportControlsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportControls](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportControlsWithContext is an alternate form of the GetReportControls method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportControlsWithContext(ctx context.Context, getReportControlsOptions *securityandcompliancecenterapiv3.GetReportControlsOptions) (*securityandcompliancecenterapiv3.ReportControls, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportControlsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetReportControlsOptions) (*securityandcompliancecenterapiv3.ReportControls, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getReportControlsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportControls](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportRule : Get a report rule
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportRule(getReportRuleOptions *securityandcompliancecenterapiv3.GetReportRuleOptions) (*securityandcompliancecenterapiv3.RuleInfo, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportRuleOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetReportRuleOptions) (*securityandcompliancecenterapiv3.RuleInfo, *core.DetailedResponse, error)); ok {
+		return fn(getReportRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.RuleInfo](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportRuleWithContext is an alternate form of the GetReportRule method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportRuleWithContext(ctx context.Context, getReportRuleOptions *securityandcompliancecenterapiv3.GetReportRuleOptions) (*securityandcompliancecenterapiv3.RuleInfo, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportRuleOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetReportRuleOptions) (*securityandcompliancecenterapiv3.RuleInfo, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getReportRuleOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.RuleInfo](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListReportEvaluations : List report evaluations
+func (_m *SecurityAndComplianceCenterAPIV3) ListReportEvaluations(listReportEvaluationsOptions *securityandcompliancecenterapiv3.ListReportEvaluationsOptions) (*securityandcompliancecenterapiv3.EvaluationPage, *core.DetailedResponse, error) {
+	ret := _m.Called(listReportEvaluationsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListReportEvaluationsOptions) (*securityandcompliancecenterapiv3.EvaluationPage, *core.DetailedResponse, error)); ok {
+		return fn(listReportEvaluationsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.EvaluationPage](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListReportEvaluationsWithContext is an alternate form of the ListReportEvaluations method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListReportEvaluationsWithContext(ctx context.Context, listReportEvaluationsOptions *securityandcompliancecenterapiv3.ListReportEvaluationsOptions) (*securityandcompliancecenterapiv3.EvaluationPage, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listReportEvaluationsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListReportEvaluationsOptions) (*securityandcompliancecenterapiv3.EvaluationPage, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listReportEvaluationsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.EvaluationPage](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListReportResources : List report resources
+func (_m *SecurityAndComplianceCenterAPIV3) ListReportResources(listReportResourcesOptions *securityandcompliancecenterapiv3.ListReportResourcesOptions) (*securityandcompliancecenterapiv3.ResourcePage, *core.DetailedResponse, error) {
+	ret := _m.Called(listReportResourcesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListReportResourcesOptions) (*securityandcompliancecenterapiv3.ResourcePage, *core.DetailedResponse, error)); ok {
+		return fn(listReportResourcesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ResourcePage](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListReportResourcesWithContext is an alternate form of the ListReportResources method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListReportResourcesWithContext(ctx context.Context, listReportResourcesOptions *securityandcompliancecenterapiv3.ListReportResourcesOptions) (*securityandcompliancecenterapiv3.ResourcePage, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listReportResourcesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListReportResourcesOptions) (*securityandcompliancecenterapiv3.ResourcePage, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listReportResourcesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ResourcePage](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportTags : List report tags
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportTags(getReportTagsOptions *securityandcompliancecenterapiv3.GetReportTagsOptions) (*securityandcompliancecenterapiv3.ReportTags, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportTagsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetReportTagsOptions) (*securityandcompliancecenterapiv3.ReportTags, *core.DetailedResponse, error)); ok {
+		return fn(getReportTagsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportTags](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportTagsWithContext is an alternate form of the GetReportTags method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportTagsWithContext(ctx context.Context, getReportTagsOptions *securityandcompliancecenterapiv3.GetReportTagsOptions) (*securityandcompliancecenterapiv3.ReportTags, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportTagsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetReportTagsOptions) (*securityandcompliancecenterapiv3.ReportTags, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getReportTagsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportTags](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportViolationsDrift : Get report violations drift
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportViolationsDrift(getReportViolationsDriftOptions *securityandcompliancecenterapiv3.GetReportViolationsDriftOptions) (*securityandcompliancecenterapiv3.ReportViolationsDrift, *core.DetailedResponse, error) {
+	ret := _m.Called(getReportViolationsDriftOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetReportViolationsDriftOptions) (*securityandcompliancecenterapiv3.ReportViolationsDrift, *core.DetailedResponse, error)); ok {
+		return fn(getReportViolationsDriftOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportViolationsDrift](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportViolationsDriftWithContext is an alternate form of the GetReportViolationsDrift method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportViolationsDriftWithContext(ctx context.Context, getReportViolationsDriftOptions *securityandcompliancecenterapiv3.GetReportViolationsDriftOptions) (*securityandcompliancecenterapiv3.ReportViolationsDrift, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getReportViolationsDriftOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetReportViolationsDriftOptions) (*securityandcompliancecenterapiv3.ReportViolationsDrift, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getReportViolationsDriftOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportViolationsDrift](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetReportJUnit : Get report controls as JUnit test suites
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportJUnit(getReportJUnitOptions *securityandcompliancecenterapiv3.GetReportJUnitOptions) (*securityandcompliancecenterapiv3.JUnitTestSuites, error) {
+	ret := _m.Called(getReportJUnitOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetReportJUnitOptions) (*securityandcompliancecenterapiv3.JUnitTestSuites, error)); ok {
+		return fn(getReportJUnitOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.JUnitTestSuites](ret, 0), get[error](ret, 1)
+}
+
+// GetReportJUnitWithContext is an alternate form of the GetReportJUnit method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetReportJUnitWithContext(ctx context.Context, getReportJUnitOptions *securityandcompliancecenterapiv3.GetReportJUnitOptions) (*securityandcompliancecenterapiv3.JUnitTestSuites, error) {
+	ret := _m.Called(ctx, getReportJUnitOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetReportJUnitOptions) (*securityandcompliancecenterapiv3.JUnitTestSuites, error)); ok {
+		return fn(ctx, getReportJUnitOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.JUnitTestSuites](ret, 0), get[error](ret, 1)
+}
+
+// CompareReports : Compare the evaluations of two reports
+func (_m *SecurityAndComplianceCenterAPIV3) CompareReports(compareReportsOptions *securityandcompliancecenterapiv3.CompareReportsOptions) (*securityandcompliancecenterapiv3.ReportComparison, error) {
+	ret := _m.Called(compareReportsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CompareReportsOptions) (*securityandcompliancecenterapiv3.ReportComparison, error)); ok {
+		return fn(compareReportsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportComparison](ret, 0), get[error](ret, 1)
+}
+
+// CompareReportsWithContext is an alternate form of the CompareReports method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CompareReportsWithContext(ctx context.Context, compareReportsOptions *securityandcompliancecenterapiv3.CompareReportsOptions) (*securityandcompliancecenterapiv3.ReportComparison, error) {
+	ret := _m.Called(ctx, compareReportsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CompareReportsOptions) (*securityandcompliancecenterapiv3.ReportComparison, error)); ok {
+		return fn(ctx, compareReportsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportComparison](ret, 0), get[error](ret, 1)
+}
+
+// NewReportsPager returns a new ReportsPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewReportsPager(options *securityandcompliancecenterapiv3.ListReportsOptions) (*securityandcompliancecenterapiv3.ReportsPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListReportsOptions) (*securityandcompliancecenterapiv3.ReportsPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportsPager](ret, 0), get[error](ret, 1)
+}
+
+// NewReportEvaluationsPager returns a new ReportEvaluationsPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewReportEvaluationsPager(options *securityandcompliancecenterapiv3.ListReportEvaluationsOptions) (*securityandcompliancecenterapiv3.ReportEvaluationsPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListReportEvaluationsOptions) (*securityandcompliancecenterapiv3.ReportEvaluationsPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportEvaluationsPager](ret, 0), get[error](ret, 1)
+}
+
+// NewReportResourcesPager returns a new ReportResourcesPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewReportResourcesPager(options *securityandcompliancecenterapiv3.ListReportResourcesOptions) (*securityandcompliancecenterapiv3.ReportResourcesPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListReportResourcesOptions) (*securityandcompliancecenterapiv3.ReportResourcesPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.ReportResourcesPager](ret, 0), get[error](ret, 1)
+}
+
+// AllReports returns an iterator over all of the results of the "ListReports" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllReports(ctx context.Context, options *securityandcompliancecenterapiv3.ListReportsOptions) iter.Seq2[securityandcompliancecenterapiv3.Report, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListReportsOptions) iter.Seq2[securityandcompliancecenterapiv3.Report, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.Report, error]](ret, 0)
+}
+
+// AllReportEvaluations returns an iterator over all of the results of the "ListReportEvaluations" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllReportEvaluations(ctx context.Context, options *securityandcompliancecenterapiv3.ListReportEvaluationsOptions) iter.Seq2[securityandcompliancecenterapiv3.Evaluation, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListReportEvaluationsOptions) iter.Seq2[securityandcompliancecenterapiv3.Evaluation, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.Evaluation, error]](ret, 0)
+}
+
+// AllReportResources returns an iterator over all of the results of the "ListReportResources" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllReportResources(ctx context.Context, options *securityandcompliancecenterapiv3.ListReportResourcesOptions) iter.Seq2[securityandcompliancecenterapiv3.Resource, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListReportResourcesOptions) iter.Seq2[securityandcompliancecenterapiv3.Resource, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.Resource, error]](ret, 0)
+}
+
+// ListScanReports : List scan reports
+func (_m *SecurityAndComplianceCenterAPIV3) ListScanReports(listScanReportsOptions *securityandcompliancecenterapiv3.ListScanReportsOptions) (*securityandcompliancecenterapiv3.ScanReportCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listScanReportsOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListScanReportsOptions) (*securityandcompliancecenterapiv3.ScanReportCollection, *core.DetailedResponse, error)); ok {
+		return fn(listScanReportsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScanReportCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListScanReportsWithContext is an alternate form of the ListScanReports method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListScanReportsWithContext(ctx context.Context, listScanReportsOptions *securityandcompliancecenterapiv3.ListScanReportsOptions) (*securityandcompliancecenterapiv3.ScanReportCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listScanReportsOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListScanReportsOptions) (*securityandcompliancecenterapiv3.ScanReportCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listScanReportsOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScanReportCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// NewScanReportsPager returns a new ScanReportsPager instance.
+func (_m *SecurityAndComplianceCenterAPIV3) NewScanReportsPager(options *securityandcompliancecenterapiv3.ListScanReportsOptions) (*securityandcompliancecenterapiv3.ScanReportsPager, error) {
+	ret := _m.Called(options)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListScanReportsOptions) (*securityandcompliancecenterapiv3.ScanReportsPager, error)); ok {
+		return fn(options)
+	}
+	return get[*securityandcompliancecenterapiv3.ScanReportsPager](ret, 0), get[error](ret, 1)
+}
+
+// AllScanReports returns an iterator over all of the results of the "ListScanReports" method.
+func (_m *SecurityAndComplianceCenterAPIV3) AllScanReports(ctx context.Context, options *securityandcompliancecenterapiv3.ListScanReportsOptions) iter.Seq2[securityandcompliancecenterapiv3.ScanReport, error] {
+	ret := _m.Called(ctx, options)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListScanReportsOptions) iter.Seq2[securityandcompliancecenterapiv3.ScanReport, error]); ok {
+		return fn(ctx, options)
+	}
+	return get[iter.Seq2[securityandcompliancecenterapiv3.ScanReport, error]](ret, 0)
+}
+
+// CreateScanReport : Create a scan report
+func (_m *SecurityAndComplianceCenterAPIV3) CreateScanReport(createScanReportOptions *securityandcompliancecenterapiv3.CreateScanReportOptions) (*securityandcompliancecenterapiv3.CreateScanReport, *core.DetailedResponse, error) {
+	ret := _m.Called(createScanReportOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.CreateScanReportOptions) (*securityandcompliancecenterapiv3.CreateScanReport, *core.DetailedResponse, error)); ok {
+		return fn(createScanReportOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.CreateScanReport](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// CreateScanReportWithContext is an alternate form of the CreateScanReport method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) CreateScanReportWithContext(ctx context.Context, createScanReportOptions *securityandcompliancecenterapiv3.CreateScanReportOptions) (*securityandcompliancecenterapiv3.CreateScanReport, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, createScanReportOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.CreateScanReportOptions) (*securityandcompliancecenterapiv3.CreateScanReport, *core.DetailedResponse, error)); ok {
+		return fn(ctx, createScanReportOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.CreateScanReport](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetScanReport : Get a scan report
+func (_m *SecurityAndComplianceCenterAPIV3) GetScanReport(getScanReportOptions *securityandcompliancecenterapiv3.GetScanReportOptions) (*securityandcompliancecenterapiv3.ScanReport, *core.DetailedResponse, error) {
+	ret := _m.Called(getScanReportOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetScanReportOptions) (*securityandcompliancecenterapiv3.ScanReport, *core.DetailedResponse, error)); ok {
+		return fn(getScanReportOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScanReport](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetScanReportWithContext is an alternate form of the GetScanReport method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetScanReportWithContext(ctx context.Context, getScanReportOptions *securityandcompliancecenterapiv3.GetScanReportOptions) (*securityandcompliancecenterapiv3.ScanReport, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getScanReportOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetScanReportOptions) (*securityandcompliancecenterapiv3.ScanReport, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getScanReportOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScanReport](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetScanReportDownloadFile : Get a scan report details
+func (_m *SecurityAndComplianceCenterAPIV3) GetScanReportDownloadFile(getScanReportDownloadFileOptions *securityandcompliancecenterapiv3.GetScanReportDownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(getScanReportDownloadFileOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetScanReportDownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error)); ok {
+		return fn(getScanReportDownloadFileOptions)
+	}
+	return get[io.ReadCloser](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetScanReportDownloadFileWithContext is an alternate form of the GetScanReportDownloadFile method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetScanReportDownloadFileWithContext(ctx context.Context, getScanReportDownloadFileOptions *securityandcompliancecenterapiv3.GetScanReportDownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getScanReportDownloadFileOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetScanReportDownloadFileOptions) (io.ReadCloser, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getScanReportDownloadFileOptions)
+	}
+	return get[io.ReadCloser](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// DownloadScanReport : Export a report and download it to a file
+func (_m *SecurityAndComplianceCenterAPIV3) DownloadScanReport(downloadScanReportOptions *securityandcompliancecenterapiv3.DownloadScanReportOptions) (*securityandcompliancecenterapiv3.ScanReportDownload, error) {
+	ret := _m.Called(downloadScanReportOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.DownloadScanReportOptions) (*securityandcompliancecenterapiv3.ScanReportDownload, error)); ok {
+		return fn(downloadScanReportOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScanReportDownload](ret, 0), get[error](ret, 1)
+}
+
+// DownloadScanReportWithContext is an alternate form of the DownloadScanReport method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) DownloadScanReportWithContext(ctx context.Context, downloadScanReportOptions *securityandcompliancecenterapiv3.DownloadScanReportOptions) (*securityandcompliancecenterapiv3.ScanReportDownload, error) {
+	ret := _m.Called(ctx, downloadScanReportOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.DownloadScanReportOptions) (*securityandcompliancecenterapiv3.ScanReportDownload, error)); ok {
+		return fn(ctx, downloadScanReportOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ScanReportDownload](ret, 0), get[error](ret, 1)
+}
+
+// ListServices : List services
+func (_m *SecurityAndComplianceCenterAPIV3) ListServices(listServicesOptions *securityandcompliancecenterapiv3.ListServicesOptions) (*securityandcompliancecenterapiv3.ServiceCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(listServicesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListServicesOptions) (*securityandcompliancecenterapiv3.ServiceCollection, *core.DetailedResponse, error)); ok {
+		return fn(listServicesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ServiceCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// ListServicesWithContext is an alternate form of the ListServices method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) ListServicesWithContext(ctx context.Context, listServicesOptions *securityandcompliancecenterapiv3.ListServicesOptions) (*securityandcompliancecenterapiv3.ServiceCollection, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listServicesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListServicesOptions) (*securityandcompliancecenterapiv3.ServiceCollection, *core.DetailedResponse, error)); ok {
+		return fn(ctx, listServicesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.ServiceCollection](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetService : Get a service
+func (_m *SecurityAndComplianceCenterAPIV3) GetService(getServiceOptions *securityandcompliancecenterapiv3.GetServiceOptions) (*securityandcompliancecenterapiv3.Service, *core.DetailedResponse, error) {
+	ret := _m.Called(getServiceOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.GetServiceOptions) (*securityandcompliancecenterapiv3.Service, *core.DetailedResponse, error)); ok {
+		return fn(getServiceOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Service](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// GetServiceWithContext is an alternate form of the GetService method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) GetServiceWithContext(ctx context.Context, getServiceOptions *securityandcompliancecenterapiv3.GetServiceOptions) (*securityandcompliancecenterapiv3.Service, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getServiceOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.GetServiceOptions) (*securityandcompliancecenterapiv3.Service, *core.DetailedResponse, error)); ok {
+		return fn(ctx, getServiceOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.Service](ret, 0), get[*core.DetailedResponse](ret, 1), get[error](ret, 2)
+}
+
+// LoadRuleValidator : Load a rule validator
+func (_m *SecurityAndComplianceCenterAPIV3) LoadRuleValidator(listServicesOptions *securityandcompliancecenterapiv3.ListServicesOptions) (*securityandcompliancecenterapiv3.RuleValidator, error) {
+	ret := _m.Called(listServicesOptions)
+	if fn, ok := returnFunc(ret).(func(*securityandcompliancecenterapiv3.ListServicesOptions) (*securityandcompliancecenterapiv3.RuleValidator, error)); ok {
+		return fn(listServicesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.RuleValidator](ret, 0), get[error](ret, 1)
+}
+
+// LoadRuleValidatorWithContext is an alternate form of the LoadRuleValidator method which supports a Context parameter
+func (_m *SecurityAndComplianceCenterAPIV3) LoadRuleValidatorWithContext(ctx context.Context, listServicesOptions *securityandcompliancecenterapiv3.ListServicesOptions) (*securityandcompliancecenterapiv3.RuleValidator, error) {
+	ret := _m.Called(ctx, listServicesOptions)
+	if fn, ok := returnFunc(ret).(func(context.Context, *securityandcompliancecenterapiv3.ListServicesOptions) (*securityandcompliancecenterapiv3.RuleValidator, error)); ok {
+		return fn(ctx, listServicesOptions)
+	}
+	return get[*securityandcompliancecenterapiv3.RuleValidator](ret, 0), get[error](ret, 1)
+}
@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package sccmock_test
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/sccmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// ruleDescription is an example of code that depends only on the RulesAPI.
+func ruleDescription(service securityandcompliancecenterapiv3.RulesAPI, instanceID string, ruleID string) (string, error) {
+	rule, _, err := service.GetRuleWithContext(context.Background(), &securityandcompliancecenterapiv3.GetRuleOptions{
+		InstanceID: core.StringPtr(instanceID),
+		RuleID:     core.StringPtr(ruleID),
+	})
+	if err != nil {
+		return "", err
+	}
+	return *rule.Description, nil
+}
+
+func TestMockReturnsValues(t *testing.T) {
+	service := sccmock.NewSecurityAndComplianceCenterAPIV3(t)
+	rule := &securityandcompliancecenterapiv3.Rule{Description: core.StringPtr("Public access is disabled")}
+	service.On("GetRuleWithContext", mock.Anything, mock.MatchedBy(func(options *securityandcompliancecenterapiv3.GetRuleOptions) bool {
+		return *options.RuleID == "rule-1"
+	})).Return(rule, &core.DetailedResponse{StatusCode: 200}, nil).Once()
+
+	description, err := ruleDescription(service, "instance-1", "rule-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Public access is disabled", description)
+}
+
+func TestMockReturnsErrorsAndNilValues(t *testing.T) {
+	service := sccmock.NewSecurityAndComplianceCenterAPIV3(t)
+	service.On("GetRuleWithContext", mock.Anything, mock.Anything).Return(nil, nil, errors.New("not found"))
+	service.On("DeleteRule", mock.Anything).Return(nil, nil)
+
+	_, err := ruleDescription(service, "instance-1", "rule-1")
+	assert.EqualError(t, err, "not found")
+
+	response, err := service.DeleteRule(&securityandcompliancecenterapiv3.DeleteRuleOptions{})
+	assert.Nil(t, response)
+	assert.Nil(t, err)
+}
+
+func TestMockReturnsResultOfFunction(t *testing.T) {
+	var service securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Interface
+	m := sccmock.NewSecurityAndComplianceCenterAPIV3(t)
+	m.On("ListRules", mock.Anything).Return(func(options *securityandcompliancecenterapiv3.ListRulesOptions) (*securityandcompliancecenterapiv3.RuleCollection, *core.DetailedResponse, error) {
+		return &securityandcompliancecenterapiv3.RuleCollection{Limit: options.Limit}, nil, nil
+	})
+	m.On("AllRules", mock.Anything, mock.Anything).Return(func(ctx context.Context, options *securityandcompliancecenterapiv3.ListRulesOptions) iter.Seq2[securityandcompliancecenterapiv3.Rule, error] {
+		return func(yield func(securityandcompliancecenterapiv3.Rule, error) bool) {
+			yield(securityandcompliancecenterapiv3.Rule{ID: core.StringPtr("rule-1")}, nil)
+		}
+	})
+	service = m
+
+	collection, _, err := service.ListRules(&securityandcompliancecenterapiv3.ListRulesOptions{Limit: core.Int64Ptr(10)})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), *collection.Limit)
+
+	var ids []string
+	for rule, err := range service.AllRules(context.Background(), nil) {
+		assert.Nil(t, err)
+		ids = append(ids, *rule.ID)
+	}
+	assert.Equal(t, []string{"rule-1"}, ids)
+	m.AssertNumberOfCalls(t, "ListRules", 1)
+}
@@ -0,0 +1,61 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sccmock provides a mock implementation of the SecurityAndComplianceCenterAPIV3Interface,
+// based on github.com/stretchr/testify/mock, for unit tests of code that depends on the
+// securityandcompliancecenterapiv3 package:
+//
+//	service := sccmock.NewSecurityAndComplianceCenterAPIV3(t)
+//	service.On("GetRule", mock.Anything).Return(rule, response, nil)
+//
+// Return accepts either the values to return, or a single function with the signature of the
+// method, whose results are returned instead.
+package sccmock
+
+//go:generate go run ../internal/mockgen -source ../client_interface.go -output mock.go
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+// NewSecurityAndComplianceCenterAPIV3 returns a new mock and registers a cleanup function
+// on "t" that asserts that all of the expected calls were made.
+func NewSecurityAndComplianceCenterAPIV3(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecurityAndComplianceCenterAPIV3 {
+	m := &SecurityAndComplianceCenterAPIV3{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// returnFunc returns the function passed as the only argument to Return, if any.
+func returnFunc(ret mock.Arguments) interface{} {
+	if len(ret) == 1 {
+		return ret.Get(0)
+	}
+	return nil
+}
+
+// get returns the return value at "index" as a T, or the zero value of T when it is nil or missing.
+func get[T any](ret mock.Arguments, index int) T {
+	var zero T
+	if index >= len(ret) || ret.Get(index) == nil {
+		return zero
+	}
+	return ret.Get(index).(T)
+}
@@ -0,0 +1,726 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scctest
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// kind describes how the server manages one kind of resource.
+type kind struct {
+	// name is the name of the collection, which is also the property that holds
+	// the resources in list responses.
+	name     string
+	singular string
+
+	// idParam is the path parameter that holds the ID of a resource.
+	// parentParam is the path parameter that holds the ID of the enclosing resource, if any,
+	// and parentKind, when set, is the kind of the enclosing resource, which must exist.
+	idParam     string
+	parentParam string
+	parentKind  *kind
+
+	newID func() string
+
+	// required lists the properties that create and replace operations must provide,
+	// writable the properties that they can set and patchable those that update operations can set.
+	required  []string
+	writable  []string
+	patchable []string
+
+	// requireIfMatch rejects replace operations without an If-Match header.
+	requireIfMatch bool
+
+	// typeProperty holds the type of a resource. Resources of the protectedType can not be
+	// replaced, updated or deleted.
+	typeProperty  string
+	protectedType string
+
+	// The properties that record the creation and the last update of a resource.
+	createdOn, createdBy, updatedOn, updatedBy string
+
+	// initialize sets the properties that the server assigns to a new resource.
+	initialize func(server *Server, instanceID string, id string, data map[string]interface{})
+
+	// derive recomputes properties derived from the content of a resource.
+	derive func(data map[string]interface{})
+
+	// deleteReturnsResource makes delete operations respond with the deleted resource
+	// instead of an empty "204 No Content" response.
+	deleteReturnsResource bool
+
+	// beforeDelete can reject the deletion of a resource or clean up dependent resources.
+	// afterWrite is invoked after a resource is created or deleted.
+	beforeDelete func(req *request, r *record) error
+	afterWrite   func(req *request, parent string)
+}
+
+var (
+	ruleKind = &kind{
+		name:           string(CollectionRules),
+		singular:       "rule",
+		idParam:        "rule_id",
+		newID:          func() string { return "rule-" + uuid.NewString() },
+		required:       []string{"description", "target", "required_config"},
+		writable:       []string{"description", "target", "required_config", "version", "import", "labels"},
+		requireIfMatch: true,
+		typeProperty:   "type",
+		protectedType:  "system_defined",
+		createdOn:      "created_on", createdBy: "created_by", updatedOn: "updated_on", updatedBy: "updated_by",
+		initialize: func(server *Server, instanceID string, id string, data map[string]interface{}) {
+			setDefault(data, "account_id", server.AccountID)
+			setDefault(data, "type", "user_defined")
+			setDefault(data, "version", "1.0.0")
+			setDefault(data, "labels", []interface{}{})
+		},
+	}
+
+	controlLibraryKind = &kind{
+		name:          string(CollectionControlLibraries),
+		singular:      "control library",
+		idParam:       "control_library_id",
+		newID:         uuid.NewString,
+		required:      []string{"control_library_name", "control_library_type", "controls"},
+		writable:      []string{"control_library_name", "control_library_description", "control_library_version", "controls"},
+		typeProperty:  "control_library_type",
+		protectedType: "predefined",
+		createdOn:     "created_on", createdBy: "created_by", updatedOn: "updated_on", updatedBy: "updated_by",
+		initialize: func(server *Server, instanceID string, id string, data map[string]interface{}) {
+			setDefault(data, "account_id", server.AccountID)
+			setDefault(data, "control_library_type", "custom")
+			setDefault(data, "version_group_label", uuid.NewString())
+			setDefault(data, "latest", true)
+			setDefault(data, "hierarchy_enabled", false)
+		},
+		derive: func(data map[string]interface{}) {
+			controls, _ := data["controls"].([]interface{})
+			data["controls_count"] = len(controls)
+			data["control_parents_count"] = 0
+
+			// Like the service, identify the controls by name so that their IDs survive a replacement,
+			// and return the control specifications with the property names of the response.
+			libraryID, _ := data["id"].(string)
+			for _, control := range controls {
+				control, ok := control.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name, _ := control["control_name"].(string)
+				setDefault(control, "control_id", uuid.NewSHA1(uuid.NameSpaceURL, []byte(libraryID+"/"+name)).String())
+				specifications, _ := control["control_specifications"].([]interface{})
+				for _, specification := range specifications {
+					if specification, ok := specification.(map[string]interface{}); ok {
+						renameProperty(specification, "control_specification_id", "id")
+						renameProperty(specification, "control_specification_description", "description")
+					}
+				}
+			}
+		},
+		deleteReturnsResource: true,
+	}
+
+	profileKind = &kind{
+		name:          string(CollectionProfiles),
+		singular:      "profile",
+		idParam:       "profile_id",
+		newID:         uuid.NewString,
+		required:      []string{"profile_name", "controls"},
+		writable:      []string{"profile_name", "profile_description", "profile_version", "version_group_label", "latest", "hierarchy_enabled", "controls", "default_parameters"},
+		typeProperty:  "profile_type",
+		protectedType: "predefined",
+		createdOn:     "created_on", createdBy: "created_by", updatedOn: "updated_on", updatedBy: "updated_by",
+		initialize: func(server *Server, instanceID string, id string, data map[string]interface{}) {
+			data["instance_id"] = instanceID
+			setDefault(data, "profile_type", "custom")
+			setDefault(data, "version_group_label", uuid.NewString())
+			setDefault(data, "latest", true)
+			setDefault(data, "hierarchy_enabled", false)
+			setDefault(data, "default_parameters", []interface{}{})
+			setDefault(data, "attachments_count", 0)
+		},
+		derive: func(data map[string]interface{}) {
+			controls, _ := data["controls"].([]interface{})
+			data["controls_count"] = len(controls)
+		},
+		deleteReturnsResource: true,
+		beforeDelete: func(req *request, r *record) error {
+			if len(req.instance.collection(string(CollectionAttachments)).list(r.id, nil)) > 0 {
+				return newError(http.StatusConflict, "conflict",
+					"The profile %s can not be deleted because it has attachments.", r.id)
+			}
+			return nil
+		},
+	}
+
+	attachmentKind = &kind{
+		name:        string(CollectionAttachments),
+		singular:    "attachment",
+		idParam:     "attachment_id",
+		parentParam: "profile_id",
+		parentKind:  profileKind,
+		newID:       uuid.NewString,
+		required:    []string{"name", "scope"},
+		writable:    []string{"attachment_parameters", "description", "name", "notifications", "schedule", "scope", "status", "data_selection_range"},
+		createdOn:   "created_on", createdBy: "created_by", updatedOn: "updated_on", updatedBy: "updated_by",
+		initialize: func(server *Server, instanceID string, id string, data map[string]interface{}) {
+			data["instance_id"] = instanceID
+			setDefault(data, "account_id", server.AccountID)
+			setDefault(data, "attachment_parameters", []interface{}{})
+			setDefault(data, "schedule", "daily")
+			setDefault(data, "status", "enabled")
+		},
+		deleteReturnsResource: true,
+		afterWrite: func(req *request, parent string) {
+			// Keep the attachment count of the profile up to date.
+			profiles := req.instance.collection(string(CollectionProfiles))
+			if profile := profiles.get("", parent); profile != nil {
+				profile.data["attachments_count"] = len(req.instance.collection(string(CollectionAttachments)).list(parent, nil))
+				profiles.put(profile)
+			}
+		},
+	}
+
+	scopeKind = &kind{
+		name:      string(CollectionScopes),
+		singular:  "scope",
+		idParam:   "scope_id",
+		newID:     uuid.NewString,
+		writable:  []string{"name", "description", "environment", "properties"},
+		patchable: []string{"name", "description"},
+		createdOn: "created_on", createdBy: "created_by", updatedOn: "updated_on", updatedBy: "updated_by",
+		initialize: func(server *Server, instanceID string, id string, data map[string]interface{}) {
+			data["instance_id"] = instanceID
+			setDefault(data, "account_id", server.AccountID)
+			setDefault(data, "description", "")
+			setDefault(data, "properties", []interface{}{})
+			setDefault(data, "attachment_count", 0)
+		},
+		beforeDelete: func(req *request, r *record) error {
+			subscopes := req.instance.collection(string(CollectionSubscopes))
+			for _, subscope := range subscopes.list(r.id, nil) {
+				subscopes.remove(subscope.id)
+			}
+			return nil
+		},
+	}
+
+	subscopeKind = &kind{
+		name:        string(CollectionSubscopes),
+		singular:    "subscope",
+		idParam:     "subscope_id",
+		parentParam: "scope_id",
+		parentKind:  scopeKind,
+		newID:       uuid.NewString,
+		writable:    []string{"name", "description", "environment", "properties"},
+		patchable:   []string{"name", "description"},
+		initialize: func(server *Server, instanceID string, id string, data map[string]interface{}) {
+			setDefault(data, "properties", []interface{}{})
+		},
+	}
+
+	targetKind = &kind{
+		name:      string(CollectionTargets),
+		singular:  "target",
+		idParam:   "target_id",
+		newID:     uuid.NewString,
+		required:  []string{"account_id", "trusted_profile_id", "name"},
+		writable:  []string{"account_id", "trusted_profile_id", "name", "credentials"},
+		createdOn: "created_on", createdBy: "created_by", updatedOn: "updated_on", updatedBy: "updated_by",
+		initialize: func(server *Server, instanceID string, id string, data map[string]interface{}) {
+			setDefault(data, "credentials", []interface{}{})
+		},
+	}
+
+	providerTypeInstanceKind = &kind{
+		name:        string(CollectionProviderTypeInstances),
+		singular:    "provider type instance",
+		idParam:     "provider_type_instance_id",
+		parentParam: "provider_type_id",
+		newID:       uuid.NewString,
+		writable:    []string{"name", "attributes"},
+		patchable:   []string{"name", "attributes"},
+		createdOn:   "created_at", updatedOn: "updated_at",
+		initialize: func(server *Server, instanceID string, id string, data map[string]interface{}) {
+			setDefault(data, "attributes", map[string]interface{}{})
+		},
+	}
+)
+
+// seedableCollections are the collections whose resources can be stored with Server.Seed.
+var seedableCollections = map[Collection]*kind{
+	CollectionRules:            ruleKind,
+	CollectionControlLibraries: controlLibraryKind,
+	CollectionProfiles:         profileKind,
+	CollectionScopes:           scopeKind,
+	CollectionTargets:          targetKind,
+}
+
+// routes returns the handler of all of the operations implemented by the server.
+func (server *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	const instance = "/instances/{instance_id}/v3"
+
+	server.handle(mux, "GET "+instance+"/rules", ruleKind.list(ruleFilter))
+	server.handle(mux, "POST "+instance+"/rules", ruleKind.create())
+	server.handle(mux, "GET "+instance+"/rules/{rule_id}", ruleKind.get())
+	server.handle(mux, "PUT "+instance+"/rules/{rule_id}", ruleKind.replace())
+	server.handle(mux, "DELETE "+instance+"/rules/{rule_id}", ruleKind.delete())
+
+	server.handle(mux, "GET "+instance+"/control_libraries", controlLibraryKind.list(nil))
+	server.handle(mux, "POST "+instance+"/control_libraries", controlLibraryKind.create())
+	server.handle(mux, "GET "+instance+"/control_libraries/{control_library_id}", controlLibraryKind.get())
+	server.handle(mux, "PUT "+instance+"/control_libraries/{control_library_id}", controlLibraryKind.replace())
+	server.handle(mux, "DELETE "+instance+"/control_libraries/{control_library_id}", controlLibraryKind.delete())
+
+	server.handle(mux, "GET "+instance+"/profiles", profileKind.list(nil))
+	server.handle(mux, "POST "+instance+"/profiles", profileKind.create())
+	server.handle(mux, "GET "+instance+"/profiles/{profile_id}", profileKind.get())
+	server.handle(mux, "PUT "+instance+"/profiles/{profile_id}", profileKind.replace())
+	server.handle(mux, "DELETE "+instance+"/profiles/{profile_id}", profileKind.delete())
+
+	server.handle(mux, "GET "+instance+"/attachments", attachmentKind.list(nil))
+	server.handle(mux, "GET "+instance+"/profiles/{profile_id}/attachments", attachmentKind.list(nil))
+	server.handle(mux, "POST "+instance+"/profiles/{profile_id}/attachments", attachmentKind.createMany("attachments"))
+	server.handle(mux, "GET "+instance+"/profiles/{profile_id}/attachments/{attachment_id}", attachmentKind.get())
+	server.handle(mux, "PUT "+instance+"/profiles/{profile_id}/attachments/{attachment_id}", attachmentKind.replace())
+	server.handle(mux, "DELETE "+instance+"/profiles/{profile_id}/attachments/{attachment_id}", attachmentKind.delete())
+
+	server.handle(mux, "GET "+instance+"/scopes", scopeKind.list(scopeFilter))
+	server.handle(mux, "POST "+instance+"/scopes", scopeKind.create())
+	server.handle(mux, "GET "+instance+"/scopes/{scope_id}", scopeKind.get())
+	server.handle(mux, "PATCH "+instance+"/scopes/{scope_id}", scopeKind.update())
+	server.handle(mux, "DELETE "+instance+"/scopes/{scope_id}", scopeKind.delete())
+
+	server.handle(mux, "GET "+instance+"/scopes/{scope_id}/subscopes", subscopeKind.list(scopeFilter))
+	server.handle(mux, "POST "+instance+"/scopes/{scope_id}/subscopes", subscopeKind.createMany("subscopes"))
+	server.handle(mux, "GET "+instance+"/scopes/{scope_id}/subscopes/{subscope_id}", subscopeKind.get())
+	server.handle(mux, "PATCH "+instance+"/scopes/{scope_id}/subscopes/{subscope_id}", subscopeKind.update())
+	server.handle(mux, "DELETE "+instance+"/scopes/{scope_id}/subscopes/{subscope_id}", subscopeKind.delete())
+
+	server.handle(mux, "GET "+instance+"/targets", targetKind.list(nil))
+	server.handle(mux, "POST "+instance+"/targets", targetKind.create())
+	server.handle(mux, "GET "+instance+"/targets/{target_id}", targetKind.get())
+	server.handle(mux, "PUT "+instance+"/targets/{target_id}", targetKind.replace())
+	server.handle(mux, "DELETE "+instance+"/targets/{target_id}", targetKind.delete())
+
+	const providerTypeInstances = instance + "/provider_types/{provider_type_id}/provider_type_instances"
+	server.handle(mux, "GET "+providerTypeInstances, providerTypeInstanceKind.list(nil))
+	server.handle(mux, "POST "+providerTypeInstances, providerTypeInstanceKind.create())
+	server.handle(mux, "GET "+providerTypeInstances+"/{provider_type_instance_id}", providerTypeInstanceKind.get())
+	server.handle(mux, "PATCH "+providerTypeInstances+"/{provider_type_instance_id}", providerTypeInstanceKind.update())
+	server.handle(mux, "DELETE "+providerTypeInstances+"/{provider_type_instance_id}", providerTypeInstanceKind.delete())
+
+	server.handle(mux, "GET "+instance+"/settings", getSettings)
+	server.handle(mux, "PATCH "+instance+"/settings", updateSettings)
+
+	server.handle(mux, "/", func(req *request) (int, interface{}, error) {
+		return 0, nil, notFound("The path %s is not implemented by the fake server.", req.URL.Path)
+	})
+	return mux
+}
+
+// ruleFilter implements the "type", "search" and "service_name" query parameters of ListRules.
+func ruleFilter(query url.Values) func(data map[string]interface{}) bool {
+	ruleType := query.Get("type")
+	search := strings.ToLower(query.Get("search"))
+	serviceName := query.Get("service_name")
+	return func(data map[string]interface{}) bool {
+		if ruleType != "" && data["type"] != ruleType {
+			return false
+		}
+		if search != "" {
+			description, _ := data["description"].(string)
+			if !strings.Contains(strings.ToLower(description), search) {
+				return false
+			}
+		}
+		if serviceName != "" {
+			target, _ := data["target"].(map[string]interface{})
+			if target["service_name"] != serviceName {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// scopeFilter implements the "name", "description" and "environment" query parameters of
+// ListScopes and ListSubscopes.
+func scopeFilter(query url.Values) func(data map[string]interface{}) bool {
+	return func(data map[string]interface{}) bool {
+		for _, name := range []string{"name", "description", "environment"} {
+			if value := query.Get(name); value != "" && data[name] != value {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// parent returns the ID of the enclosing resource of the request and verifies that it exists.
+func (k *kind) parent(req *request) (string, error) {
+	if k.parentParam == "" {
+		return "", nil
+	}
+	parent := req.PathValue(k.parentParam)
+	if parent != "" && k.parentKind != nil && req.instance.collection(k.parentKind.name).get("", parent) == nil {
+		return "", notFound("The %s %s was not found.", k.parentKind.singular, parent)
+	}
+	return parent, nil
+}
+
+// find returns the record addressed by the request.
+func (k *kind) find(req *request) (*record, error) {
+	parent, err := k.parent(req)
+	if err != nil {
+		return nil, err
+	}
+	id := req.PathValue(k.idParam)
+	r := req.instance.collection(k.name).get(parent, id)
+	if r == nil {
+		return nil, notFound("The %s %s was not found.", k.singular, id)
+	}
+	return r, nil
+}
+
+// checkModifiable rejects changes to protected resources and verifies the If-Match header.
+func (k *kind) checkModifiable(req *request, r *record) error {
+	if k.typeProperty != "" && r.data[k.typeProperty] == k.protectedType {
+		return newError(http.StatusForbidden, "forbidden", "The %s %s is %s and can not be modified.", k.singular, r.id, k.protectedType)
+	}
+	return req.checkIfMatch(r)
+}
+
+// checkRequired verifies that the data contains all of the required properties.
+func (k *kind) checkRequired(data map[string]interface{}) error {
+	for _, name := range k.required {
+		if _, ok := data[name]; !ok {
+			return badRequest("The property %s is required.", name)
+		}
+	}
+	return nil
+}
+
+// newRecord creates a resource of the kind from the writable properties of "body".
+func (k *kind) newRecord(req *request, parent string, body map[string]interface{}) (*record, error) {
+	if err := k.checkRequired(body); err != nil {
+		return nil, err
+	}
+	id := k.newID()
+	data := map[string]interface{}{"id": id}
+	copyProperties(data, body, k.writable)
+	if k.typeProperty != "" {
+		if value, ok := body[k.typeProperty]; ok && value != k.protectedType {
+			data[k.typeProperty] = value
+		}
+	}
+	switch k.parentParam {
+	case "profile_id":
+		data["profile_id"] = parent
+	case "provider_type_id":
+		data["type"] = parent
+	}
+	k.initialize(req.server, req.PathValue("instance_id"), id, data)
+	k.touch(req, data, true)
+	return &record{id: id, parent: parent, data: data}, nil
+}
+
+// touch records the creation or the last update of a resource and recomputes its derived properties.
+func (k *kind) touch(req *request, data map[string]interface{}, created bool) {
+	now := req.server.timestamp()
+	if created {
+		setProperty(data, k.createdOn, now)
+		setProperty(data, k.createdBy, req.server.UserID)
+	}
+	setProperty(data, k.updatedOn, now)
+	setProperty(data, k.updatedBy, req.server.UserID)
+	if k.derive != nil {
+		k.derive(data)
+	}
+}
+
+// list returns the handler of the list operation of the kind.
+func (k *kind) list(filter func(query url.Values) func(data map[string]interface{}) bool) handlerFunc {
+	return func(req *request) (int, interface{}, error) {
+		parent, err := k.parent(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		var match func(data map[string]interface{}) bool
+		if filter != nil {
+			match = filter(req.URL.Query())
+		}
+		items, result, err := req.page(req.instance.collection(k.name).list(parent, match))
+		if err != nil {
+			return 0, nil, err
+		}
+		result[k.name] = items
+		return http.StatusOK, result, nil
+	}
+}
+
+// create returns the handler of the create operation of the kind.
+func (k *kind) create() handlerFunc {
+	return func(req *request) (int, interface{}, error) {
+		parent, err := k.parent(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		body, err := req.decodeBody()
+		if err != nil {
+			return 0, nil, err
+		}
+		r, err := k.newRecord(req, parent, body)
+		if err != nil {
+			return 0, nil, err
+		}
+		req.instance.collection(k.name).put(r)
+		if k.afterWrite != nil {
+			k.afterWrite(req, parent)
+		}
+		req.header.Set("ETag", r.etag)
+		return http.StatusCreated, r.data, nil
+	}
+}
+
+// createMany returns the handler of an operation that creates several resources of the kind at once.
+// The resources are passed in, and returned in, the "property" array of the request and response.
+func (k *kind) createMany(property string) handlerFunc {
+	return func(req *request) (int, interface{}, error) {
+		parent, err := k.parent(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		body, err := req.decodeBody()
+		if err != nil {
+			return 0, nil, err
+		}
+		items, _ := body[property].([]interface{})
+		if len(items) == 0 {
+			return 0, nil, badRequest("The property %s must contain at least one item.", property)
+		}
+
+		// Validate all of the items before storing any of them.
+		records := make([]*record, 0, len(items))
+		for _, item := range items {
+			data, ok := item.(map[string]interface{})
+			if !ok {
+				return 0, nil, badRequest("The items of the property %s must be objects.", property)
+			}
+			r, err := k.newRecord(req, parent, data)
+			if err != nil {
+				return 0, nil, err
+			}
+			records = append(records, r)
+		}
+
+		created := make([]interface{}, 0, len(records))
+		for _, r := range records {
+			req.instance.collection(k.name).put(r)
+			created = append(created, r.data)
+		}
+		if k.afterWrite != nil {
+			k.afterWrite(req, parent)
+		}
+		result := map[string]interface{}{property: created}
+		if k.parentParam == "profile_id" {
+			result["profile_id"] = parent
+		}
+		return http.StatusCreated, result, nil
+	}
+}
+
+// get returns the handler of the get operation of the kind.
+func (k *kind) get() handlerFunc {
+	return func(req *request) (int, interface{}, error) {
+		r, err := k.find(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		req.header.Set("ETag", r.etag)
+		return http.StatusOK, r.data, nil
+	}
+}
+
+// replace returns the handler of the replace (PUT) operation of the kind,
+// which replaces all of the writable properties of a resource.
+func (k *kind) replace() handlerFunc {
+	return func(req *request) (int, interface{}, error) {
+		r, err := k.find(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		if k.requireIfMatch && req.Header.Get("If-Match") == "" {
+			return 0, nil, newError(http.StatusPreconditionRequired, "precondition_required", "The If-Match header is required.")
+		}
+		if err := k.checkModifiable(req, r); err != nil {
+			return 0, nil, err
+		}
+		body, err := req.decodeBody()
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := k.checkRequired(body); err != nil {
+			return 0, nil, err
+		}
+
+		data := make(map[string]interface{}, len(r.data))
+		for name, value := range r.data {
+			data[name] = value
+		}
+		for _, name := range k.writable {
+			delete(data, name)
+		}
+		copyProperties(data, body, k.writable)
+		k.initialize(req.server, req.PathValue("instance_id"), r.id, data)
+		k.touch(req, data, false)
+		return k.store(req, r, data)
+	}
+}
+
+// update returns the handler of the update (PATCH) operation of the kind,
+// which changes the patchable properties that are present in the request.
+func (k *kind) update() handlerFunc {
+	return func(req *request) (int, interface{}, error) {
+		r, err := k.find(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := k.checkModifiable(req, r); err != nil {
+			return 0, nil, err
+		}
+		body, err := req.decodeBody()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		data := make(map[string]interface{}, len(r.data))
+		for name, value := range r.data {
+			data[name] = value
+		}
+		copyProperties(data, body, k.patchable)
+		k.touch(req, data, false)
+		return k.store(req, r, data)
+	}
+}
+
+// store saves the new content of a resource and returns it with its new ETag.
+func (k *kind) store(req *request, r *record, data map[string]interface{}) (int, interface{}, error) {
+	updated := &record{id: r.id, parent: r.parent, data: data}
+	req.instance.collection(k.name).put(updated)
+	req.header.Set("ETag", updated.etag)
+	return http.StatusOK, updated.data, nil
+}
+
+// delete returns the handler of the delete operation of the kind.
+func (k *kind) delete() handlerFunc {
+	return func(req *request) (int, interface{}, error) {
+		r, err := k.find(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := k.checkModifiable(req, r); err != nil {
+			return 0, nil, err
+		}
+		if k.beforeDelete != nil {
+			if err := k.beforeDelete(req, r); err != nil {
+				return 0, nil, err
+			}
+		}
+		req.instance.collection(k.name).remove(r.id)
+		if k.afterWrite != nil {
+			k.afterWrite(req, r.parent)
+		}
+		if k.deleteReturnsResource {
+			return http.StatusOK, r.data, nil
+		}
+		return http.StatusNoContent, nil, nil
+	}
+}
+
+// getSettings implements GetSettings.
+func getSettings(req *request) (int, interface{}, error) {
+	r := settings(req)
+	req.header.Set("ETag", r.etag)
+	return http.StatusOK, r.data, nil
+}
+
+// updateSettings implements UpdateSettings, which replaces the object storage and
+// event notifications settings that are present in the request.
+func updateSettings(req *request) (int, interface{}, error) {
+	r := settings(req)
+	if err := req.checkIfMatch(r); err != nil {
+		return 0, nil, err
+	}
+	body, err := req.decodeBody()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	data := make(map[string]interface{}, len(r.data))
+	for name, value := range r.data {
+		data[name] = value
+	}
+	copyProperties(data, body, []string{"object_storage", "event_notifications"})
+	updated := &record{id: settingsID, data: data}
+	req.instance.collection(collectionSettings).put(updated)
+	req.header.Set("ETag", updated.etag)
+	return http.StatusOK, updated.data, nil
+}
+
+// settings returns the settings of the instance, which are initially empty.
+func settings(req *request) *record {
+	c := req.instance.collection(collectionSettings)
+	r := c.get("", settingsID)
+	if r == nil {
+		r = &record{id: settingsID, data: map[string]interface{}{}}
+		c.put(r)
+	}
+	return r
+}
+
+// copyProperties copies the named properties that are present in "from" to "to".
+func copyProperties(to map[string]interface{}, from map[string]interface{}, names []string) {
+	for _, name := range names {
+		if value, ok := from[name]; ok {
+			to[name] = value
+		}
+	}
+}
+
+func setDefault(data map[string]interface{}, name string, value interface{}) {
+	if _, ok := data[name]; !ok {
+		data[name] = value
+	}
+}
+
+func renameProperty(data map[string]interface{}, name string, newName string) {
+	if value, ok := data[name]; ok {
+		delete(data, name)
+		data[newName] = value
+	}
+}
+
+func setProperty(data map[string]interface{}, name string, value interface{}) {
+	if name != "" {
+		data[name] = value
+	}
+}
@@ -0,0 +1,425 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package scctest provides an in-memory fake of the Security and Compliance Center API
+// for unit tests of code that is built on top of the securityandcompliancecenterapiv3 package.
+//
+// The fake server keeps its state in memory and implements the rules, control libraries,
+// profiles, attachments, scopes, subscopes, targets, provider type instances and settings
+// operations with the status codes, ETag/If-Match semantics and "next.start" pagination
+// tokens of the real service:
+//
+//	server := scctest.NewServer()
+//	defer server.Close()
+//
+//	service, err := server.NewService()
+//	rule, _, err := service.CreateRule(service.NewCreateRuleOptions(instanceID, ...))
+//
+// Every instance ID is accepted and has its own, initially empty, state.
+package scctest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultAccountID is the account ID that the server assigns to new resources.
+	DefaultAccountID = "130003ea8bfa43c5aacea07a86da3000"
+
+	// DefaultUserID is the user ID that the server records as the creator and updater of resources.
+	DefaultUserID = "IBMid-scctest"
+
+	// DefaultLimit is the page size of list operations that are invoked without a "limit".
+	DefaultLimit = 50
+
+	// MaxLimit is the largest "limit" accepted by list operations.
+	MaxLimit = 200
+)
+
+// Server is a stateful, in-memory fake of the Security and Compliance Center API.
+// The exported fields must not be modified after the first request has been served.
+type Server struct {
+	*httptest.Server
+
+	// AccountID is the account ID assigned to new resources.
+	AccountID string
+
+	// UserID is recorded in the "created_by" and "updated_by" fields of resources.
+	UserID string
+
+	// Now returns the time recorded in the "created_on" and "updated_on" fields of resources.
+	Now func() time.Time
+
+	mutex     sync.Mutex
+	instances map[string]*instance
+}
+
+// NewServer starts and returns a new Server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	server := NewUnstartedServer()
+	server.Start()
+	return server
+}
+
+// NewUnstartedServer returns a new Server but doesn't start it.
+// After changing its configuration, the caller should call Start or StartTLS.
+func NewUnstartedServer() *Server {
+	server := &Server{
+		AccountID: DefaultAccountID,
+		UserID:    DefaultUserID,
+		Now:       time.Now,
+		instances: make(map[string]*instance),
+	}
+	server.Server = httptest.NewUnstartedServer(server.routes())
+	return server
+}
+
+// NewService returns a SecurityAndComplianceCenterAPIV3 service that sends its requests to the server.
+func (server *Server) NewService() (*securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3, error) {
+	return securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// Reset discards the state of all instances.
+func (server *Server) Reset() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.instances = make(map[string]*instance)
+}
+
+// Seed stores "resource", a model such as a Rule, ControlLibrary, Profile, Scope or Target,
+// in the given collection of the instance and returns its ID.
+// Unlike the create operations, Seed keeps the ID and type of the resource when they are set,
+// which makes it possible to store system defined rules and predefined profiles and control libraries.
+func (server *Server) Seed(instanceID string, collection Collection, resource interface{}) (string, error) {
+	kind, ok := seedableCollections[collection]
+	if !ok {
+		return "", fmt.Errorf("scctest: collection %q can not be seeded", collection)
+	}
+	data, err := toObject(resource)
+	if err != nil {
+		return "", err
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	inst := server.instance(instanceID)
+	id, _ := data["id"].(string)
+	if id == "" {
+		id = kind.newID()
+	}
+	if inst.collection(kind.name).get("", id) != nil {
+		return "", fmt.Errorf("scctest: %s %q already exists", kind.singular, id)
+	}
+	data["id"] = id
+	kind.initialize(server, instanceID, id, data)
+	now := server.timestamp()
+	for _, name := range []string{kind.createdOn, kind.updatedOn} {
+		if name != "" {
+			setDefault(data, name, now)
+		}
+	}
+	for _, name := range []string{kind.createdBy, kind.updatedBy} {
+		if name != "" {
+			setDefault(data, name, server.UserID)
+		}
+	}
+	if kind.derive != nil {
+		kind.derive(data)
+	}
+	inst.collection(kind.name).put(&record{id: id, data: data})
+	return id, nil
+}
+
+// SetSettings replaces the settings of the instance.
+func (server *Server) SetSettings(instanceID string, settings *securityandcompliancecenterapiv3.Settings) error {
+	data, err := toObject(settings)
+	if err != nil {
+		return err
+	}
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.instance(instanceID).collection(collectionSettings).put(&record{id: settingsID, data: data})
+	return nil
+}
+
+// Len returns the number of resources in the given collection of the instance.
+func (server *Server) Len(instanceID string, collection Collection) int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return len(server.instance(instanceID).collection(string(collection)).list("", nil))
+}
+
+// instance returns the state of an instance, creating it on first use.
+// The caller must hold the mutex.
+func (server *Server) instance(instanceID string) *instance {
+	inst, ok := server.instances[instanceID]
+	if !ok {
+		inst = &instance{collections: make(map[string]*collection)}
+		server.instances[instanceID] = inst
+	}
+	return inst
+}
+
+// timestamp returns the current time in the format used by the service.
+func (server *Server) timestamp() string {
+	return server.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// request is an incoming request together with the state of the instance it addresses.
+type request struct {
+	*http.Request
+	server   *Server
+	instance *instance
+	header   http.Header
+}
+
+// handlerFunc serves a request and returns the status code and the body of the response.
+type handlerFunc func(req *request) (int, interface{}, error)
+
+// handle registers a handler for the pattern.
+// Requests are serialized, and their responses are written while holding the mutex,
+// so handlers can access and return the state of the server without further locking.
+func (server *Server) handle(mux *http.ServeMux, pattern string, handler handlerFunc) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		if requestID := r.Header.Get("X-Request-Id"); requestID != "" {
+			w.Header().Set("X-Request-Id", requestID)
+		}
+
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		req := &request{
+			Request: r,
+			server:  server,
+			header:  w.Header(),
+		}
+		if instanceID := r.PathValue("instance_id"); instanceID != "" {
+			req.instance = server.instance(instanceID)
+		}
+		status, body, err := handler(req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, status, body)
+	})
+}
+
+// apiError is an error response of the server.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func newError(status int, code string, format string, args ...interface{}) error {
+	return &apiError{status: status, code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return newError(http.StatusBadRequest, "bad_request", format, args...)
+}
+
+func notFound(format string, args ...interface{}) error {
+	return newError(http.StatusNotFound, "not_found", format, args...)
+}
+
+// writeError writes an error in the format of the service:
+// a status code, a trace ID and a list of errors with a code and a message.
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = &apiError{status: http.StatusInternalServerError, code: "internal_error", message: err.Error()}
+	}
+	writeJSON(w, e.status, map[string]interface{}{
+		"status_code": e.status,
+		"trace":       uuid.NewString(),
+		"errors": []map[string]string{
+			{"code": e.code, "message": e.message},
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decodeBody decodes the JSON object in the body of the request.
+// Properties with a null value are discarded.
+func (req *request) decodeBody() (map[string]interface{}, error) {
+	decoder := json.NewDecoder(req.Body)
+	decoder.UseNumber()
+	var body map[string]interface{}
+	if err := decoder.Decode(&body); err != nil {
+		return nil, badRequest("The request body is not a valid JSON object: %s", err.Error())
+	}
+	for name, value := range body {
+		if value == nil {
+			delete(body, name)
+		}
+	}
+	return body, nil
+}
+
+// checkIfMatch verifies the "If-Match" header of the request against the ETag of the record.
+// A missing header or "*" matches any version of the record.
+func (req *request) checkIfMatch(r *record) error {
+	ifMatch := req.Header.Get("If-Match")
+	if ifMatch == "" {
+		return nil
+	}
+	for _, etag := range strings.Split(ifMatch, ",") {
+		etag = strings.TrimSpace(etag)
+		if etag == "*" || etag == r.etag {
+			return nil
+		}
+	}
+	return newError(http.StatusPreconditionFailed, "precondition_failed",
+		"The If-Match header %s does not match the current ETag %s of the resource.", ifMatch, r.etag)
+}
+
+// page returns the records of the page that is selected by the "start" and "limit" query parameters,
+// and the "first" and "next" references of the collection.
+func (req *request) page(records []*record) ([]interface{}, map[string]interface{}, error) {
+	query := req.URL.Query()
+
+	limit := DefaultLimit
+	if value := query.Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			return nil, nil, badRequest("The limit %q is not a number between 1 and %d.", value, MaxLimit)
+		}
+	}
+
+	offset := 0
+	if start := query.Get("start"); start != "" {
+		var err error
+		offset, err = decodeStart(start)
+		if err != nil || offset > len(records) {
+			return nil, nil, badRequest("The start token %q is not valid.", start)
+		}
+	}
+
+	end := offset + limit
+	if end > len(records) {
+		end = len(records)
+	}
+	items := make([]interface{}, 0, end-offset)
+	for _, r := range records[offset:end] {
+		items = append(items, r.data)
+	}
+
+	query.Del("start")
+	query.Set("limit", strconv.Itoa(limit))
+	collection := map[string]interface{}{
+		"limit":       limit,
+		"total_count": len(records),
+		"first":       map[string]interface{}{"href": req.href(query)},
+	}
+	if end < len(records) {
+		start := encodeStart(end)
+		query.Set("start", start)
+		collection["next"] = map[string]interface{}{"href": req.href(query), "start": start}
+	}
+	return items, collection, nil
+}
+
+// href returns the absolute URL of the request path with the given query.
+func (req *request) href(query url.Values) string {
+	u := url.URL{Scheme: "http", Host: req.Host, Path: req.URL.Path, RawQuery: query.Encode()}
+	if req.TLS != nil {
+		u.Scheme = "https"
+	}
+	return u.String()
+}
+
+// encodeStart returns the opaque "start" token of the page that begins at "offset".
+func encodeStart(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+func decodeStart(start string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(start)
+	if err != nil {
+		return 0, err
+	}
+	value, ok := strings.CutPrefix(string(data), "offset:")
+	if !ok {
+		return 0, fmt.Errorf("invalid start token")
+	}
+	offset, err := strconv.Atoi(value)
+	if err == nil && offset < 0 {
+		err = fmt.Errorf("invalid start token")
+	}
+	return offset, err
+}
+
+// computeETag returns a strong entity tag derived from the content of a resource.
+func computeETag(data map[string]interface{}) string {
+	var buffer bytes.Buffer
+	_ = json.NewEncoder(&buffer).Encode(data)
+	sum := sha256.Sum256(buffer.Bytes())
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// toObject converts a model into its JSON object representation.
+func toObject(model interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	if object == nil {
+		object = make(map[string]interface{})
+	}
+	return object, nil
+}
@@ -0,0 +1,454 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scctest_test
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3/scctest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Server`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+
+	var server *scctest.Server
+	var service *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+
+	BeforeEach(func() {
+		server = scctest.NewUnstartedServer()
+		server.Now = func() time.Time { return time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC) }
+		server.Start()
+
+		var err error
+		service, err = server.NewService()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newRuleOptions := func(description string) *securityandcompliancecenterapiv3.CreateRuleOptions {
+		target, err := service.NewRuleTargetPrototype("cloud-object-storage", "bucket")
+		Expect(err).To(BeNil())
+		requiredConfig := &securityandcompliancecenterapiv3.RequiredConfigConditionBase{
+			Property: core.StringPtr("public_access_enabled"),
+			Operator: core.StringPtr("is_false"),
+		}
+		return service.NewCreateRuleOptions(instanceID, description, target, requiredConfig)
+	}
+	expectStatus := func(response *core.DetailedResponse, err error, status int) {
+		Expect(err).ToNot(BeNil())
+		Expect(response).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(status))
+	}
+
+	Describe(`Rules`, func() {
+		It(`Create, get, replace and delete a rule`, func() {
+			rule, response, err := service.CreateRule(newRuleOptions("Public access is disabled").SetLabels([]string{"cos"}))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(201))
+			Expect(*rule.ID).To(HavePrefix("rule-"))
+			Expect(*rule.Type).To(Equal("user_defined"))
+			Expect(*rule.AccountID).To(Equal(scctest.DefaultAccountID))
+			Expect(*rule.CreatedBy).To(Equal(scctest.DefaultUserID))
+			Expect(rule.CreatedOn.String()).To(Equal("2025-01-02T03:04:05.000Z"))
+			Expect(rule.Labels).To(Equal([]string{"cos"}))
+			Expect(*rule.Target.ServiceName).To(Equal("cloud-object-storage"))
+			Expect(*rule.RequiredConfig.(*securityandcompliancecenterapiv3.RequiredConfig).Operator).To(Equal("is_false"))
+			etag := response.GetHeaders().Get("ETag")
+			Expect(etag).ToNot(BeEmpty())
+
+			_, response, err = service.GetRule(service.NewGetRuleOptions(instanceID, *rule.ID))
+			Expect(err).To(BeNil())
+			Expect(response.GetHeaders().Get("ETag")).To(Equal(etag))
+
+			replaceOptions := service.NewReplaceRuleOptions(instanceID, *rule.ID, `"stale"`, "Updated", nil, rule.RequiredConfig)
+			replaceOptions.Target, err = service.NewRuleTargetPrototype("cloud-object-storage", "bucket")
+			Expect(err).To(BeNil())
+			_, response, err = service.ReplaceRule(replaceOptions)
+			expectStatus(response, err, http.StatusPreconditionFailed)
+
+			replaced, response, err := service.ReplaceRule(replaceOptions.SetIfMatch(etag))
+			Expect(err).To(BeNil())
+			Expect(*replaced.Description).To(Equal("Updated"))
+			Expect(*replaced.ID).To(Equal(*rule.ID))
+			Expect(replaced.Labels).To(BeEmpty())
+			Expect(response.GetHeaders().Get("ETag")).ToNot(Equal(etag))
+
+			_, response, err = service.ReplaceRule(replaceOptions)
+			expectStatus(response, err, http.StatusPreconditionFailed)
+
+			response, err = service.DeleteRule(service.NewDeleteRuleOptions(instanceID, *rule.ID))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(204))
+
+			_, response, err = service.GetRule(service.NewGetRuleOptions(instanceID, *rule.ID))
+			expectStatus(response, err, http.StatusNotFound)
+			response, err = service.DeleteRule(service.NewDeleteRuleOptions(instanceID, *rule.ID))
+			expectStatus(response, err, http.StatusNotFound)
+		})
+		It(`Reject invalid rules`, func() {
+			options := newRuleOptions("No required config")
+			options.RequiredConfig = nil
+			options.Description = nil
+			_, response, err := service.CreateRule(options)
+			Expect(err).ToNot(BeNil())
+			Expect(response).To(BeNil())
+
+			builder := core.NewRequestBuilder(core.POST)
+			_, err = builder.ResolveRequestURL(server.URL, `/instances/{instance_id}/v3/rules`, map[string]string{"instance_id": instanceID})
+			Expect(err).To(BeNil())
+			_, err = builder.SetBodyContentJSON(map[string]interface{}{"description": "No target"})
+			Expect(err).To(BeNil())
+			request, err := builder.Build()
+			Expect(err).To(BeNil())
+			response, err = service.Service.Request(request, nil)
+			expectStatus(response, err, http.StatusBadRequest)
+		})
+		It(`Page through rules with next.start tokens`, func() {
+			for i := 0; i < 5; i++ {
+				_, _, err := service.CreateRule(newRuleOptions(fmt.Sprintf("Rule %d", i)))
+				Expect(err).To(BeNil())
+			}
+
+			collection, _, err := service.ListRules(service.NewListRulesOptions(instanceID).SetLimit(2))
+			Expect(err).To(BeNil())
+			Expect(*collection.Limit).To(Equal(int64(2)))
+			Expect(*collection.TotalCount).To(Equal(int64(5)))
+			Expect(collection.Rules).To(HaveLen(2))
+			Expect(*collection.First.Href).To(HavePrefix(server.URL))
+			Expect(*collection.Next.Start).ToNot(BeEmpty())
+
+			pager, err := service.NewRulesPager(service.NewListRulesOptions(instanceID).SetLimit(2))
+			Expect(err).To(BeNil())
+			rules, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(rules).To(HaveLen(5))
+			for i, rule := range rules {
+				Expect(*rule.Description).To(Equal(fmt.Sprintf("Rule %d", i)))
+			}
+
+			_, response, err := service.ListRules(service.NewListRulesOptions(instanceID).SetStart("bogus"))
+			expectStatus(response, err, http.StatusBadRequest)
+			_, response, err = service.ListRules(service.NewListRulesOptions(instanceID).SetLimit(1000))
+			expectStatus(response, err, http.StatusBadRequest)
+		})
+		It(`Filter rules and protect system defined rules`, func() {
+			_, err := server.Seed(instanceID, scctest.CollectionRules, map[string]interface{}{
+				"id":              "rule-system",
+				"type":            "system_defined",
+				"description":     "Check whether Cloud Object Storage is encrypted",
+				"target":          map[string]interface{}{"service_name": "cloud-object-storage", "resource_kind": "bucket"},
+				"required_config": map[string]interface{}{"property": "encrypted", "operator": "is_true"},
+			})
+			Expect(err).To(BeNil())
+			_, err = server.Seed(instanceID, scctest.CollectionRules, map[string]interface{}{"id": "rule-system"})
+			Expect(err).ToNot(BeNil())
+			_, _, err = service.CreateRule(newRuleOptions("User rule"))
+			Expect(err).To(BeNil())
+			Expect(server.Len(instanceID, scctest.CollectionRules)).To(Equal(2))
+
+			collection, _, err := service.ListRules(service.NewListRulesOptions(instanceID).SetType("system_defined"))
+			Expect(err).To(BeNil())
+			Expect(collection.Rules).To(HaveLen(1))
+			Expect(*collection.Rules[0].ID).To(Equal("rule-system"))
+
+			collection, _, err = service.ListRules(service.NewListRulesOptions(instanceID).SetSearch("user"))
+			Expect(err).To(BeNil())
+			Expect(collection.Rules).To(HaveLen(1))
+			Expect(*collection.Rules[0].Description).To(Equal("User rule"))
+
+			collection, _, err = service.ListRules(service.NewListRulesOptions(instanceID).SetServiceName("iam-identity"))
+			Expect(err).To(BeNil())
+			Expect(collection.Rules).To(BeEmpty())
+			Expect(collection.Next).To(BeNil())
+
+			response, err := service.DeleteRule(service.NewDeleteRuleOptions(instanceID, "rule-system"))
+			expectStatus(response, err, http.StatusForbidden)
+
+			collection, _, err = service.ListRules(service.NewListRulesOptions("other-instance"))
+			Expect(err).To(BeNil())
+			Expect(collection.Rules).To(BeEmpty())
+
+			server.Reset()
+			Expect(server.Len(instanceID, scctest.CollectionRules)).To(Equal(0))
+		})
+	})
+
+	Describe(`Control libraries, profiles and attachments`, func() {
+		It(`Manage a profile with an attachment`, func() {
+			control, err := service.NewControlPrototype("SC-7", "Boundary protection", "System and communications protection", true, []securityandcompliancecenterapiv3.ControlSpecificationPrototype{})
+			Expect(err).To(BeNil())
+			library, response, err := service.CreateControlLibrary(service.NewCreateControlLibraryOptions(instanceID, "Library", "A custom library", "custom", "1.0.0", []securityandcompliancecenterapiv3.ControlPrototype{*control}))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(201))
+			Expect(*library.ControlsCount).To(Equal(int64(1)))
+			Expect(*library.Latest).To(BeTrue())
+
+			libraries, _, err := service.ListControlLibraries(service.NewListControlLibrariesOptions(instanceID))
+			Expect(err).To(BeNil())
+			Expect(libraries.ControlLibraries).To(HaveLen(1))
+
+			profile, _, err := service.CreateProfile(service.NewCreateProfileOptions(instanceID, "Profile", "1.0.0", []securityandcompliancecenterapiv3.ProfileControlsPrototype{
+				{ControlLibraryID: library.ID, ControlID: core.StringPtr("control-1")},
+			}, []securityandcompliancecenterapiv3.DefaultParameters{}))
+			Expect(err).To(BeNil())
+			Expect(*profile.ProfileType).To(Equal("custom"))
+			Expect(*profile.InstanceID).To(Equal(instanceID))
+			Expect(*profile.ControlsCount).To(Equal(int64(1)))
+
+			scope, err := service.NewMultiCloudScopePayloadByProperties([]securityandcompliancecenterapiv3.ScopePropertyIntf{})
+			Expect(err).To(BeNil())
+			attachment, err := service.NewProfileAttachmentBase([]securityandcompliancecenterapiv3.Parameter{}, "An attachment", "Attachment", &securityandcompliancecenterapiv3.AttachmentNotifications{Enabled: core.BoolPtr(false)}, "every_30_days", []securityandcompliancecenterapiv3.MultiCloudScopePayloadIntf{scope}, "enabled")
+			Expect(err).To(BeNil())
+			attachments, response, err := service.CreateProfileAttachment(service.NewCreateProfileAttachmentOptions(instanceID, *profile.ID, []securityandcompliancecenterapiv3.ProfileAttachmentBase{*attachment}))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(201))
+			Expect(*attachments.ProfileID).To(Equal(*profile.ID))
+			Expect(attachments.Attachments).To(HaveLen(1))
+			attachmentID := *attachments.Attachments[0].ID
+			Expect(*attachments.Attachments[0].ProfileID).To(Equal(*profile.ID))
+			Expect(*attachments.Attachments[0].Schedule).To(Equal("every_30_days"))
+
+			_, response, err = service.CreateProfileAttachment(service.NewCreateProfileAttachmentOptions(instanceID, "unknown", []securityandcompliancecenterapiv3.ProfileAttachmentBase{*attachment}))
+			expectStatus(response, err, http.StatusNotFound)
+
+			profile, _, err = service.GetProfile(service.NewGetProfileOptions(instanceID, *profile.ID))
+			Expect(err).To(BeNil())
+			Expect(*profile.AttachmentsCount).To(Equal(int64(1)))
+
+			instanceAttachments, _, err := service.ListInstanceAttachments(service.NewListInstanceAttachmentsOptions(instanceID))
+			Expect(err).To(BeNil())
+			Expect(instanceAttachments.Attachments).To(HaveLen(1))
+			profileAttachments, _, err := service.ListProfileAttachments(service.NewListProfileAttachmentsOptions(instanceID, *profile.ID))
+			Expect(err).To(BeNil())
+			Expect(profileAttachments.Attachments).To(HaveLen(1))
+
+			replaced, _, err := service.ReplaceProfileAttachment(service.NewReplaceProfileAttachmentOptions(instanceID, *profile.ID, attachmentID, []securityandcompliancecenterapiv3.Parameter{}, "Disabled", "Attachment", attachment.Notifications, "daily", attachment.Scope, "disabled"))
+			Expect(err).To(BeNil())
+			Expect(*replaced.Status).To(Equal("disabled"))
+			Expect(*replaced.ID).To(Equal(attachmentID))
+
+			_, response, err = service.DeleteCustomProfile(service.NewDeleteCustomProfileOptions(instanceID, *profile.ID))
+			expectStatus(response, err, http.StatusConflict)
+
+			deleted, response, err := service.DeleteProfileAttachment(service.NewDeleteProfileAttachmentOptions(instanceID, *profile.ID, attachmentID))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(*deleted.ID).To(Equal(attachmentID))
+
+			deletedProfile, _, err := service.DeleteCustomProfile(service.NewDeleteCustomProfileOptions(instanceID, *profile.ID))
+			Expect(err).To(BeNil())
+			Expect(*deletedProfile.AttachmentsCount).To(Equal(int64(0)))
+
+			_, _, err = service.DeleteCustomControlLibrary(service.NewDeleteCustomControlLibraryOptions(instanceID, *library.ID))
+			Expect(err).To(BeNil())
+			Expect(server.Len(instanceID, scctest.CollectionControlLibraries)).To(Equal(0))
+		})
+		It(`Identify the controls of a control library by name`, func() {
+			control, err := service.NewControlPrototype("SC-7", "Boundary protection", "System and communications protection", true, []securityandcompliancecenterapiv3.ControlSpecificationPrototype{
+				{
+					ControlSpecificationID:          core.StringPtr("spec-1"),
+					ControlSpecificationDescription: core.StringPtr("Buckets are private"),
+					ComponentID:                     core.StringPtr("cloud-object-storage"),
+				},
+			})
+			Expect(err).To(BeNil())
+			library, _, err := service.CreateControlLibrary(service.NewCreateControlLibraryOptions(instanceID, "Library", "A custom library", "custom", "1.0.0", []securityandcompliancecenterapiv3.ControlPrototype{*control}))
+			Expect(err).To(BeNil())
+			Expect(library.Controls).To(HaveLen(1))
+			controlID := *library.Controls[0].ControlID
+			Expect(controlID).ToNot(BeEmpty())
+			Expect(library.Controls[0].ControlSpecifications).To(HaveLen(1))
+			specification := library.Controls[0].ControlSpecifications[0]
+			Expect(*specification.ID).To(Equal("spec-1"))
+			Expect(*specification.Description).To(Equal("Buckets are private"))
+
+			other, err := service.NewControlPrototype("SC-8", "Transmission confidentiality", "System and communications protection", true, []securityandcompliancecenterapiv3.ControlSpecificationPrototype{})
+			Expect(err).To(BeNil())
+			replaced, _, err := service.ReplaceCustomControlLibrary(service.NewReplaceCustomControlLibraryOptions(instanceID, *library.ID, "Library", "A custom library", "custom", "1.0.1", []securityandcompliancecenterapiv3.ControlPrototype{*other, *control}))
+			Expect(err).To(BeNil())
+			Expect(replaced.Controls).To(HaveLen(2))
+			Expect(*replaced.Controls[1].ControlID).To(Equal(controlID))
+			Expect(*replaced.Controls[0].ControlID).ToNot(Equal(controlID))
+		})
+		It(`Protect predefined profiles`, func() {
+			profileID, err := server.Seed(instanceID, scctest.CollectionProfiles, &securityandcompliancecenterapiv3.Profile{
+				ProfileName: core.StringPtr("IBM Cloud Framework for Financial Services"),
+				ProfileType: core.StringPtr("predefined"),
+				Controls:    []securityandcompliancecenterapiv3.ProfileControls{},
+			})
+			Expect(err).To(BeNil())
+
+			profile, _, err := service.GetProfile(service.NewGetProfileOptions(instanceID, profileID))
+			Expect(err).To(BeNil())
+			Expect(*profile.ProfileType).To(Equal("predefined"))
+
+			_, response, err := service.ReplaceProfile(service.NewReplaceProfileOptions(instanceID, profileID, "custom", []securityandcompliancecenterapiv3.ProfileControls{}, []securityandcompliancecenterapiv3.DefaultParameters{}).SetNewProfileName("Changed"))
+			expectStatus(response, err, http.StatusForbidden)
+			_, response, err = service.DeleteCustomProfile(service.NewDeleteCustomProfileOptions(instanceID, profileID))
+			expectStatus(response, err, http.StatusForbidden)
+
+			_, err = server.Seed(instanceID, scctest.CollectionAttachments, map[string]interface{}{})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe(`Scopes and subscopes`, func() {
+		It(`Manage a scope with subscopes`, func() {
+			scope, response, err := service.CreateScope(service.NewCreateScopeOptions(instanceID).
+				SetName("Production").
+				SetEnvironment("ibm-cloud"))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(201))
+			Expect(*scope.InstanceID).To(Equal(instanceID))
+
+			subscopes, _, err := service.CreateSubscope(service.NewCreateSubscopeOptions(instanceID, *scope.ID, []securityandcompliancecenterapiv3.ScopePrototype{
+				{Name: core.StringPtr("Team A"), Environment: core.StringPtr("ibm-cloud")},
+				{Name: core.StringPtr("Team B"), Environment: core.StringPtr("ibm-cloud")},
+			}))
+			Expect(err).To(BeNil())
+			Expect(subscopes.Subscopes).To(HaveLen(2))
+			subscopeID := *subscopes.Subscopes[0].ID
+
+			list, _, err := service.ListSubscopes(service.NewListSubscopesOptions(instanceID, *scope.ID).SetName("Team B"))
+			Expect(err).To(BeNil())
+			Expect(list.Subscopes).To(HaveLen(1))
+			Expect(*list.Subscopes[0].Name).To(Equal("Team B"))
+
+			subscope, _, err := service.UpdateSubscope(service.NewUpdateSubscopeOptions(instanceID, *scope.ID, subscopeID).SetDescription("The first team"))
+			Expect(err).To(BeNil())
+			Expect(*subscope.Name).To(Equal("Team A"))
+			Expect(*subscope.Description).To(Equal("The first team"))
+
+			updated, response, err := service.UpdateScope(service.NewUpdateScopeOptions(instanceID, *scope.ID).SetName("Staging"))
+			Expect(err).To(BeNil())
+			Expect(*updated.Name).To(Equal("Staging"))
+			Expect(*updated.Environment).To(Equal("ibm-cloud"))
+			etag := response.GetHeaders().Get("ETag")
+
+			options := service.NewUpdateScopeOptions(instanceID, *scope.ID).SetName("Development")
+			_, response, err = service.UpdateScope(options.SetHeaders(map[string]string{"If-Match": `"stale"`}))
+			expectStatus(response, err, http.StatusPreconditionFailed)
+			_, _, err = service.UpdateScope(options.SetHeaders(map[string]string{"If-Match": etag}))
+			Expect(err).To(BeNil())
+
+			scopes, _, err := service.ListScopes(service.NewListScopesOptions(instanceID).SetName("Development"))
+			Expect(err).To(BeNil())
+			Expect(scopes.Scopes).To(HaveLen(1))
+
+			response, err = service.DeleteScope(service.NewDeleteScopeOptions(instanceID, *scope.ID))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(204))
+			Expect(server.Len(instanceID, scctest.CollectionSubscopes)).To(Equal(0))
+
+			_, response, err = service.GetSubscope(service.NewGetSubscopeOptions(instanceID, *scope.ID, subscopeID))
+			expectStatus(response, err, http.StatusNotFound)
+		})
+	})
+
+	Describe(`Targets and provider type instances`, func() {
+		It(`Manage a target`, func() {
+			target, response, err := service.CreateTarget(service.NewCreateTargetOptions(instanceID, "62ecf99b240144dea9125666249edfcb", "Profile-6bc6b3f3-5d1c-4b2d-9c8e-1e8c6f6f6f6f", "Target"))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(201))
+			Expect(target.Credentials).To(BeEmpty())
+
+			replaced, _, err := service.ReplaceTarget(service.NewReplaceTargetOptions(instanceID, *target.ID, *target.AccountID, *target.TrustedProfileID, "Renamed"))
+			Expect(err).To(BeNil())
+			Expect(*replaced.Name).To(Equal("Renamed"))
+			Expect(replaced.CreatedOn).To(Equal(target.CreatedOn))
+
+			targets, _, err := service.ListTargets(service.NewListTargetsOptions(instanceID))
+			Expect(err).To(BeNil())
+			Expect(targets.Targets).To(HaveLen(1))
+
+			_, err = service.DeleteTarget(service.NewDeleteTargetOptions(instanceID, *target.ID))
+			Expect(err).To(BeNil())
+			_, response, err = service.GetTarget(service.NewGetTargetOptions(instanceID, *target.ID))
+			expectStatus(response, err, http.StatusNotFound)
+		})
+		It(`Manage a provider type instance`, func() {
+			const providerTypeID = "3e25966275dccfa2c3a34786919c5af7"
+			created, response, err := service.CreateProviderTypeInstance(service.NewCreateProviderTypeInstanceOptions(instanceID, providerTypeID).
+				SetName("workload-protection").
+				SetAttributes(map[string]interface{}{"wp_crn": "crn:v1:1"}))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(201))
+			Expect(*created.Type).To(Equal(providerTypeID))
+			Expect(created.CreatedAt).ToNot(BeNil())
+
+			updated, _, err := service.UpdateProviderTypeInstance(service.NewUpdateProviderTypeInstanceOptions(instanceID, providerTypeID, *created.ID).
+				SetAttributes(map[string]interface{}{"wp_crn": "crn:v1:2"}))
+			Expect(err).To(BeNil())
+			Expect(*updated.Name).To(Equal("workload-protection"))
+			Expect(updated.Attributes).To(HaveKeyWithValue("wp_crn", "crn:v1:2"))
+
+			instances, _, err := service.ListProviderTypeInstances(service.NewListProviderTypeInstancesOptions(instanceID, providerTypeID))
+			Expect(err).To(BeNil())
+			Expect(instances.ProviderTypeInstances).To(HaveLen(1))
+			instances, _, err = service.ListProviderTypeInstances(service.NewListProviderTypeInstancesOptions(instanceID, "other"))
+			Expect(err).To(BeNil())
+			Expect(instances.ProviderTypeInstances).To(BeEmpty())
+
+			response, err = service.DeleteProviderTypeInstance(service.NewDeleteProviderTypeInstanceOptions(instanceID, providerTypeID, *created.ID))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(204))
+		})
+	})
+
+	Describe(`Settings`, func() {
+		It(`Get and update the settings`, func() {
+			settings, response, err := service.GetSettings(service.NewGetSettingsOptions(instanceID))
+			Expect(err).To(BeNil())
+			Expect(settings.ObjectStorage).To(BeNil())
+			etag := response.GetHeaders().Get("ETag")
+
+			objectStorage := &securityandcompliancecenterapiv3.ObjectStoragePrototype{
+				InstanceCRN: core.StringPtr("crn:v1:bluemix:public:cloud-object-storage:global:a/130003ea8bfa43c5aacea07a86da3000::"),
+				Bucket:      core.StringPtr("scc-bucket"),
+			}
+			options := service.NewUpdateSettingsOptions(instanceID).SetObjectStorage(objectStorage)
+			settings, _, err = service.UpdateSettings(options.SetHeaders(map[string]string{"If-Match": etag}))
+			Expect(err).To(BeNil())
+			Expect(*settings.ObjectStorage.Bucket).To(Equal("scc-bucket"))
+
+			_, response, err = service.UpdateSettings(options)
+			expectStatus(response, err, http.StatusPreconditionFailed)
+
+			Expect(server.SetSettings(instanceID, &securityandcompliancecenterapiv3.Settings{
+				EventNotifications: &securityandcompliancecenterapiv3.EventNotifications{SourceName: core.StringPtr("scc")},
+			})).To(Succeed())
+			settings, _, err = service.GetSettings(service.NewGetSettingsOptions(instanceID))
+			Expect(err).To(BeNil())
+			Expect(settings.ObjectStorage).To(BeNil())
+			Expect(*settings.EventNotifications.SourceName).To(Equal("scc"))
+		})
+	})
+
+	It(`Respond with 404 to operations that are not implemented`, func() {
+		_, response, err := service.GetLatestReports(service.NewGetLatestReportsOptions(instanceID))
+		expectStatus(response, err, http.StatusNotFound)
+		Expect(err.Error()).To(ContainSubstring("not implemented"))
+	})
+})
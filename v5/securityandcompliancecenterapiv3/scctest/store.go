@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scctest
+
+// Collection identifies a kind of resource stored by the server.
+type Collection string
+
+// The collections of resources stored by the server.
+const (
+	CollectionRules                 Collection = "rules"
+	CollectionControlLibraries      Collection = "control_libraries"
+	CollectionProfiles              Collection = "profiles"
+	CollectionAttachments           Collection = "attachments"
+	CollectionScopes                Collection = "scopes"
+	CollectionSubscopes             Collection = "subscopes"
+	CollectionTargets               Collection = "targets"
+	CollectionProviderTypeInstances Collection = "provider_type_instances"
+)
+
+const (
+	collectionSettings = "settings"
+	settingsID         = "settings"
+)
+
+// record is a stored resource.
+// The parent is the ID of the enclosing resource, such as the profile of an attachment.
+type record struct {
+	id     string
+	parent string
+	data   map[string]interface{}
+	etag   string
+}
+
+// collection holds the records of one kind of resource in creation order.
+type collection struct {
+	records map[string]*record
+	order   []string
+}
+
+// instance is the state of a Security and Compliance Center instance.
+type instance struct {
+	collections map[string]*collection
+}
+
+func (inst *instance) collection(name string) *collection {
+	c, ok := inst.collections[name]
+	if !ok {
+		c = &collection{records: make(map[string]*record)}
+		inst.collections[name] = c
+	}
+	return c
+}
+
+// get returns the record with the ID, or nil if it does not exist or belongs to another parent.
+// An empty parent matches records of any parent.
+func (c *collection) get(parent string, id string) *record {
+	r, ok := c.records[id]
+	if !ok || (parent != "" && r.parent != parent) {
+		return nil
+	}
+	return r
+}
+
+// put stores the record and computes its ETag.
+func (c *collection) put(r *record) {
+	if _, ok := c.records[r.id]; !ok {
+		c.order = append(c.order, r.id)
+	}
+	r.etag = computeETag(r.data)
+	c.records[r.id] = r
+}
+
+// remove deletes the record with the ID.
+func (c *collection) remove(id string) {
+	if _, ok := c.records[id]; !ok {
+		return
+	}
+	delete(c.records, id)
+	for i, existing := range c.order {
+		if existing == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// list returns the records of the parent that satisfy the filter, in creation order.
+// An empty parent matches records of any parent and a nil filter matches all records.
+func (c *collection) list(parent string, filter func(data map[string]interface{}) bool) []*record {
+	var records []*record
+	for _, id := range c.order {
+		r := c.records[id]
+		if parent != "" && r.parent != parent {
+			continue
+		}
+		if filter != nil && !filter(r.data) {
+			continue
+		}
+		records = append(records, r)
+	}
+	return records
+}
@@ -114,18 +114,19 @@ func NewSecurityAndComplianceCenterAPIV3(options *SecurityAndComplianceCenterAPI
 	return
 }
 
+// serviceURLsByRegion maps the regions of the service to their service URLs.
+var serviceURLsByRegion = map[string]string{
+	"us-south": "https://us-south.compliance.cloud.ibm.com", // Dallas region
+	"eu-de":    "https://eu-de.compliance.cloud.ibm.com",    // Frankfurt region
+	"eu-fr2":   "https://eu-fr2.compliance.cloud.ibm.com",   // Frankfurt region(Restricted)
+	"ca-tor":   "https://ca-tor.compliance.cloud.ibm.com",   // Toronto region
+	"au-syd":   "https://au-syd.compliance.cloud.ibm.com",   // Sydney region
+	"eu-es":    "https://eu-es.compliance.cloud.ibm.com",    // Madrid region
+}
+
 // GetServiceURLForRegion returns the service URL to be used for the specified region
 func GetServiceURLForRegion(region string) (string, error) {
-	var endpoints = map[string]string{
-		"us-south": "https://us-south.compliance.cloud.ibm.com", // Dallas region
-		"eu-de":    "https://eu-de.compliance.cloud.ibm.com",    // Frankfurt region
-		"eu-fr2":   "https://eu-fr2.compliance.cloud.ibm.com",   // Frankfurt region(Restricted)
-		"ca-tor":   "https://ca-tor.compliance.cloud.ibm.com",   // Toronto region
-		"au-syd":   "https://au-syd.compliance.cloud.ibm.com",   // Sydney region
-		"eu-es":    "https://eu-es.compliance.cloud.ibm.com",    // Madrid region
-	}
-
-	if url, ok := endpoints[region]; ok {
+	if url, ok := serviceURLsByRegion[region]; ok {
 		return url, nil
 	}
 	return "", core.SDKErrorf(nil, fmt.Sprintf("service URL for region '%s' not found", region), "invalid-region", common.GetComponentInfo())
@@ -207,7 +208,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetSetti
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetSettings", getSettingsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/settings`, pathParamsMap)
 	if err != nil {
@@ -219,7 +220,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetSetti
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetSettings")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetSettings")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -232,10 +233,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetSetti
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -276,7 +277,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateSe
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateSettings", updateSettingsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/settings`, pathParamsMap)
 	if err != nil {
@@ -288,7 +289,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateSe
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "UpdateSettings")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "UpdateSettings")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -315,10 +316,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateSe
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -361,7 +362,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) PostTest
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "PostTestEvent", postTestEventOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/test_event`, pathParamsMap)
 	if err != nil {
@@ -373,7 +374,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) PostTest
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "PostTestEvent")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "PostTestEvent")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -386,10 +387,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) PostTest
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "post_test_event", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -436,7 +437,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListInst
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListInstanceAttachments", listInstanceAttachmentsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/attachments`, pathParamsMap)
 	if err != nil {
@@ -448,7 +449,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListInst
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListInstanceAttachments")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListInstanceAttachments")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -480,10 +481,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListInst
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_instance_attachments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -531,7 +532,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreatePr
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateProfileAttachment", createProfileAttachmentOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}/attachments`, pathParamsMap)
 	if err != nil {
@@ -543,7 +544,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreatePr
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateProfileAttachment")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateProfileAttachment")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -574,10 +575,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreatePr
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_profile_attachment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -626,7 +627,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProfi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetProfileAttachment", getProfileAttachmentOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}/attachments/{attachment_id}`, pathParamsMap)
 	if err != nil {
@@ -638,7 +639,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProfi
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetProfileAttachment")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetProfileAttachment")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -655,10 +656,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProfi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile_attachment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -707,7 +708,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceP
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceProfileAttachment", replaceProfileAttachmentOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}/attachments/{attachment_id}`, pathParamsMap)
 	if err != nil {
@@ -719,7 +720,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceP
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ReplaceProfileAttachment")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ReplaceProfileAttachment")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -768,10 +769,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceP
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_profile_attachment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -820,7 +821,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeletePr
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteProfileAttachment", deleteProfileAttachmentOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}/attachments/{attachment_id}`, pathParamsMap)
 	if err != nil {
@@ -832,7 +833,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeletePr
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "DeleteProfileAttachment")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "DeleteProfileAttachment")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -849,10 +850,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeletePr
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_profile_attachment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -901,7 +902,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpgradeA
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpgradeAttachment", upgradeAttachmentOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}/attachments/{attachment_id}/upgrade`, pathParamsMap)
 	if err != nil {
@@ -913,7 +914,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpgradeA
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "UpgradeAttachment")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "UpgradeAttachment")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -941,10 +942,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpgradeA
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "upgrade_attachment", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -990,7 +991,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSc
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateScan", createScanOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scans`, pathParamsMap)
 	if err != nil {
@@ -1002,7 +1003,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSc
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateScan")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateScan")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1030,10 +1031,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSc
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_scan", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1081,7 +1082,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateCo
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateControlLibrary", createControlLibraryOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/control_libraries`, pathParamsMap)
 	if err != nil {
@@ -1093,7 +1094,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateCo
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateControlLibrary")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateControlLibrary")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1133,10 +1134,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_control_library", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1184,7 +1185,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListCont
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListControlLibraries", listControlLibrariesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/control_libraries`, pathParamsMap)
 	if err != nil {
@@ -1196,7 +1197,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListCont
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListControlLibraries")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListControlLibraries")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1219,10 +1220,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_control_libraries", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1271,7 +1272,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceC
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceCustomControlLibrary", replaceCustomControlLibraryOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/control_libraries/{control_library_id}`, pathParamsMap)
 	if err != nil {
@@ -1283,7 +1284,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceC
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ReplaceCustomControlLibrary")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ReplaceCustomControlLibrary")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1323,10 +1324,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_custom_control_library", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1375,7 +1376,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetContr
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetControlLibrary", getControlLibraryOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/control_libraries/{control_library_id}`, pathParamsMap)
 	if err != nil {
@@ -1387,7 +1388,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetContr
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetControlLibrary")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetControlLibrary")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1404,10 +1405,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetContr
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_control_library", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1456,7 +1457,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteCu
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteCustomControlLibrary", deleteCustomControlLibraryOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/control_libraries/{control_library_id}`, pathParamsMap)
 	if err != nil {
@@ -1468,7 +1469,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteCu
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "DeleteCustomControlLibrary")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "DeleteCustomControlLibrary")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1485,10 +1486,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteCu
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_custom_control_library", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1533,7 +1534,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreatePr
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateProfile", createProfileOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles`, pathParamsMap)
 	if err != nil {
@@ -1545,7 +1546,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreatePr
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateProfile")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateProfile")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1591,10 +1592,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreatePr
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_profile", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1640,7 +1641,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProf
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListProfiles", listProfilesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles`, pathParamsMap)
 	if err != nil {
@@ -1652,7 +1653,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProf
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListProfiles")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListProfiles")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1675,10 +1676,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProf
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_profiles", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1724,7 +1725,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceP
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceProfile", replaceProfileOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}`, pathParamsMap)
 	if err != nil {
@@ -1736,7 +1737,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceP
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ReplaceProfile")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ReplaceProfile")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1812,10 +1813,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceP
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_profile", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1862,7 +1863,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProfi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetProfile", getProfileOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}`, pathParamsMap)
 	if err != nil {
@@ -1874,7 +1875,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProfi
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetProfile")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetProfile")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1891,10 +1892,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProfi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1941,7 +1942,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteCu
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteCustomProfile", deleteCustomProfileOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}`, pathParamsMap)
 	if err != nil {
@@ -1953,7 +1954,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteCu
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "DeleteCustomProfile")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "DeleteCustomProfile")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1970,10 +1971,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteCu
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_custom_profile", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2020,7 +2021,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceP
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceProfileParameters", replaceProfileParametersOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}/parameters`, pathParamsMap)
 	if err != nil {
@@ -2032,7 +2033,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceP
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ReplaceProfileParameters")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ReplaceProfileParameters")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2063,10 +2064,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceP
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_profile_parameters", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2108,7 +2109,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProf
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListProfileParameters", listProfileParametersOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}/parameters`, pathParamsMap)
 	if err != nil {
@@ -2120,7 +2121,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProf
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListProfileParameters")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListProfileParameters")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2133,10 +2134,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProf
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_profile_parameters", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2181,7 +2182,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CompareP
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CompareProfiles", compareProfilesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}/compare`, pathParamsMap)
 	if err != nil {
@@ -2193,7 +2194,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CompareP
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CompareProfiles")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CompareProfiles")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2210,10 +2211,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CompareP
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "compare_profiles", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2261,7 +2262,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProf
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListProfileAttachments", listProfileAttachmentsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/profiles/{profile_id}/attachments`, pathParamsMap)
 	if err != nil {
@@ -2273,7 +2274,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProf
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListProfileAttachments")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListProfileAttachments")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2290,10 +2291,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProf
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_profile_attachments", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2334,7 +2335,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSc
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateScope", createScopeOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes`, pathParamsMap)
 	if err != nil {
@@ -2346,7 +2347,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSc
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateScope")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateScope")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2379,10 +2380,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSc
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_scope", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2423,7 +2424,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListScop
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListScopes", listScopesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes`, pathParamsMap)
 	if err != nil {
@@ -2435,7 +2436,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListScop
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListScopes")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListScopes")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2464,10 +2465,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListScop
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_scopes", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2509,7 +2510,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateSc
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateScope", updateScopeOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes/{scope_id}`, pathParamsMap)
 	if err != nil {
@@ -2521,7 +2522,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateSc
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "UpdateScope")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "UpdateScope")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2548,10 +2549,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateSc
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_scope", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2593,7 +2594,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetScope
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetScope", getScopeOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes/{scope_id}`, pathParamsMap)
 	if err != nil {
@@ -2605,7 +2606,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetScope
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetScope")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetScope")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2618,10 +2619,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetScope
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_scope", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2663,7 +2664,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteSc
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteScope", deleteScopeOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes/{scope_id}`, pathParamsMap)
 	if err != nil {
@@ -2675,7 +2676,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteSc
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "DeleteScope")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "DeleteScope")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2686,10 +2687,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteSc
 		return
 	}
 
-	response, err = securityAndComplianceCenterApi.Service.Request(request, nil)
+	response, err = securityAndComplianceCenterApi.request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_scope", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -2723,7 +2724,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSu
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateSubscope", createSubscopeOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes/{scope_id}/subscopes`, pathParamsMap)
 	if err != nil {
@@ -2735,7 +2736,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSu
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateSubscope")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateSubscope")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2759,10 +2760,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSu
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_subscope", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2804,7 +2805,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListSubs
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListSubscopes", listSubscopesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes/{scope_id}/subscopes`, pathParamsMap)
 	if err != nil {
@@ -2816,7 +2817,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListSubs
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListSubscopes")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListSubscopes")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2845,10 +2846,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListSubs
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_subscopes", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2891,7 +2892,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetSubsc
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetSubscope", getSubscopeOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes/{scope_id}/subscopes/{subscope_id}`, pathParamsMap)
 	if err != nil {
@@ -2903,7 +2904,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetSubsc
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetSubscope")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetSubscope")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2916,10 +2917,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetSubsc
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_subscope", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -2962,7 +2963,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateSu
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateSubscope", updateSubscopeOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes/{scope_id}/subscopes/{subscope_id}`, pathParamsMap)
 	if err != nil {
@@ -2974,7 +2975,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateSu
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "UpdateSubscope")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "UpdateSubscope")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3001,10 +3002,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdateSu
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_subscope", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3047,7 +3048,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteSu
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteSubscope", deleteSubscopeOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/scopes/{scope_id}/subscopes/{subscope_id}`, pathParamsMap)
 	if err != nil {
@@ -3059,7 +3060,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteSu
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "DeleteSubscope")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "DeleteSubscope")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3070,10 +3071,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteSu
 		return
 	}
 
-	response, err = securityAndComplianceCenterApi.Service.Request(request, nil)
+	response, err = securityAndComplianceCenterApi.request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_subscope", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3106,7 +3107,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateTa
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateTarget", createTargetOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/targets`, pathParamsMap)
 	if err != nil {
@@ -3118,7 +3119,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateTa
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateTarget")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateTarget")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3151,10 +3152,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateTa
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3195,7 +3196,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListTarg
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListTargets", listTargetsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/targets`, pathParamsMap)
 	if err != nil {
@@ -3207,7 +3208,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListTarg
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListTargets")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListTargets")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3220,10 +3221,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListTarg
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_targets", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3265,7 +3266,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetTarge
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetTarget", getTargetOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/targets/{target_id}`, pathParamsMap)
 	if err != nil {
@@ -3277,7 +3278,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetTarge
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetTarget")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetTarget")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3290,10 +3291,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetTarge
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3335,7 +3336,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceT
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceTarget", replaceTargetOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/targets/{target_id}`, pathParamsMap)
 	if err != nil {
@@ -3347,7 +3348,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceT
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ReplaceTarget")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ReplaceTarget")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3380,10 +3381,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceT
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3425,7 +3426,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteTa
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteTarget", deleteTargetOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/targets/{target_id}`, pathParamsMap)
 	if err != nil {
@@ -3437,7 +3438,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteTa
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "DeleteTarget")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "DeleteTarget")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3448,10 +3449,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteTa
 		return
 	}
 
-	response, err = securityAndComplianceCenterApi.Service.Request(request, nil)
+	response, err = securityAndComplianceCenterApi.request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_target", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3486,7 +3487,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreatePr
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateProviderTypeInstance", createProviderTypeInstanceOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/provider_types/{provider_type_id}/provider_type_instances`, pathParamsMap)
 	if err != nil {
@@ -3498,7 +3499,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreatePr
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateProviderTypeInstance")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateProviderTypeInstance")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3525,10 +3526,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreatePr
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_provider_type_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3571,7 +3572,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProv
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListProviderTypeInstances", listProviderTypeInstancesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/provider_types/{provider_type_id}/provider_type_instances`, pathParamsMap)
 	if err != nil {
@@ -3583,7 +3584,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProv
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListProviderTypeInstances")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListProviderTypeInstances")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3596,10 +3597,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProv
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_provider_type_instances", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3644,7 +3645,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProvi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetProviderTypeInstance", getProviderTypeInstanceOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/provider_types/{provider_type_id}/provider_type_instances/{provider_type_instance_id}`, pathParamsMap)
 	if err != nil {
@@ -3656,7 +3657,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProvi
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetProviderTypeInstance")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetProviderTypeInstance")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3669,10 +3670,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProvi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_provider_type_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3716,7 +3717,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdatePr
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateProviderTypeInstance", updateProviderTypeInstanceOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/provider_types/{provider_type_id}/provider_type_instances/{provider_type_instance_id}`, pathParamsMap)
 	if err != nil {
@@ -3728,7 +3729,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdatePr
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "UpdateProviderTypeInstance")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "UpdateProviderTypeInstance")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3755,10 +3756,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) UpdatePr
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_provider_type_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3802,7 +3803,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeletePr
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteProviderTypeInstance", deleteProviderTypeInstanceOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/provider_types/{provider_type_id}/provider_type_instances/{provider_type_instance_id}`, pathParamsMap)
 	if err != nil {
@@ -3814,7 +3815,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeletePr
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "DeleteProviderTypeInstance")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "DeleteProviderTypeInstance")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3825,10 +3826,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeletePr
 		return
 	}
 
-	response, err = securityAndComplianceCenterApi.Service.Request(request, nil)
+	response, err = securityAndComplianceCenterApi.request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_provider_type_instance", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -3864,7 +3865,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProv
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListProviderTypes", listProviderTypesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/provider_types`, pathParamsMap)
 	if err != nil {
@@ -3876,7 +3877,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProv
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListProviderTypes")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListProviderTypes")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3889,10 +3890,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListProv
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_provider_types", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -3935,7 +3936,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProvi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetProviderTypeByID", getProviderTypeByIDOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/provider_types/{provider_type_id}`, pathParamsMap)
 	if err != nil {
@@ -3947,7 +3948,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProvi
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetProviderTypeByID")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetProviderTypeByID")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -3960,10 +3961,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetProvi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_provider_type_by_id", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4005,7 +4006,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetLates
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetLatestReports", getLatestReportsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/latest`, pathParamsMap)
 	if err != nil {
@@ -4017,7 +4018,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetLates
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetLatestReports")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetLatestReports")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4034,10 +4035,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetLates
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_latest_reports", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4079,7 +4080,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRepo
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListReports", listReportsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports`, pathParamsMap)
 	if err != nil {
@@ -4091,7 +4092,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRepo
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListReports")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListReports")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4126,10 +4127,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRepo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_reports", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4172,7 +4173,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetReport", getReportOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}`, pathParamsMap)
 	if err != nil {
@@ -4184,7 +4185,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetReport")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetReport")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4204,10 +4205,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_report", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4250,7 +4251,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetReportSummary", getReportSummaryOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/summary`, pathParamsMap)
 	if err != nil {
@@ -4262,7 +4263,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetReportSummary")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetReportSummary")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4275,10 +4276,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_report_summary", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4321,7 +4322,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetReportDownloadFile", getReportDownloadFileOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/download`, pathParamsMap)
 	if err != nil {
@@ -4333,7 +4334,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetReportDownloadFile")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetReportDownloadFile")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4352,10 +4353,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 		return
 	}
 
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &result)
+	response, err = securityAndComplianceCenterApi.request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_report_download_file", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -4390,7 +4391,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetReportControls", getReportControlsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/controls`, pathParamsMap)
 	if err != nil {
@@ -4402,7 +4403,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetReportControls")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetReportControls")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4440,10 +4441,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_report_controls", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4487,7 +4488,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetReportRule", getReportRuleOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -4499,7 +4500,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetReportRule")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetReportRule")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4512,10 +4513,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_report_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4558,7 +4559,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRepo
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListReportEvaluations", listReportEvaluationsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/evaluations`, pathParamsMap)
 	if err != nil {
@@ -4570,7 +4571,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRepo
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListReportEvaluations")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListReportEvaluations")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4620,10 +4621,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRepo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_report_evaluations", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4666,7 +4667,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRepo
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListReportResources", listReportResourcesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/resources`, pathParamsMap)
 	if err != nil {
@@ -4678,7 +4679,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRepo
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListReportResources")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListReportResources")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4722,10 +4723,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRepo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_report_resources", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4768,7 +4769,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetReportTags", getReportTagsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/tags`, pathParamsMap)
 	if err != nil {
@@ -4780,7 +4781,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetReportTags")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetReportTags")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4793,10 +4794,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_report_tags", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4839,7 +4840,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetReportViolationsDrift", getReportViolationsDriftOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/violations_drift`, pathParamsMap)
 	if err != nil {
@@ -4851,7 +4852,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetReportViolationsDrift")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetReportViolationsDrift")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4874,10 +4875,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRepor
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_report_violations_drift", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -4920,7 +4921,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListScan
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListScanReports", listScanReportsOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/scan_reports`, pathParamsMap)
 	if err != nil {
@@ -4932,7 +4933,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListScan
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListScanReports")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListScanReports")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -4955,10 +4956,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListScan
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_scan_reports", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5001,7 +5002,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSc
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateScanReport", createScanReportOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/scan_reports`, pathParamsMap)
 	if err != nil {
@@ -5013,7 +5014,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSc
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateScanReport")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateScanReport")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5043,10 +5044,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateSc
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_scan_report", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5090,7 +5091,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetScanR
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetScanReport", getScanReportOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/scan_reports/{job_id}`, pathParamsMap)
 	if err != nil {
@@ -5102,7 +5103,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetScanR
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetScanReport")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetScanReport")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5115,10 +5116,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetScanR
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_scan_report", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5162,7 +5163,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetScanR
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetScanReportDownloadFile", getScanReportDownloadFileOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/reports/{report_id}/scan_reports/{job_id}/download`, pathParamsMap)
 	if err != nil {
@@ -5174,7 +5175,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetScanR
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetScanReportDownloadFile")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetScanReportDownloadFile")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5189,10 +5190,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetScanR
 		return
 	}
 
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &result)
+	response, err = securityAndComplianceCenterApi.request(request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_scan_report_download_file", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5227,7 +5228,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRule
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListRules", listRulesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/rules`, pathParamsMap)
 	if err != nil {
@@ -5239,7 +5240,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRule
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListRules")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListRules")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5271,10 +5272,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListRule
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_rules", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5317,7 +5318,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateRu
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateRule", createRuleOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/rules`, pathParamsMap)
 	if err != nil {
@@ -5329,7 +5330,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateRu
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "CreateRule")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "CreateRule")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5368,10 +5369,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) CreateRu
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5414,7 +5415,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRuleW
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetRule", getRuleOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -5426,7 +5427,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRuleW
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetRule")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetRule")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5439,10 +5440,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetRuleW
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5486,7 +5487,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceR
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceRule", replaceRuleOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -5498,7 +5499,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceR
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ReplaceRule")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ReplaceRule")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5540,10 +5541,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ReplaceR
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5586,7 +5587,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteRu
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteRule", deleteRuleOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/instances/{instance_id}/v3/rules/{rule_id}`, pathParamsMap)
 	if err != nil {
@@ -5598,7 +5599,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteRu
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "DeleteRule")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "DeleteRule")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5609,10 +5610,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DeleteRu
 		return
 	}
 
-	response, err = securityAndComplianceCenterApi.Service.Request(request, nil)
+	response, err = securityAndComplianceCenterApi.request(request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_rule", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 
@@ -5637,7 +5638,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListServ
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListServices", listServicesOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/v3/services`, nil)
 	if err != nil {
@@ -5649,7 +5650,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListServ
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "ListServices")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "ListServices")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5662,10 +5663,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) ListServ
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_services", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -5707,7 +5708,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetServi
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetService", getServiceOptions))
 	builder.EnableGzipCompression = securityAndComplianceCenterApi.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(securityAndComplianceCenterApi.Service.Options.URL, `/v3/services/{services_name}`, pathParamsMap)
 	if err != nil {
@@ -5719,7 +5720,7 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetServi
 		builder.AddHeader(headerName, headerValue)
 	}
 
-	sdkHeaders := common.GetSdkHeaders("security_and_compliance_center_api", "V3", "GetService")
+	sdkHeaders := common.GetSdkHeadersWithContext(ctx, "security_and_compliance_center_api", "V3", "GetService")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -5732,10 +5733,10 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) GetServi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = securityAndComplianceCenterApi.Service.Request(request, &rawResponse)
+	response, err = securityAndComplianceCenterApi.request(request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_service", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newAPIError(err, response, request), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -18039,12 +18040,7 @@ func UnmarshalRequiredConfigConditionSubRuleConditionSubRuleConditionAnyIf(m map
 
 // InstanceAttachmentsPager can be used to simplify the use of the "ListInstanceAttachments" method.
 type InstanceAttachmentsPager struct {
-	hasNext     bool
-	options     *ListInstanceAttachmentsOptions
-	client      *SecurityAndComplianceCenterAPIV3
-	pageContext struct {
-		next *string
-	}
+	basePager[ProfileAttachment]
 }
 
 // NewInstanceAttachmentsPager returns a new InstanceAttachmentsPager instance.
@@ -18055,81 +18051,22 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewInsta
 	}
 
 	var optionsCopy = *options
-	pager = &InstanceAttachmentsPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  securityAndComplianceCenterApi,
-	}
-	return
-}
-
-// HasNext returns true if there are potentially more results to be retrieved.
-func (pager *InstanceAttachmentsPager) HasNext() bool {
-	return pager.hasNext
-}
-
-// GetNextWithContext returns the next page of results using the specified Context.
-func (pager *InstanceAttachmentsPager) GetNextWithContext(ctx context.Context) (page []ProfileAttachment, err error) {
-	if !pager.HasNext() {
-		return nil, fmt.Errorf("no more results available")
-	}
-
-	pager.options.Start = pager.pageContext.next
-
-	result, _, err := pager.client.ListInstanceAttachmentsWithContext(ctx, pager.options)
-	if err != nil {
-		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
-		return
-	}
-
-	var next *string
-	if result.Next != nil {
-		next = result.Next.Start
-	}
-	pager.pageContext.next = next
-	pager.hasNext = pager.pageContext.next != nil && *pager.pageContext.next != ""
-	page = result.Attachments
-
-	return
-}
-
-// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
-// until all pages of results have been retrieved.
-func (pager *InstanceAttachmentsPager) GetAllWithContext(ctx context.Context) (allItems []ProfileAttachment, err error) {
-	for pager.HasNext() {
-		var nextPage []ProfileAttachment
-		nextPage, err = pager.GetNextWithContext(ctx)
+	pager = &InstanceAttachmentsPager{newBasePager(func(ctx context.Context, start *string) (page []ProfileAttachment, next *string, err error) {
+		optionsCopy.Start = start
+		result, _, err := securityAndComplianceCenterApi.ListInstanceAttachmentsWithContext(ctx, &optionsCopy)
 		if err != nil {
-			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
 			return
 		}
-		allItems = append(allItems, nextPage...)
-	}
-	return
-}
-
-// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
-func (pager *InstanceAttachmentsPager) GetNext() (page []ProfileAttachment, err error) {
-	page, err = pager.GetNextWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
-	return
-}
-
-// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
-func (pager *InstanceAttachmentsPager) GetAll() (allItems []ProfileAttachment, err error) {
-	allItems, err = pager.GetAllWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
+		next, err = result.GetNextStart()
+		page = result.Attachments
+		return
+	})}
 	return
 }
 
 // ControlLibrariesPager can be used to simplify the use of the "ListControlLibraries" method.
 type ControlLibrariesPager struct {
-	hasNext     bool
-	options     *ListControlLibrariesOptions
-	client      *SecurityAndComplianceCenterAPIV3
-	pageContext struct {
-		next *string
-	}
+	basePager[ControlLibrary]
 }
 
 // NewControlLibrariesPager returns a new ControlLibrariesPager instance.
@@ -18140,81 +18077,22 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewContr
 	}
 
 	var optionsCopy = *options
-	pager = &ControlLibrariesPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  securityAndComplianceCenterApi,
-	}
-	return
-}
-
-// HasNext returns true if there are potentially more results to be retrieved.
-func (pager *ControlLibrariesPager) HasNext() bool {
-	return pager.hasNext
-}
-
-// GetNextWithContext returns the next page of results using the specified Context.
-func (pager *ControlLibrariesPager) GetNextWithContext(ctx context.Context) (page []ControlLibrary, err error) {
-	if !pager.HasNext() {
-		return nil, fmt.Errorf("no more results available")
-	}
-
-	pager.options.Start = pager.pageContext.next
-
-	result, _, err := pager.client.ListControlLibrariesWithContext(ctx, pager.options)
-	if err != nil {
-		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
-		return
-	}
-
-	var next *string
-	if result.Next != nil {
-		next = result.Next.Start
-	}
-	pager.pageContext.next = next
-	pager.hasNext = pager.pageContext.next != nil && *pager.pageContext.next != ""
-	page = result.ControlLibraries
-
-	return
-}
-
-// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
-// until all pages of results have been retrieved.
-func (pager *ControlLibrariesPager) GetAllWithContext(ctx context.Context) (allItems []ControlLibrary, err error) {
-	for pager.HasNext() {
-		var nextPage []ControlLibrary
-		nextPage, err = pager.GetNextWithContext(ctx)
+	pager = &ControlLibrariesPager{newBasePager(func(ctx context.Context, start *string) (page []ControlLibrary, next *string, err error) {
+		optionsCopy.Start = start
+		result, _, err := securityAndComplianceCenterApi.ListControlLibrariesWithContext(ctx, &optionsCopy)
 		if err != nil {
-			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
 			return
 		}
-		allItems = append(allItems, nextPage...)
-	}
-	return
-}
-
-// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
-func (pager *ControlLibrariesPager) GetNext() (page []ControlLibrary, err error) {
-	page, err = pager.GetNextWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
-	return
-}
-
-// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
-func (pager *ControlLibrariesPager) GetAll() (allItems []ControlLibrary, err error) {
-	allItems, err = pager.GetAllWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
+		next, err = result.GetNextStart()
+		page = result.ControlLibraries
+		return
+	})}
 	return
 }
 
 // ProfilesPager can be used to simplify the use of the "ListProfiles" method.
 type ProfilesPager struct {
-	hasNext     bool
-	options     *ListProfilesOptions
-	client      *SecurityAndComplianceCenterAPIV3
-	pageContext struct {
-		next *string
-	}
+	basePager[Profile]
 }
 
 // NewProfilesPager returns a new ProfilesPager instance.
@@ -18225,81 +18103,22 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewProfi
 	}
 
 	var optionsCopy = *options
-	pager = &ProfilesPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  securityAndComplianceCenterApi,
-	}
-	return
-}
-
-// HasNext returns true if there are potentially more results to be retrieved.
-func (pager *ProfilesPager) HasNext() bool {
-	return pager.hasNext
-}
-
-// GetNextWithContext returns the next page of results using the specified Context.
-func (pager *ProfilesPager) GetNextWithContext(ctx context.Context) (page []Profile, err error) {
-	if !pager.HasNext() {
-		return nil, fmt.Errorf("no more results available")
-	}
-
-	pager.options.Start = pager.pageContext.next
-
-	result, _, err := pager.client.ListProfilesWithContext(ctx, pager.options)
-	if err != nil {
-		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
-		return
-	}
-
-	var next *string
-	if result.Next != nil {
-		next = result.Next.Start
-	}
-	pager.pageContext.next = next
-	pager.hasNext = pager.pageContext.next != nil && *pager.pageContext.next != ""
-	page = result.Profiles
-
-	return
-}
-
-// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
-// until all pages of results have been retrieved.
-func (pager *ProfilesPager) GetAllWithContext(ctx context.Context) (allItems []Profile, err error) {
-	for pager.HasNext() {
-		var nextPage []Profile
-		nextPage, err = pager.GetNextWithContext(ctx)
+	pager = &ProfilesPager{newBasePager(func(ctx context.Context, start *string) (page []Profile, next *string, err error) {
+		optionsCopy.Start = start
+		result, _, err := securityAndComplianceCenterApi.ListProfilesWithContext(ctx, &optionsCopy)
 		if err != nil {
-			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
 			return
 		}
-		allItems = append(allItems, nextPage...)
-	}
-	return
-}
-
-// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
-func (pager *ProfilesPager) GetNext() (page []Profile, err error) {
-	page, err = pager.GetNextWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
-	return
-}
-
-// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
-func (pager *ProfilesPager) GetAll() (allItems []Profile, err error) {
-	allItems, err = pager.GetAllWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
+		next, err = result.GetNextStart()
+		page = result.Profiles
+		return
+	})}
 	return
 }
 
 // ScopesPager can be used to simplify the use of the "ListScopes" method.
 type ScopesPager struct {
-	hasNext     bool
-	options     *ListScopesOptions
-	client      *SecurityAndComplianceCenterAPIV3
-	pageContext struct {
-		next *string
-	}
+	basePager[Scope]
 }
 
 // NewScopesPager returns a new ScopesPager instance.
@@ -18310,81 +18129,22 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewScope
 	}
 
 	var optionsCopy = *options
-	pager = &ScopesPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  securityAndComplianceCenterApi,
-	}
-	return
-}
-
-// HasNext returns true if there are potentially more results to be retrieved.
-func (pager *ScopesPager) HasNext() bool {
-	return pager.hasNext
-}
-
-// GetNextWithContext returns the next page of results using the specified Context.
-func (pager *ScopesPager) GetNextWithContext(ctx context.Context) (page []Scope, err error) {
-	if !pager.HasNext() {
-		return nil, fmt.Errorf("no more results available")
-	}
-
-	pager.options.Start = pager.pageContext.next
-
-	result, _, err := pager.client.ListScopesWithContext(ctx, pager.options)
-	if err != nil {
-		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
-		return
-	}
-
-	var next *string
-	if result.Next != nil {
-		next = result.Next.Start
-	}
-	pager.pageContext.next = next
-	pager.hasNext = pager.pageContext.next != nil && *pager.pageContext.next != ""
-	page = result.Scopes
-
-	return
-}
-
-// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
-// until all pages of results have been retrieved.
-func (pager *ScopesPager) GetAllWithContext(ctx context.Context) (allItems []Scope, err error) {
-	for pager.HasNext() {
-		var nextPage []Scope
-		nextPage, err = pager.GetNextWithContext(ctx)
+	pager = &ScopesPager{newBasePager(func(ctx context.Context, start *string) (page []Scope, next *string, err error) {
+		optionsCopy.Start = start
+		result, _, err := securityAndComplianceCenterApi.ListScopesWithContext(ctx, &optionsCopy)
 		if err != nil {
-			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
 			return
 		}
-		allItems = append(allItems, nextPage...)
-	}
-	return
-}
-
-// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
-func (pager *ScopesPager) GetNext() (page []Scope, err error) {
-	page, err = pager.GetNextWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
-	return
-}
-
-// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
-func (pager *ScopesPager) GetAll() (allItems []Scope, err error) {
-	allItems, err = pager.GetAllWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
+		next, err = result.GetNextStart()
+		page = result.Scopes
+		return
+	})}
 	return
 }
 
 // SubscopesPager can be used to simplify the use of the "ListSubscopes" method.
 type SubscopesPager struct {
-	hasNext     bool
-	options     *ListSubscopesOptions
-	client      *SecurityAndComplianceCenterAPIV3
-	pageContext struct {
-		next *string
-	}
+	basePager[SubScope]
 }
 
 // NewSubscopesPager returns a new SubscopesPager instance.
@@ -18395,81 +18155,22 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewSubsc
 	}
 
 	var optionsCopy = *options
-	pager = &SubscopesPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  securityAndComplianceCenterApi,
-	}
-	return
-}
-
-// HasNext returns true if there are potentially more results to be retrieved.
-func (pager *SubscopesPager) HasNext() bool {
-	return pager.hasNext
-}
-
-// GetNextWithContext returns the next page of results using the specified Context.
-func (pager *SubscopesPager) GetNextWithContext(ctx context.Context) (page []SubScope, err error) {
-	if !pager.HasNext() {
-		return nil, fmt.Errorf("no more results available")
-	}
-
-	pager.options.Start = pager.pageContext.next
-
-	result, _, err := pager.client.ListSubscopesWithContext(ctx, pager.options)
-	if err != nil {
-		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
-		return
-	}
-
-	var next *string
-	if result.Next != nil {
-		next = result.Next.Start
-	}
-	pager.pageContext.next = next
-	pager.hasNext = pager.pageContext.next != nil && *pager.pageContext.next != ""
-	page = result.Subscopes
-
-	return
-}
-
-// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
-// until all pages of results have been retrieved.
-func (pager *SubscopesPager) GetAllWithContext(ctx context.Context) (allItems []SubScope, err error) {
-	for pager.HasNext() {
-		var nextPage []SubScope
-		nextPage, err = pager.GetNextWithContext(ctx)
+	pager = &SubscopesPager{newBasePager(func(ctx context.Context, start *string) (page []SubScope, next *string, err error) {
+		optionsCopy.Start = start
+		result, _, err := securityAndComplianceCenterApi.ListSubscopesWithContext(ctx, &optionsCopy)
 		if err != nil {
-			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
 			return
 		}
-		allItems = append(allItems, nextPage...)
-	}
-	return
-}
-
-// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
-func (pager *SubscopesPager) GetNext() (page []SubScope, err error) {
-	page, err = pager.GetNextWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
-	return
-}
-
-// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
-func (pager *SubscopesPager) GetAll() (allItems []SubScope, err error) {
-	allItems, err = pager.GetAllWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
+		next, err = result.GetNextStart()
+		page = result.Subscopes
+		return
+	})}
 	return
 }
 
 // ReportsPager can be used to simplify the use of the "ListReports" method.
 type ReportsPager struct {
-	hasNext     bool
-	options     *ListReportsOptions
-	client      *SecurityAndComplianceCenterAPIV3
-	pageContext struct {
-		next *string
-	}
+	basePager[Report]
 }
 
 // NewReportsPager returns a new ReportsPager instance.
@@ -18480,81 +18181,22 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewRepor
 	}
 
 	var optionsCopy = *options
-	pager = &ReportsPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  securityAndComplianceCenterApi,
-	}
-	return
-}
-
-// HasNext returns true if there are potentially more results to be retrieved.
-func (pager *ReportsPager) HasNext() bool {
-	return pager.hasNext
-}
-
-// GetNextWithContext returns the next page of results using the specified Context.
-func (pager *ReportsPager) GetNextWithContext(ctx context.Context) (page []Report, err error) {
-	if !pager.HasNext() {
-		return nil, fmt.Errorf("no more results available")
-	}
-
-	pager.options.Start = pager.pageContext.next
-
-	result, _, err := pager.client.ListReportsWithContext(ctx, pager.options)
-	if err != nil {
-		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
-		return
-	}
-
-	var next *string
-	if result.Next != nil {
-		next = result.Next.Start
-	}
-	pager.pageContext.next = next
-	pager.hasNext = pager.pageContext.next != nil && *pager.pageContext.next != ""
-	page = result.Reports
-
-	return
-}
-
-// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
-// until all pages of results have been retrieved.
-func (pager *ReportsPager) GetAllWithContext(ctx context.Context) (allItems []Report, err error) {
-	for pager.HasNext() {
-		var nextPage []Report
-		nextPage, err = pager.GetNextWithContext(ctx)
+	pager = &ReportsPager{newBasePager(func(ctx context.Context, start *string) (page []Report, next *string, err error) {
+		optionsCopy.Start = start
+		result, _, err := securityAndComplianceCenterApi.ListReportsWithContext(ctx, &optionsCopy)
 		if err != nil {
-			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
 			return
 		}
-		allItems = append(allItems, nextPage...)
-	}
-	return
-}
-
-// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
-func (pager *ReportsPager) GetNext() (page []Report, err error) {
-	page, err = pager.GetNextWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
-	return
-}
-
-// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
-func (pager *ReportsPager) GetAll() (allItems []Report, err error) {
-	allItems, err = pager.GetAllWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
+		next, err = result.GetNextStart()
+		page = result.Reports
+		return
+	})}
 	return
 }
 
 // ReportEvaluationsPager can be used to simplify the use of the "ListReportEvaluations" method.
 type ReportEvaluationsPager struct {
-	hasNext     bool
-	options     *ListReportEvaluationsOptions
-	client      *SecurityAndComplianceCenterAPIV3
-	pageContext struct {
-		next *string
-	}
+	basePager[Evaluation]
 }
 
 // NewReportEvaluationsPager returns a new ReportEvaluationsPager instance.
@@ -18565,81 +18207,22 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewRepor
 	}
 
 	var optionsCopy = *options
-	pager = &ReportEvaluationsPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  securityAndComplianceCenterApi,
-	}
-	return
-}
-
-// HasNext returns true if there are potentially more results to be retrieved.
-func (pager *ReportEvaluationsPager) HasNext() bool {
-	return pager.hasNext
-}
-
-// GetNextWithContext returns the next page of results using the specified Context.
-func (pager *ReportEvaluationsPager) GetNextWithContext(ctx context.Context) (page []Evaluation, err error) {
-	if !pager.HasNext() {
-		return nil, fmt.Errorf("no more results available")
-	}
-
-	pager.options.Start = pager.pageContext.next
-
-	result, _, err := pager.client.ListReportEvaluationsWithContext(ctx, pager.options)
-	if err != nil {
-		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
-		return
-	}
-
-	var next *string
-	if result.Next != nil {
-		next = result.Next.Start
-	}
-	pager.pageContext.next = next
-	pager.hasNext = pager.pageContext.next != nil && *pager.pageContext.next != ""
-	page = result.Evaluations
-
-	return
-}
-
-// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
-// until all pages of results have been retrieved.
-func (pager *ReportEvaluationsPager) GetAllWithContext(ctx context.Context) (allItems []Evaluation, err error) {
-	for pager.HasNext() {
-		var nextPage []Evaluation
-		nextPage, err = pager.GetNextWithContext(ctx)
+	pager = &ReportEvaluationsPager{newBasePager(func(ctx context.Context, start *string) (page []Evaluation, next *string, err error) {
+		optionsCopy.Start = start
+		result, _, err := securityAndComplianceCenterApi.ListReportEvaluationsWithContext(ctx, &optionsCopy)
 		if err != nil {
-			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
 			return
 		}
-		allItems = append(allItems, nextPage...)
-	}
-	return
-}
-
-// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
-func (pager *ReportEvaluationsPager) GetNext() (page []Evaluation, err error) {
-	page, err = pager.GetNextWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
-	return
-}
-
-// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
-func (pager *ReportEvaluationsPager) GetAll() (allItems []Evaluation, err error) {
-	allItems, err = pager.GetAllWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
+		next, err = result.GetNextStart()
+		page = result.Evaluations
+		return
+	})}
 	return
 }
 
 // ReportResourcesPager can be used to simplify the use of the "ListReportResources" method.
 type ReportResourcesPager struct {
-	hasNext     bool
-	options     *ListReportResourcesOptions
-	client      *SecurityAndComplianceCenterAPIV3
-	pageContext struct {
-		next *string
-	}
+	basePager[Resource]
 }
 
 // NewReportResourcesPager returns a new ReportResourcesPager instance.
@@ -18650,81 +18233,22 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewRepor
 	}
 
 	var optionsCopy = *options
-	pager = &ReportResourcesPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  securityAndComplianceCenterApi,
-	}
-	return
-}
-
-// HasNext returns true if there are potentially more results to be retrieved.
-func (pager *ReportResourcesPager) HasNext() bool {
-	return pager.hasNext
-}
-
-// GetNextWithContext returns the next page of results using the specified Context.
-func (pager *ReportResourcesPager) GetNextWithContext(ctx context.Context) (page []Resource, err error) {
-	if !pager.HasNext() {
-		return nil, fmt.Errorf("no more results available")
-	}
-
-	pager.options.Start = pager.pageContext.next
-
-	result, _, err := pager.client.ListReportResourcesWithContext(ctx, pager.options)
-	if err != nil {
-		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
-		return
-	}
-
-	var next *string
-	if result.Next != nil {
-		next = result.Next.Start
-	}
-	pager.pageContext.next = next
-	pager.hasNext = pager.pageContext.next != nil && *pager.pageContext.next != ""
-	page = result.Resources
-
-	return
-}
-
-// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
-// until all pages of results have been retrieved.
-func (pager *ReportResourcesPager) GetAllWithContext(ctx context.Context) (allItems []Resource, err error) {
-	for pager.HasNext() {
-		var nextPage []Resource
-		nextPage, err = pager.GetNextWithContext(ctx)
+	pager = &ReportResourcesPager{newBasePager(func(ctx context.Context, start *string) (page []Resource, next *string, err error) {
+		optionsCopy.Start = start
+		result, _, err := securityAndComplianceCenterApi.ListReportResourcesWithContext(ctx, &optionsCopy)
 		if err != nil {
-			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
 			return
 		}
-		allItems = append(allItems, nextPage...)
-	}
-	return
-}
-
-// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
-func (pager *ReportResourcesPager) GetNext() (page []Resource, err error) {
-	page, err = pager.GetNextWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
-	return
-}
-
-// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
-func (pager *ReportResourcesPager) GetAll() (allItems []Resource, err error) {
-	allItems, err = pager.GetAllWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
+		next, err = result.GetNextStart()
+		page = result.Resources
+		return
+	})}
 	return
 }
 
 // RulesPager can be used to simplify the use of the "ListRules" method.
 type RulesPager struct {
-	hasNext     bool
-	options     *ListRulesOptions
-	client      *SecurityAndComplianceCenterAPIV3
-	pageContext struct {
-		next *string
-	}
+	basePager[Rule]
 }
 
 // NewRulesPager returns a new RulesPager instance.
@@ -18735,69 +18259,78 @@ func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewRules
 	}
 
 	var optionsCopy = *options
-	pager = &RulesPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  securityAndComplianceCenterApi,
-	}
+	pager = &RulesPager{newBasePager(func(ctx context.Context, start *string) (page []Rule, next *string, err error) {
+		optionsCopy.Start = start
+		result, _, err := securityAndComplianceCenterApi.ListRulesWithContext(ctx, &optionsCopy)
+		if err != nil {
+			return
+		}
+		next, err = result.GetNextStart()
+		page = result.Rules
+		return
+	})}
 	return
 }
 
-// HasNext returns true if there are potentially more results to be retrieved.
-func (pager *RulesPager) HasNext() bool {
-	return pager.hasNext
+// ProfileAttachmentsPager can be used to simplify the use of the "ListProfileAttachments" method. The options of the method have
+// no start parameter: the pager requests the next page that the previous page refers to.
+type ProfileAttachmentsPager struct {
+	basePager[ProfileAttachment]
 }
 
-// GetNextWithContext returns the next page of results using the specified Context.
-func (pager *RulesPager) GetNextWithContext(ctx context.Context) (page []Rule, err error) {
-	if !pager.HasNext() {
-		return nil, fmt.Errorf("no more results available")
-	}
-
-	pager.options.Start = pager.pageContext.next
-
-	result, _, err := pager.client.ListRulesWithContext(ctx, pager.options)
-	if err != nil {
-		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+// NewProfileAttachmentsPager returns a new ProfileAttachmentsPager instance.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewProfileAttachmentsPager(options *ListProfileAttachmentsOptions) (pager *ProfileAttachmentsPager, err error) {
+	var optionsCopy = *options
+	pager = &ProfileAttachmentsPager{newBasePager(func(ctx context.Context, start *string) (page []ProfileAttachment, next *string, err error) {
+		result, _, err := securityAndComplianceCenterApi.ListProfileAttachmentsWithContext(withPageStart(ctx, start), &optionsCopy)
+		if err != nil {
+			return
+		}
+		next, err = nextPageStart(result.Next)
+		page = result.Attachments
 		return
-	}
-
-	var next *string
-	if result.Next != nil {
-		next = result.Next.Start
-	}
-	pager.pageContext.next = next
-	pager.hasNext = pager.pageContext.next != nil && *pager.pageContext.next != ""
-	page = result.Rules
-
+	})}
 	return
 }
 
-// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
-// until all pages of results have been retrieved.
-func (pager *RulesPager) GetAllWithContext(ctx context.Context) (allItems []Rule, err error) {
-	for pager.HasNext() {
-		var nextPage []Rule
-		nextPage, err = pager.GetNextWithContext(ctx)
+// TargetsPager can be used to simplify the use of the "ListTargets" method. The options of the method have
+// no start parameter: the pager requests the next page that the previous page refers to.
+type TargetsPager struct {
+	basePager[Target]
+}
+
+// NewTargetsPager returns a new TargetsPager instance.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewTargetsPager(options *ListTargetsOptions) (pager *TargetsPager, err error) {
+	var optionsCopy = *options
+	pager = &TargetsPager{newBasePager(func(ctx context.Context, start *string) (page []Target, next *string, err error) {
+		result, _, err := securityAndComplianceCenterApi.ListTargetsWithContext(withPageStart(ctx, start), &optionsCopy)
 		if err != nil {
-			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
 			return
 		}
-		allItems = append(allItems, nextPage...)
-	}
+		next, err = nextPageStart(result.Next)
+		page = result.Targets
+		return
+	})}
 	return
 }
 
-// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
-func (pager *RulesPager) GetNext() (page []Rule, err error) {
-	page, err = pager.GetNextWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
-	return
+// ScanReportsPager can be used to simplify the use of the "ListScanReports" method. The options of the method have
+// no start parameter: the pager requests the next page that the previous page refers to.
+type ScanReportsPager struct {
+	basePager[ScanReport]
 }
 
-// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
-func (pager *RulesPager) GetAll() (allItems []Rule, err error) {
-	allItems, err = pager.GetAllWithContext(context.Background())
-	err = core.RepurposeSDKProblem(err, "")
+// NewScanReportsPager returns a new ScanReportsPager instance.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) NewScanReportsPager(options *ListScanReportsOptions) (pager *ScanReportsPager, err error) {
+	var optionsCopy = *options
+	pager = &ScanReportsPager{newBasePager(func(ctx context.Context, start *string) (page []ScanReport, next *string, err error) {
+		result, _, err := securityAndComplianceCenterApi.ListScanReportsWithContext(withPageStart(ctx, start), &optionsCopy)
+		if err != nil {
+			return
+		}
+		next, err = nextPageStart(result.Next)
+		page = result.ScanReports
+		return
+	})}
 	return
 }
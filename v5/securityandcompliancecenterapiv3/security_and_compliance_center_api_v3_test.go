@@ -7585,6 +7585,85 @@ var _ = Describe(`SecurityAndComplianceCenterAPIV3`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listProfileAttachmentsPath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.URL.Query()["account_id"]).To(Equal([]string{"testString"}))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["start"]).To(BeNil())
+						res.WriteHeader(200)
+						fmt.Fprintf(res, "%s", `{"next":{"href":"https://us-south.compliance.cloud.ibm.com/instances/acd7032c-15a3-484f-bf5b-67d41534d940/v3/profiles/9c265b4a-4cdf-47f1-acd3-17b5808f7f3f/attachments?start=1","start":"1"},"total_count":2,"limit":1,"attachments":[{"id":"ID-1"}]}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["start"]).To(Equal([]string{"1"}))
+						res.WriteHeader(200)
+						fmt.Fprintf(res, "%s", `{"total_count":2,"limit":1,"attachments":[{"id":"ID-2"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ProfileAttachmentsPager.GetNext successfully`, func() {
+				securityAndComplianceCenterAPIService, serviceErr := securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(securityAndComplianceCenterAPIService).ToNot(BeNil())
+
+				listProfileAttachmentsOptionsModel := &securityandcompliancecenterapiv3.ListProfileAttachmentsOptions{
+					InstanceID: core.StringPtr("acd7032c-15a3-484f-bf5b-67d41534d940"),
+					ProfileID:  core.StringPtr("9c265b4a-4cdf-47f1-acd3-17b5808f7f3f"),
+					AccountID:  core.StringPtr("testString"),
+				}
+
+				pager, err := securityAndComplianceCenterAPIService.NewProfileAttachmentsPager(listProfileAttachmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []securityandcompliancecenterapiv3.ProfileAttachment
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+				Expect(*allResults[1].ID).To(Equal("ID-2"))
+			})
+			It(`Use ProfileAttachmentsPager.GetAll successfully`, func() {
+				securityAndComplianceCenterAPIService, serviceErr := securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(securityAndComplianceCenterAPIService).ToNot(BeNil())
+
+				listProfileAttachmentsOptionsModel := &securityandcompliancecenterapiv3.ListProfileAttachmentsOptions{
+					InstanceID: core.StringPtr("acd7032c-15a3-484f-bf5b-67d41534d940"),
+					ProfileID:  core.StringPtr("9c265b4a-4cdf-47f1-acd3-17b5808f7f3f"),
+					AccountID:  core.StringPtr("testString"),
+				}
+
+				pager, err := securityAndComplianceCenterAPIService.NewProfileAttachmentsPager(listProfileAttachmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateScope(createScopeOptions *CreateScopeOptions) - Operation response error`, func() {
 		createScopePath := "/instances/acd7032c-15a3-484f-bf5b-67d41534d940/v3/scopes"
@@ -10663,6 +10742,80 @@ var _ = Describe(`SecurityAndComplianceCenterAPIV3`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listTargetsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["start"]).To(BeNil())
+						res.WriteHeader(200)
+						fmt.Fprintf(res, "%s", `{"next":{"href":"https://us-south.compliance.cloud.ibm.com/instances/acd7032c-15a3-484f-bf5b-67d41534d940/v3/targets?limit=1&start=1"},"total_count":2,"limit":1,"targets":[{"id":"ID-1"}]}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["start"]).To(Equal([]string{"1"}))
+						res.WriteHeader(200)
+						fmt.Fprintf(res, "%s", `{"total_count":2,"limit":1,"targets":[{"id":"ID-2"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use TargetsPager.GetNext successfully`, func() {
+				securityAndComplianceCenterAPIService, serviceErr := securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(securityAndComplianceCenterAPIService).ToNot(BeNil())
+
+				listTargetsOptionsModel := &securityandcompliancecenterapiv3.ListTargetsOptions{
+					InstanceID: core.StringPtr("acd7032c-15a3-484f-bf5b-67d41534d940"),
+				}
+
+				pager, err := securityAndComplianceCenterAPIService.NewTargetsPager(listTargetsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []securityandcompliancecenterapiv3.Target
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+				Expect(*allResults[1].ID).To(Equal("ID-2"))
+			})
+			It(`Use TargetsPager.GetAll successfully`, func() {
+				securityAndComplianceCenterAPIService, serviceErr := securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(securityAndComplianceCenterAPIService).ToNot(BeNil())
+
+				listTargetsOptionsModel := &securityandcompliancecenterapiv3.ListTargetsOptions{
+					InstanceID: core.StringPtr("acd7032c-15a3-484f-bf5b-67d41534d940"),
+				}
+
+				pager, err := securityAndComplianceCenterAPIService.NewTargetsPager(listTargetsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`GetTarget(getTargetOptions *GetTargetOptions) - Operation response error`, func() {
 		getTargetPath := "/instances/acd7032c-15a3-484f-bf5b-67d41534d940/v3/targets/testString"
@@ -16134,6 +16287,85 @@ var _ = Describe(`SecurityAndComplianceCenterAPIV3`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listScanReportsPath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.URL.Query()["sort"]).To(Equal([]string{"status"}))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["start"]).To(BeNil())
+						res.WriteHeader(200)
+						fmt.Fprintf(res, "%s", `{"next":{"href":"https://us-south.compliance.cloud.ibm.com/instances/acd7032c-15a3-484f-bf5b-67d41534d940/v3/reports/testString/scan_reports?sort=status&start=1","start":"1"},"total_count":2,"limit":1,"scan_reports":[{"id":"ID-1"}]}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["start"]).To(Equal([]string{"1"}))
+						res.WriteHeader(200)
+						fmt.Fprintf(res, "%s", `{"total_count":2,"limit":1,"scan_reports":[{"id":"ID-2"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ScanReportsPager.GetNext successfully`, func() {
+				securityAndComplianceCenterAPIService, serviceErr := securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(securityAndComplianceCenterAPIService).ToNot(BeNil())
+
+				listScanReportsOptionsModel := &securityandcompliancecenterapiv3.ListScanReportsOptions{
+					InstanceID: core.StringPtr("acd7032c-15a3-484f-bf5b-67d41534d940"),
+					ReportID:   core.StringPtr("testString"),
+					Sort:       core.StringPtr("status"),
+				}
+
+				pager, err := securityAndComplianceCenterAPIService.NewScanReportsPager(listScanReportsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []securityandcompliancecenterapiv3.ScanReport
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+				Expect(*allResults[1].ID).To(Equal("ID-2"))
+			})
+			It(`Use ScanReportsPager.GetAll successfully`, func() {
+				securityAndComplianceCenterAPIService, serviceErr := securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(securityAndComplianceCenterAPIService).ToNot(BeNil())
+
+				listScanReportsOptionsModel := &securityandcompliancecenterapiv3.ListScanReportsOptions{
+					InstanceID: core.StringPtr("acd7032c-15a3-484f-bf5b-67d41534d940"),
+					ReportID:   core.StringPtr("testString"),
+					Sort:       core.StringPtr("status"),
+				}
+
+				pager, err := securityAndComplianceCenterAPIService.NewScanReportsPager(listScanReportsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateScanReport(createScanReportOptions *CreateScanReportOptions) - Operation response error`, func() {
 		createScanReportPath := "/instances/acd7032c-15a3-484f-bf5b-67d41534d940/v3/reports/testString/scan_reports"
@@ -0,0 +1,222 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// telemetryScope is the instrumentation scope of the spans and metrics of the client.
+const telemetryScope = "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+
+// Attributes of the spans and metrics of the client.
+const (
+	// TelemetryOperationKey is the operationId of the operation, such as "GetReport".
+	TelemetryOperationKey = attribute.Key("scc.operation")
+
+	// TelemetryInstanceIDKey is the ID of the Security and Compliance Center instance.
+	TelemetryInstanceIDKey = attribute.Key("scc.instance_id")
+
+	// TelemetryReportIDKey is the ID of the report.
+	TelemetryReportIDKey = attribute.Key("scc.report_id")
+)
+
+// Names of the metrics of the client.
+const (
+	// TelemetryRequestDurationMetric is a histogram of the duration of the requests, in seconds.
+	TelemetryRequestDurationMetric = "scc.client.request.duration"
+
+	// TelemetryRetriesMetric counts the requests sent again by the retries enabled with EnableRetries.
+	TelemetryRetriesMetric = "scc.client.retries"
+
+	// TelemetryPagerPagesMetric counts the pages retrieved by the pagers.
+	TelemetryPagerPagesMetric = "scc.client.pager.pages"
+)
+
+// TelemetryOptions : The options of EnableTelemetry.
+type TelemetryOptions struct {
+	// The provider of the tracer of the spans. Defaults to the global tracer provider.
+	TracerProvider trace.TracerProvider
+
+	// The provider of the meter of the metrics. Defaults to the global meter provider.
+	MeterProvider metric.MeterProvider
+
+	// The propagator that injects the trace context into the requests. Defaults to the global
+	// propagator.
+	Propagator propagation.TextMapPropagator
+}
+
+// EnableTelemetry : Trace the requests of the client with OpenTelemetry
+// Each request is sent within a client span named after its operation, such as "GetReport", with the
+// instance ID, the report ID and the HTTP status code of the request as attributes, and with the
+// trace context injected into its headers. With retries enabled, each retry is a span of its own.
+// The duration of the requests, the retries and the pages retrieved by the pagers are recorded as
+// metrics. Enable the telemetry after any call to SetHTTPClient; enabling it again replaces its
+// options.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) EnableTelemetry(options *TelemetryOptions) (err error) {
+	if options == nil {
+		options = &TelemetryOptions{}
+	}
+	tracerProvider := options.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	meterProvider := options.MeterProvider
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+	propagator := options.Propagator
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+
+	transport := &telemetryTransport{
+		tracer:     tracerProvider.Tracer(telemetryScope, trace.WithInstrumentationVersion(common.Version)),
+		propagator: propagator,
+	}
+	meter := meterProvider.Meter(telemetryScope, metric.WithInstrumentationVersion(common.Version))
+	transport.duration, err = meter.Float64Histogram(TelemetryRequestDurationMetric,
+		metric.WithDescription("The duration of the requests."), metric.WithUnit("s"))
+	if err == nil {
+		transport.retries, err = meter.Int64Counter(TelemetryRetriesMetric,
+			metric.WithDescription("The number of requests sent again after a failure."), metric.WithUnit("{request}"))
+	}
+	if err == nil {
+		transport.pages, err = meter.Int64Counter(TelemetryPagerPagesMetric,
+			metric.WithDescription("The number of pages retrieved by the pagers."), metric.WithUnit("{page}"))
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "telemetry-instrument-error", common.GetComponentInfo())
+		return
+	}
+
+	securityAndComplianceCenterApi.wrapTransport(func(current http.RoundTripper) http.RoundTripper {
+		return withTransportLayer[*telemetryTransport](current, transport.wrap)
+	})
+	return
+}
+
+// DisableTelemetry : Stop tracing the requests of the client
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) DisableTelemetry() {
+	securityAndComplianceCenterApi.wrapTransport(func(current http.RoundTripper) http.RoundTripper {
+		return withTransportLayer[*telemetryTransport](current, func(next http.RoundTripper) http.RoundTripper {
+			return next
+		})
+	})
+}
+
+// telemetryTransport traces the requests of a client.
+type telemetryTransport struct {
+	next       http.RoundTripper
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	duration   metric.Float64Histogram
+	retries    metric.Int64Counter
+	pages      metric.Int64Counter
+}
+
+func (transport *telemetryTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *telemetryTransport) wrap(next http.RoundTripper) http.RoundTripper {
+	wrapped := *transport
+	wrapped.next = next
+	return &wrapped
+}
+
+// RoundTrip sends the request within a span.
+func (transport *telemetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	operationID := GetOperationID(ctx)
+	resendCount := 0
+	if op := operationFromContext(ctx); op != nil {
+		resendCount = int(op.attempts.Add(1)) - 1
+	}
+
+	spanName := operationID
+	if spanName == "" {
+		spanName = "HTTP " + req.Method
+	}
+	operationAttributes := []attribute.KeyValue{TelemetryOperationKey.String(operationID)}
+	spanAttributes := []attribute.KeyValue{
+		TelemetryOperationKey.String(operationID),
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.URLFull(req.URL.String()),
+		semconv.ServerAddress(req.URL.Hostname()),
+	}
+	if instanceID := pathParameter(req.URL.Path, "instances"); instanceID != "" {
+		spanAttributes = append(spanAttributes, TelemetryInstanceIDKey.String(instanceID))
+	}
+	if reportID := pathParameter(req.URL.Path, "reports"); reportID != "" && reportID != "latest" {
+		spanAttributes = append(spanAttributes, TelemetryReportIDKey.String(reportID))
+	}
+	if resendCount > 0 {
+		spanAttributes = append(spanAttributes, semconv.HTTPRequestResendCount(resendCount))
+		transport.retries.Add(ctx, 1, metric.WithAttributes(operationAttributes...))
+	} else if isPagerRequest(ctx) {
+		transport.pages.Add(ctx, 1, metric.WithAttributes(operationAttributes...))
+	}
+
+	ctx, span := transport.tracer.Start(ctx, spanName, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(spanAttributes...))
+	defer span.End()
+	req = req.Clone(ctx)
+	transport.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	start := time.Now()
+	resp, err := transport.next.RoundTrip(req)
+	durationAttributes := append([]attribute.KeyValue{}, operationAttributes...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		durationAttributes = append(durationAttributes, semconv.ErrorTypeKey.String(fmt.Sprintf("%T", err)))
+	} else {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+		durationAttributes = append(durationAttributes, semconv.HTTPResponseStatusCode(resp.StatusCode))
+		if resp.StatusCode >= 400 {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+			durationAttributes = append(durationAttributes, semconv.ErrorTypeKey.String(fmt.Sprint(resp.StatusCode)))
+		}
+	}
+	transport.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(durationAttributes...))
+	return resp, err
+}
+
+// pathParameter returns the path segment that follows "name", such as the instance ID that
+// follows "instances", or an empty string.
+func pathParameter(path string, name string) string {
+	segments := strings.Split(path, "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == name {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
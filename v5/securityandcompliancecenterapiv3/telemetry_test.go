@@ -0,0 +1,242 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var _ = Describe(`EnableTelemetry`, func() {
+	const instanceID = "acd7032c-15a3-484f-bf5b-67d41534d940"
+	var testServer *httptest.Server
+	var securityAndComplianceCenterAPIService *securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3
+	var spanExporter *tracetest.InMemoryExporter
+	var tracerProvider *sdktrace.TracerProvider
+	var metricReader *sdkmetric.ManualReader
+	var failures int32
+	var mutex sync.Mutex
+	var traceparents []string
+
+	// spanAttributes returns the attributes of a span by key.
+	spanAttributes := func(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+		result := make(map[attribute.Key]attribute.Value)
+		for _, kv := range span.Attributes {
+			result[kv.Key] = kv.Value
+		}
+		return result
+	}
+
+	// sums returns the values of a counter by operation.
+	sums := func(name string) map[string]int64 {
+		var metrics metricdata.ResourceMetrics
+		Expect(metricReader.Collect(context.Background(), &metrics)).To(Succeed())
+		result := make(map[string]int64)
+		for _, scope := range metrics.ScopeMetrics {
+			for _, m := range scope.Metrics {
+				if sum, ok := m.Data.(metricdata.Sum[int64]); ok && m.Name == name {
+					for _, point := range sum.DataPoints {
+						operation, _ := point.Attributes.Value(securityandcompliancecenterapiv3.TelemetryOperationKey)
+						result[operation.AsString()] += point.Value
+					}
+				}
+			}
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		atomic.StoreInt32(&failures, 0)
+		traceparents = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			traceparents = append(traceparents, req.Header.Get("traceparent"))
+			mutex.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			if atomic.AddInt32(&failures, -1) >= 0 {
+				res.WriteHeader(503)
+				fmt.Fprint(res, `{"errors": [{"code": "unavailable", "message": "Service unavailable"}]}`)
+				return
+			}
+			if req.URL.Query().Get("start") == "" && req.URL.Path == "/instances/"+instanceID+"/v3/reports" {
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"reports": [{"id": "report-1"}], "next": {"start": "page-2"}}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"reports": [{"id": "report-2"}]}`)
+		}))
+
+		spanExporter = tracetest.NewInMemoryExporter()
+		tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter))
+		metricReader = sdkmetric.NewManualReader()
+
+		var err error
+		securityAndComplianceCenterAPIService, err = securityandcompliancecenterapiv3.NewSecurityAndComplianceCenterAPIV3(&securityandcompliancecenterapiv3.SecurityAndComplianceCenterAPIV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		Expect(securityAndComplianceCenterAPIService.EnableTelemetry(&securityandcompliancecenterapiv3.TelemetryOptions{
+			TracerProvider: tracerProvider,
+			MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(metricReader)),
+			Propagator:     propagation.TraceContext{},
+		})).To(Succeed())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke operations within spans`, func() {
+		ctx, parent := tracerProvider.Tracer("test").Start(context.Background(), "dashboard")
+		_, _, err := securityAndComplianceCenterAPIService.GetReportWithContext(ctx, securityAndComplianceCenterAPIService.NewGetReportOptions("report-1", instanceID))
+		Expect(err).To(BeNil())
+		parent.End()
+
+		spans := spanExporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+		span := spans[0]
+		Expect(span.Name).To(Equal("GetReport"))
+		Expect(span.Parent.SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		Expect(span.InstrumentationScope.Name).To(Equal("github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"))
+		attributes := spanAttributes(span)
+		Expect(attributes["scc.operation"].AsString()).To(Equal("GetReport"))
+		Expect(attributes["scc.instance_id"].AsString()).To(Equal(instanceID))
+		Expect(attributes["scc.report_id"].AsString()).To(Equal("report-1"))
+		Expect(attributes["http.request.method"].AsString()).To(Equal("GET"))
+		Expect(attributes["http.response.status_code"].AsInt64()).To(Equal(int64(200)))
+		Expect(span.Status.Code).To(Equal(codes.Unset))
+
+		// The trace context is propagated to the service.
+		Expect(traceparents).To(Equal([]string{fmt.Sprintf("00-%s-%s-01", span.SpanContext.TraceID(), span.SpanContext.SpanID())}))
+	})
+	It(`Invoke operations with errors and retries`, func() {
+		atomic.StoreInt32(&failures, 2)
+		_, response, err := securityAndComplianceCenterAPIService.GetLatestReports(securityAndComplianceCenterAPIService.NewGetLatestReportsOptions(instanceID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(503))
+
+		securityAndComplianceCenterAPIService.EnableRetries(2, 10*time.Millisecond)
+		_, _, err = securityAndComplianceCenterAPIService.GetLatestReports(securityAndComplianceCenterAPIService.NewGetLatestReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+
+		spans := spanExporter.GetSpans()
+		Expect(spans).To(HaveLen(3))
+		Expect(spans[0].Status.Code).To(Equal(codes.Error))
+		Expect(spanAttributes(spans[0])["http.response.status_code"].AsInt64()).To(Equal(int64(503)))
+		Expect(spanAttributes(spans[0])).ToNot(HaveKey(attribute.Key("scc.report_id")))
+		Expect(spanAttributes(spans[1])).ToNot(HaveKey(attribute.Key("http.request.resend_count")))
+		Expect(spanAttributes(spans[2])["http.request.resend_count"].AsInt64()).To(Equal(int64(1)))
+		Expect(sums("scc.client.retries")).To(Equal(map[string]int64{"GetLatestReports": 1}))
+
+		var metrics metricdata.ResourceMetrics
+		Expect(metricReader.Collect(context.Background(), &metrics)).To(Succeed())
+		var requests uint64
+		for _, m := range metrics.ScopeMetrics[0].Metrics {
+			if histogram, ok := m.Data.(metricdata.Histogram[float64]); ok && m.Name == "scc.client.request.duration" {
+				Expect(m.Unit).To(Equal("s"))
+				for _, point := range histogram.DataPoints {
+					requests += point.Count
+				}
+			}
+		}
+		Expect(requests).To(Equal(uint64(3)))
+	})
+	It(`Invoke pagers`, func() {
+		pager, err := securityAndComplianceCenterAPIService.NewReportsPager(securityAndComplianceCenterAPIService.NewListReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		reports, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(reports).To(HaveLen(2))
+
+		_, _, err = securityAndComplianceCenterAPIService.ListReports(securityAndComplianceCenterAPIService.NewListReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(sums("scc.client.pager.pages")).To(Equal(map[string]int64{"ListReports": 2}))
+		Expect(spanExporter.GetSpans()).To(HaveLen(3))
+	})
+	It(`Invoke DisableTelemetry successfully`, func() {
+		securityAndComplianceCenterAPIService.DisableTelemetry()
+		_, _, err := securityAndComplianceCenterAPIService.GetLatestReports(securityAndComplianceCenterAPIService.NewGetLatestReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(spanExporter.GetSpans()).To(BeEmpty())
+		Expect(traceparents).To(Equal([]string{""}))
+	})
+	It(`Invoke EnableTelemetry again`, func() {
+		securityAndComplianceCenterAPIService.SetRateLimiter(securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0))
+		Expect(securityAndComplianceCenterAPIService.EnableTelemetry(&securityandcompliancecenterapiv3.TelemetryOptions{
+			TracerProvider: tracerProvider,
+			MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(metricReader)),
+			Propagator:     propagation.TraceContext{},
+		})).To(Succeed())
+		_, _, err := securityAndComplianceCenterAPIService.GetLatestReports(securityAndComplianceCenterAPIService.NewGetLatestReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(spanExporter.GetSpans()).To(HaveLen(1))
+	})
+	It(`Invoke DisableTelemetry under a rate limiter`, func() {
+		limiter := securityandcompliancecenterapiv3.NewTokenBucketRateLimiter(0, 0)
+		securityAndComplianceCenterAPIService.SetRateLimiter(limiter)
+		securityAndComplianceCenterAPIService.DisableTelemetry()
+		_, _, err := securityAndComplianceCenterAPIService.GetLatestReports(securityAndComplianceCenterAPIService.NewGetLatestReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(spanExporter.GetSpans()).To(BeEmpty())
+		Expect(limiter.GetMetrics().Requests).To(Equal(int64(1)))
+	})
+	It(`Invoke DisableTelemetry on a clone`, func() {
+		clone := securityAndComplianceCenterAPIService.Clone()
+		clone.DisableTelemetry()
+		_, _, err := clone.GetLatestReports(clone.NewGetLatestReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(spanExporter.GetSpans()).To(BeEmpty())
+		_, _, err = securityAndComplianceCenterAPIService.GetLatestReports(securityAndComplianceCenterAPIService.NewGetLatestReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(spanExporter.GetSpans()).To(HaveLen(1))
+	})
+	It(`Invoke DisableTelemetry on a clone with retries enabled`, func() {
+		securityAndComplianceCenterAPIService.EnableRetries(2, 10*time.Millisecond)
+		clone := securityAndComplianceCenterAPIService.Clone()
+		clone.DisableTelemetry()
+
+		// The clone still retries the requests, without spans.
+		atomic.StoreInt32(&failures, 1)
+		_, _, err := clone.GetLatestReports(clone.NewGetLatestReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(traceparents).To(HaveLen(2))
+		Expect(spanExporter.GetSpans()).To(BeEmpty())
+
+		_, _, err = securityAndComplianceCenterAPIService.GetLatestReports(securityAndComplianceCenterAPIService.NewGetLatestReportsOptions(instanceID))
+		Expect(err).To(BeNil())
+		Expect(spanExporter.GetSpans()).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package securityandcompliancecenterapiv3
+
+import (
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// transportLayer is a transport that the client adds to its HTTP client, such as the router of a
+// MultiRegionSecurityAndComplianceCenterAPIV3. The layers of a chain of transports can be found,
+// replaced and removed wherever they are in the chain.
+type transportLayer interface {
+	http.RoundTripper
+
+	// unwrap returns the transport that the layer wraps.
+	unwrap() http.RoundTripper
+
+	// wrap returns a copy of the layer that wraps "next".
+	wrap(next http.RoundTripper) http.RoundTripper
+}
+
+// withTransportLayer returns the chain of transports "transport" with its layer of type L replaced
+// by the transport that "layer" returns for the transport that the layer wraps. The layers above
+// it are copied to wrap the new transport, and "transport" is left unchanged. If the chain has no
+// layer of type L, the transport returned by "layer" for the whole chain is returned: a function
+// that returns "next" removes the layer.
+func withTransportLayer[L transportLayer](transport http.RoundTripper, layer func(next http.RoundTripper) http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if replaced, ok := replaceTransportLayer[L](transport, layer); ok {
+		return replaced
+	}
+	return layer(transport)
+}
+
+func replaceTransportLayer[L transportLayer](transport http.RoundTripper, layer func(next http.RoundTripper) http.RoundTripper) (http.RoundTripper, bool) {
+	current, ok := transport.(transportLayer)
+	if !ok {
+		return transport, false
+	}
+	if _, ok = current.(L); ok {
+		return layer(current.unwrap()), true
+	}
+	next, ok := replaceTransportLayer[L](current.unwrap(), layer)
+	if !ok {
+		return transport, false
+	}
+	return current.wrap(next), true
+}
+
+// wrapTransport sets the transport that "wrap" returns for the transport of the HTTP client of the
+// service, which is the client embedded in the retryable client when retries are enabled.
+//
+// The layers of the SDK, such as the rate limiter, the telemetry and the middlewares, are added
+// with wrapTransport and are copied on write: the transport is set on a copy of the client, and
+// the retryable client that the core shares with the clones of the service is copied as well, so
+// the clones and the other users of the client are not affected. Setting a layer again replaces
+// it where it is in the chain, and the layers above it are copied to wrap the new one.
+func (securityAndComplianceCenterApi *SecurityAndComplianceCenterAPIV3) wrapTransport(wrap func(transport http.RoundTripper) http.RoundTripper) {
+	service := securityAndComplianceCenterApi.Service
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	wrapped := *client
+	wrapped.Transport = wrap(wrapped.Transport)
+
+	// SetHTTPClient replaces the client embedded in the retryable client in place.
+	if shim := service.Client; shim != nil {
+		if transport, ok := shim.Transport.(*retryablehttp.RoundTripper); ok && transport.Client != nil {
+			copied := *shim
+			copied.Transport = &retryablehttp.RoundTripper{Client: copyRetryableClient(transport.Client)}
+			service.Client = &copied
+		}
+	}
+	service.SetHTTPClient(&wrapped)
+}
+
+// copyRetryableClient returns a copy of the settings of "client", which can't be copied as a
+// struct.
+func copyRetryableClient(client *retryablehttp.Client) *retryablehttp.Client {
+	return &retryablehttp.Client{
+		HTTPClient:      client.HTTPClient,
+		Logger:          client.Logger,
+		RetryWaitMin:    client.RetryWaitMin,
+		RetryWaitMax:    client.RetryWaitMax,
+		RetryMax:        client.RetryMax,
+		RequestLogHook:  client.RequestLogHook,
+		ResponseLogHook: client.ResponseLogHook,
+		CheckRetry:      client.CheckRetry,
+		Backoff:         client.Backoff,
+		ErrorHandler:    client.ErrorHandler,
+		PrepareRetry:    client.PrepareRetry,
+	}
+}